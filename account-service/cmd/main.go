@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"os/signal"
 	"syscall"
 	"time"
@@ -10,12 +12,17 @@ import (
 	"gitlab.com/pisya-dev/account-service/internal/repository"
 	"gitlab.com/pisya-dev/account-service/internal/service"
 	"gitlab.com/pisya-dev/account-service/internal/transport/grpc_server"
+	pb "gitlab.com/pisya-dev/account-service/pkg/api/account_service"
+	healthcheck "gitlab.com/pisya-dev/account-service/pkg/health"
 	"gitlab.com/pisya-dev/account-service/pkg/jwt"
 	"gitlab.com/pisya-dev/account-service/pkg/logger"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
 	"gitlab.com/pisya-dev/account-service/pkg/postgres"
 	"gitlab.com/pisya-dev/account-service/pkg/redis"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
 )
 
 func main() {
@@ -32,6 +39,18 @@ func main() {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "error  reading config:", zap.Error(err))
 	}
 
+	metrics.Register("account")
+
+	shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+		ServiceName:  "account-service",
+		Exporter:     config.Tracing.Exporter,
+		OTLPEndpoint: config.Tracing.OTLPEndpoint,
+		SampleRatio:  config.Tracing.SampleRatio,
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed init tracing", zap.Error(err))
+	}
+
 	pg, err := postgres.NewPostgres(ctx, &config.Postgres)
 
 	if err != nil {
@@ -58,10 +77,33 @@ func main() {
 
 	accountServer := grpc_server.NewServer(servise, jwtService)
 
-	server := grpc_server.NewGRPCServer(config, accountServer)
+	healthServer := health.NewServer()
+
+	checker := healthcheck.NewChecker(healthServer, config.Health.CheckInterval, config.Health.CheckTimeout, func(name string, err error) {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "dependency status changed", zap.String("dependency", name), zap.Error(err))
+	}, pb.Account_Service_ServiceDesc.ServiceName)
+	checker.Add("postgres", pg.Ping)
+	checker.Add("redis", func(ctx context.Context) error {
+		return rds.Ping(ctx).Err()
+	})
+
+	server := grpc_server.NewGRPCServer(config, accountServer, healthServer)
 
 	go server.Run(ctx)
 
+	checkerCtx, stopChecker := context.WithCancel(ctx)
+	defer stopChecker()
+
+	go checker.Run(checkerCtx)
+
+	metricsServer := metrics.NewServer(config.Metrics.Port)
+
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, "filed to serve metrics", zap.Error(err))
+		}
+	}()
+
 	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 
 	defer stop()
@@ -69,10 +111,23 @@ func main() {
 	<-ctx.Done()
 	logger.GetLoggerFromCtx(ctx).Info(ctx, "Shutdowning server...")
 
+	// сначала сообщаем о неготовности, чтобы клиенты и балансировщик успели уйти с инстанса
+	checker.Shutdown()
+	stopChecker()
+	time.Sleep(config.Health.ShutdownDelay)
+
 	server.ShutDown()
+	metricsServer.Close()
 	pg.Close()
 
 	rds.ShutdownSave(ctx)
 
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFlush()
+
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed flush traces", zap.Error(err))
+	}
+
 	logger.GetLoggerFromCtx(ctx).Info(ctx, "Server stoped")
 }
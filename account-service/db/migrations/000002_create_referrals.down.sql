@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS referral_credits;
+
+DROP TABLE IF EXISTS referrals;
+
+ALTER TABLE users DROP COLUMN IF EXISTS referral_code;
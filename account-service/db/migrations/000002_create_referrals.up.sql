@@ -0,0 +1,29 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS referral_code VARCHAR(16);
+
+UPDATE users SET referral_code = upper(substr(md5(id::text || random()::text), 1, 8)) WHERE referral_code IS NULL;
+
+ALTER TABLE users ALTER COLUMN referral_code SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_referral_code_idx ON users (referral_code);
+
+CREATE TABLE IF NOT EXISTS referrals (
+    referee_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    referrer_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    converted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS referrals_referrer_id_idx ON referrals (referrer_id);
+
+CREATE TABLE IF NOT EXISTS referral_credits (
+    referee_id uuid NOT NULL REFERENCES referrals (referee_id) ON DELETE CASCADE,
+    company_id uuid NOT NULL,
+    referrer_id uuid NOT NULL,
+    promo_id uuid NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (referee_id, company_id)
+);
+
+CREATE INDEX IF NOT EXISTS referral_credits_referrer_id_idx ON referral_credits (referrer_id);
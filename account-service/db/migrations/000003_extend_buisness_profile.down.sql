@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS buisness_categories_idx;
+
+DROP INDEX IF EXISTS buisness_country_idx;
+
+ALTER TABLE buisness
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS country,
+    DROP COLUMN IF EXISTS categories,
+    DROP COLUMN IF EXISTS contact_email,
+    DROP COLUMN IF EXISTS website,
+    DROP COLUMN IF EXISTS logo_url,
+    DROP COLUMN IF EXISTS description;
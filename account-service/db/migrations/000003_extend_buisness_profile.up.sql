@@ -0,0 +1,13 @@
+ALTER TABLE buisness
+    ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS logo_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS website TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS contact_email VARCHAR(254) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS categories TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS country VARCHAR(2) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS buisness_country_idx ON buisness (country);
+
+CREATE INDEX IF NOT EXISTS buisness_categories_idx ON buisness USING GIN (categories);
@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -12,6 +13,12 @@ type Config struct {
 	Gateway
 	Postgres
 	Redis
+	Auth
+	TLS
+	Health
+	Metrics
+	Tracing
+	Log
 }
 
 type Postgres struct {
@@ -30,6 +37,51 @@ type Redis struct {
 	Port     int    `env:"REDIS_PORT"`
 }
 
+// Auth сервисные токены доверенных клиентов в формате name:token через запятую,
+// например auth-service:secret1,promo-service:secret2
+type Auth struct {
+	ServiceTokens map[string]string `env:"SERVICE_TOKENS"`
+}
+
+// TLS настройки mTLS gRPC-сервера. AllowedPeers задаёт методы для каждого клиента по CommonName
+// его сертификата: auth-service:*,promo-service:GetBuisness|ConvertReferral
+type TLS struct {
+	Enabled        bool              `env:"TLS_ENABLED"`
+	CertFile       string            `env:"TLS_CERT_FILE" env-default:"./certs/mtls/tls.pem"`
+	KeyFile        string            `env:"TLS_KEY_FILE" env-default:"./certs/mtls/tls-key.pem"`
+	CAFile         string            `env:"TLS_CA_FILE" env-default:"./certs/mtls/ca.pem"`
+	ReloadInterval time.Duration     `env:"TLS_RELOAD_INTERVAL" env-default:"1m"`
+	AllowedPeers   map[string]string `env:"TLS_ALLOWED_PEERS"`
+}
+
+// Health интервал проверки зависимостей для grpc.health.v1. ShutdownDelay сколько сервер
+// после сигнала остановки отвечает NOT_SERVING, прежде чем перестать принимать соединения
+type Health struct {
+	CheckInterval time.Duration `env:"HEALTH_CHECK_INTERVAL" env-default:"5s"`
+	CheckTimeout  time.Duration `env:"HEALTH_CHECK_TIMEOUT" env-default:"2s"`
+	ShutdownDelay time.Duration `env:"SHUTDOWN_DELAY" env-default:"5s"`
+}
+
+// Metrics admin-порт, на котором отдаётся /metrics
+type Metrics struct {
+	Port int `env:"METRICS_PORT" env-default:"9090"`
+}
+
+// Tracing экспорт трасс OpenTelemetry: none, stdout или otlp
+type Tracing struct {
+	Exporter     string  `env:"TRACING_EXPORTER" env-default:"none"`
+	OTLPEndpoint string  `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	SampleRatio  float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
+// Log логирование вызовов: уровень по методу (/api.Account_Service/GetUser:debug, off отключает),
+// доля успешных вызовов в логе и поля тела запроса, скрываемые в дополнение к стандартным
+type Log struct {
+	MethodLevels      []string `env:"LOG_METHOD_LEVELS"`
+	SuccessSampleRate float64  `env:"LOG_SUCCESS_SAMPLE_RATE" env-default:"1"`
+	RedactFields      []string `env:"LOG_REDACT_FIELDS"`
+}
+
 type Gateway struct {
 	Port int `env:"GATEWAY_PORT"`
 }
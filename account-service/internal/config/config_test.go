@@ -21,6 +21,7 @@ func TestNewConfig_Success(t *testing.T) {
 	t.Setenv("REDIS_HOST", "localhost")
 	t.Setenv("REDIS_PORT", "6379")
 	t.Setenv("REDIS_PASS", "redispass")
+	t.Setenv("SERVICE_TOKENS", "auth-service:secret1,promo-service:secret2")
 
 	cfg, err := config.NewConfig()
 	assert.NoError(t, err)
@@ -38,6 +39,8 @@ func TestNewConfig_Success(t *testing.T) {
 	assert.Equal(t, "localhost", cfg.Redis.Host)
 	assert.Equal(t, 6379, cfg.Redis.Port)
 	assert.Equal(t, "redispass", cfg.Redis.Password)
+
+	assert.Equal(t, map[string]string{"auth-service": "secret1", "promo-service": "secret2"}, cfg.Auth.ServiceTokens)
 }
 
 func TestNewConfig_MissingEnv(t *testing.T) {
@@ -9,6 +9,15 @@ const (
 
 	RequestID Key = "request_id"
 	Uuid      Key = "uuid"
+	// Role роль вызывающего: RoleUser или RoleService
+	Role Key = "role"
+	// Caller имя сервиса, вызвавшего метод по сервисному токену
+	Caller Key = "caller"
+)
+
+const (
+	RoleUser    = "user"
+	RoleService = "service"
 )
 
 type User struct {
@@ -20,11 +29,68 @@ type User struct {
 
 	Age     int32  `json:"age"`
 	Country string `json:"country"`
+
+	// ReferralCode личный код пользователя для приглашения друзей
+	ReferralCode string `json:"referral_code"`
+	// ReferrerCode код пригласившего пользователя, указанный при регистрации
+	ReferrerCode string `json:"-"`
+}
+
+// Поля профиля пользователя, которые можно обновлять через UpdateUser
+const (
+	UserFieldName      = "name"
+	UserFieldSurname   = "surname"
+	UserFieldAvatarUrl = "avatar_url"
+	UserFieldAge       = "age"
+	UserFieldCountry   = "country"
+)
+
+// UserFields все обновляемые поля профиля, порядок задаёт порядок колонок в UPDATE
+var UserFields = []string{UserFieldName, UserFieldSurname, UserFieldAvatarUrl, UserFieldAge, UserFieldCountry}
+
+// ReferralReward начисление пригласившему, суммы в минимальных единицах валюты
+type ReferralReward struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// ReferralConversion активация промокода приглашённым пользователем.
+// Reward задаёт компания-владелец промокода, nil означает конверсию без начисления
+type ReferralConversion struct {
+	RefereeId string
+	PromoId   string
+	CompanyId string
+	Reward    *ReferralReward
+}
+
+type ReferralStats struct {
+	ReferralCode string
+	Invited      int64
+	Converted    int64
+	Rewards      []ReferralReward
 }
 
 type Business struct {
-	Guid string
-	Name string
+	Guid string `json:"id"`
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+	LogoUrl     string `json:"logo_url"`
+	Website     string `json:"website"`
+	// ContactEmail адрес для связи с компанией, отображается пользователям
+	ContactEmail string   `json:"contact_email"`
+	Categories   []string `json:"categories"`
+	// Country код страны ISO 3166-1 alpha-2
+	Country string `json:"country"`
+}
+
+// BusinessFilter условия выборки ListBusinesses, пустые поля не ограничивают выборку
+type BusinessFilter struct {
+	Country  string
+	Category string
+
+	Limit  int64
+	Offset int64
 }
 
 const (
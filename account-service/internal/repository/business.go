@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+
+	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/pkg/logger"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// businessColumns колонки профиля компании в порядке businessFields
+var businessColumns = []string{"id", "name", "description", "logo_url", "website", "contact_email", "categories", "country"}
+
+func businessFields(bis *domain.Business) []any {
+	return []any{
+		&bis.Guid,
+		&bis.Name,
+		&bis.Description,
+		&bis.LogoUrl,
+		&bis.Website,
+		&bis.ContactEmail,
+		&bis.Categories,
+		&bis.Country,
+	}
+}
+
+// UpdateBusiness перезаписывает профиль компании. Возвращает pgx.ErrNoRows, если компании нет
+func (r *Repository) UpdateBusiness(ctx context.Context, business *domain.Business) error {
+	categories := business.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("buisness").
+		Set("name", business.Name).
+		Set("description", business.Description).
+		Set("logo_url", business.LogoUrl).
+		Set("website", business.Website).
+		Set("contact_email", business.ContactEmail).
+		Set("categories", categories).
+		Set("country", business.Country).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": business.Guid})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to build SQL:", zap.Error(err))
+
+		return err
+	}
+
+	tag, err := r.pg.Exec(ctx, sql, args...)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Update failed:", zap.Error(err))
+
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteBusiness удаляет профиль компании. Возвращает pgx.ErrNoRows, если компании нет
+func (r *Repository) DeleteBusiness(ctx context.Context, businessId string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("buisness").
+		Where(sq.Eq{"id": businessId})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to build SQL:", zap.Error(err))
+
+		return err
+	}
+
+	tag, err := r.pg.Exec(ctx, sql, args...)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Delete failed:", zap.Error(err))
+
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListBusinesses возвращает страницу профилей компаний, новые первыми, и общее число подходящих под фильтр
+func (r *Repository) ListBusinesses(ctx context.Context, filter *domain.BusinessFilter) ([]domain.Business, int64, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(append(businessColumns, "count(1) OVER ()")...).
+		From("buisness").
+		OrderBy("created_at DESC", "id").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset))
+
+	if filter.Country != "" {
+		query = query.Where(sq.Eq{"country": filter.Country})
+	}
+	if filter.Category != "" {
+		query = query.Where(sq.Expr("categories @> ARRAY[?]::text[]", filter.Category))
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to build SQL", zap.Error(err))
+
+		return nil, 0, err
+	}
+
+	rows, err := r.pg.Query(ctx, sqlStr, args...)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to execute SELECT", zap.Error(err))
+
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		businesses []domain.Business
+		total      int64
+	)
+
+	for rows.Next() {
+		var bis domain.Business
+
+		if err = rows.Scan(append(businessFields(&bis), &total)...); err != nil {
+			return nil, 0, err
+		}
+
+		businesses = append(businesses, bis)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to read rows", zap.Error(err))
+
+		return nil, 0, err
+	}
+
+	return businesses, total, nil
+}
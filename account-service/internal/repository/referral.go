@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	uniqueViolation        = "23505"
+	referralCodeConstraint = "users_referral_code_idx"
+)
+
+// ErrReferralCodeTaken сгенерированный реферальный код уже принадлежит другому пользователю
+var ErrReferralCodeTaken = errors.New("referral code already taken")
+
+// CreateReferral связывает нового пользователя с владельцем реферального кода.
+// Возвращает false, если код не найден или принадлежит самому пользователю
+func (r *Repository) CreateReferral(ctx context.Context, refereeId string, referrerCode string) (bool, error) {
+	const sql = `
+		INSERT INTO referrals (referee_id, referrer_id)
+		SELECT $1, id FROM users WHERE referral_code = upper($2) AND id <> $1
+		ON CONFLICT (referee_id) DO NOTHING`
+
+	tag, err := r.pg.Exec(ctx, sql, refereeId, referrerCode)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "INSERT referral failed:", zap.Error(err))
+
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ConvertReferral отмечает приглашение сконвертированным и начисляет награду пригласившему.
+// Каждая компания начисляет награду за приглашённого пользователя не больше одного раза.
+// Если пользователь не был приглашён, возвращается пустой referrerId
+func (r *Repository) ConvertReferral(ctx context.Context, conversion *domain.ReferralConversion) (referrerId string, credited bool, err error) {
+	const sql = `
+		WITH referral AS (
+			UPDATE referrals SET converted_at = coalesce(converted_at, now())
+			WHERE referee_id = $1
+			RETURNING referrer_id
+		), credit AS (
+			INSERT INTO referral_credits (referee_id, company_id, referrer_id, promo_id, amount, currency)
+			SELECT $1, $2, referrer_id, $3, $4, $5 FROM referral WHERE $4 > 0
+			ON CONFLICT (referee_id, company_id) DO NOTHING
+			RETURNING referee_id
+		)
+		SELECT referrer_id, EXISTS (SELECT 1 FROM credit) FROM referral`
+
+	var reward domain.ReferralReward
+	if conversion.Reward != nil {
+		reward = *conversion.Reward
+	}
+
+	err = r.pg.QueryRow(ctx, sql, conversion.RefereeId, conversion.CompanyId, conversion.PromoId, reward.Amount, reward.Currency).
+		Scan(&referrerId, &credited)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Convert referral failed:", zap.Error(err))
+
+		return "", false, err
+	}
+
+	return referrerId, credited, nil
+}
+
+func (r *Repository) GetReferralStats(ctx context.Context, userId string) (*domain.ReferralStats, error) {
+	const sql = `
+		SELECT
+			u.referral_code,
+			(SELECT count(1) FROM referrals r WHERE r.referrer_id = u.id),
+			(SELECT count(1) FROM referrals r WHERE r.referrer_id = u.id AND r.converted_at IS NOT NULL),
+			coalesce((
+				SELECT json_agg(json_build_object('amount', t.amount, 'currency', t.currency) ORDER BY t.currency)
+				FROM (
+					SELECT currency, sum(amount) AS amount FROM referral_credits
+					WHERE referrer_id = u.id
+					GROUP BY currency
+				) t
+			), '[]')
+		FROM users u
+		WHERE u.id = $1`
+
+	var (
+		stats   domain.ReferralStats
+		rewards []byte
+	)
+
+	err := r.pg.QueryRow(ctx, sql, userId).Scan(&stats.ReferralCode, &stats.Invited, &stats.Converted, &rewards)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to execute SELECT", zap.Error(err))
+
+		return nil, err
+	}
+
+	if err = json.Unmarshal(rewards, &stats.Rewards); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"gitlab.com/pisya-dev/account-service/internal/domain"
@@ -25,6 +26,7 @@ type Repository struct {
 type PgxIface interface {
 	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
 
 func NewRepository(pg PgxIface) *Repository {
@@ -33,8 +35,8 @@ func NewRepository(pg PgxIface) *Repository {
 
 func (r *Repository) CreateUser(ctx context.Context, user *domain.User) error {
 	query := sq.Insert("users").
-		Columns("id", "name", "surname", "avatar_url", "age", "country").
-		Values(user.Guid, user.Name, user.Surname, user.Avatar_url, user.Age, user.Country).
+		Columns("id", "name", "surname", "avatar_url", "age", "country", "referral_code").
+		Values(user.Guid, user.Name, user.Surname, user.Avatar_url, user.Age, user.Country, user.ReferralCode).
 		PlaceholderFormat(sq.Dollar)
 
 	sql, args, err := query.ToSql()
@@ -50,22 +52,44 @@ func (r *Repository) CreateUser(ctx context.Context, user *domain.User) error {
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "INSERT failed:", zap.Error(err))
 
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == referralCodeConstraint {
+			return ErrReferralCodeTaken
+		}
+
 		return err
 	}
 
 	return nil
 }
 
-func (r *Repository) UpdateUser(ctx context.Context, user *domain.User) error {
+// UpdateUser обновляет только перечисленные в fields колонки профиля.
+// Возвращает pgx.ErrNoRows, если пользователя нет
+func (r *Repository) UpdateUser(ctx context.Context, user *domain.User, fields []string) error {
+	values := map[string]any{
+		domain.UserFieldName:      user.Name,
+		domain.UserFieldSurname:   user.Surname,
+		domain.UserFieldAvatarUrl: user.Avatar_url,
+		domain.UserFieldAge:       user.Age,
+		domain.UserFieldCountry:   user.Country,
+	}
+
+	requested := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		requested[field] = true
+	}
+
 	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Update("users").
-		Set("name", user.Name).
-		Set("surname", user.Surname).
-		Set("avatar_url", user.Avatar_url).
-		Set("age", user.Age).
-		Set("country", user.Country).
 		Where(sq.Eq{"id": user.Guid})
 
+	// обходим domain.UserFields, а не fields, чтобы порядок колонок не зависел от клиента
+	for _, field := range domain.UserFields {
+		if requested[field] {
+			query = query.Set(field, values[field])
+		}
+	}
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to build SQL:", zap.Error(err))
@@ -75,13 +99,17 @@ func (r *Repository) UpdateUser(ctx context.Context, user *domain.User) error {
 
 	// --- Выполняем запрос ---
 
-	_, err = r.pg.Exec(ctx, sql, args...)
+	tag, err := r.pg.Exec(ctx, sql, args...)
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "Update failed:", zap.Error(err))
 
 		return err
 	}
 
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
 	return nil
 }
 
@@ -132,9 +160,9 @@ func (r *Repository) CreateBuisness(ctx context.Context, business *domain.Busine
 	return nil
 }
 
-func (r *Repository) GetBuisness(ctx context.Context, business *domain.Business) (string, error) {
+func (r *Repository) GetBuisness(ctx context.Context, business *domain.Business) (*domain.Business, error) {
 	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("id", "name").
+		Select(businessColumns...).
 		From("buisness").
 		Where(sq.Eq{"id": business.Guid})
 
@@ -142,30 +170,27 @@ func (r *Repository) GetBuisness(ctx context.Context, business *domain.Business)
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to build SQL", zap.Error(err))
 
-		return "", err
+		return nil, err
 	}
 
 	// Структура для результата
 	var bis domain.Business
 
 	// Выполняем запрос
-	err = r.pg.QueryRow(ctx, sqlStr, args...).Scan(
-		&bis.Guid,
-		&bis.Name,
-	)
+	err = r.pg.QueryRow(ctx, sqlStr, args...).Scan(businessFields(&bis)...)
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to execute SELECT", zap.Error(err))
 
-		return "", err
+		return nil, err
 	}
 
-	return bis.Name, nil
+	return &bis, nil
 }
 
 func (r *Repository) GetUser(ctx context.Context, userId string) (*domain.User, error) {
 
 	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("name", "surname", "avatar_url", "age", "country").
+		Select("name", "surname", "avatar_url", "age", "country", "referral_code").
 		From("users").
 		Where(sq.Eq{"id": userId})
 
@@ -185,6 +210,7 @@ func (r *Repository) GetUser(ctx context.Context, userId string) (*domain.User,
 		&userResult.Avatar_url,
 		&userResult.Age,
 		&userResult.Country,
+		&userResult.ReferralCode,
 	)
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "Failed to execute SELECT", zap.Error(err))
@@ -8,6 +8,8 @@ import (
 	"gitlab.com/pisya-dev/account-service/internal/repository"
 	"gitlab.com/pisya-dev/account-service/pkg/logger"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/require"
 )
@@ -32,10 +34,12 @@ func TestRepository_CreateUser(t *testing.T) {
 		Avatar_url: "avatar.jpg",
 		Age:        25,
 		Country:    "RU",
+
+		ReferralCode: "ABCD2345",
 	}
 
 	mock.ExpectExec(`INSERT INTO users`).
-		WithArgs(user.Guid, user.Name, user.Surname, user.Avatar_url, user.Age, user.Country).
+		WithArgs(user.Guid, user.Name, user.Surname, user.Avatar_url, user.Age, user.Country, user.ReferralCode).
 		WillReturnResult(pgxmock.NewResult("INSERT", 1))
 
 	err = repo.CreateUser(ctx, user)
@@ -66,7 +70,28 @@ func TestRepository_UpdateUser(t *testing.T) {
 		WithArgs(user.Name, user.Surname, user.Avatar_url, user.Age, user.Country, user.Guid).
 		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 
-	err = repo.UpdateUser(ctx, user)
+	err = repo.UpdateUser(ctx, user, domain.UserFields)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateUser_Partial(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = logger.New(ctx)
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	repo := repository.NewRepository(mock)
+
+	user := &domain.User{Guid: "u1", Avatar_url: "avatar3.jpg", Age: 31}
+
+	mock.ExpectExec(`UPDATE users SET avatar_url = \$1, age = \$2 WHERE id = \$3`).
+		WithArgs(user.Avatar_url, user.Age, user.Guid).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.UpdateUser(ctx, user, []string{domain.UserFieldAge, domain.UserFieldAvatarUrl})
 	require.NoError(t, err)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
@@ -124,17 +149,176 @@ func TestRepository_GetBuisness(t *testing.T) {
 	repo := repository.NewRepository(mock)
 
 	bis := &domain.Business{Guid: "b1"}
-	expectedName := "My Biz"
+	expected := &domain.Business{
+		Guid:         bis.Guid,
+		Name:         "My Biz",
+		Description:  "Coffee and pastries",
+		LogoUrl:      "https://cdn.example/logo.png",
+		Website:      "https://biz.example",
+		ContactEmail: "hello@biz.example",
+		Categories:   []string{"food"},
+		Country:      "RU",
+	}
 
-	rows := pgxmock.NewRows([]string{"id", "name"}).
-		AddRow(bis.Guid, expectedName)
+	rows := pgxmock.NewRows([]string{"id", "name", "description", "logo_url", "website", "contact_email", "categories", "country"}).
+		AddRow(expected.Guid, expected.Name, expected.Description, expected.LogoUrl, expected.Website, expected.ContactEmail, expected.Categories, expected.Country)
 
-	mock.ExpectQuery(`SELECT id, name FROM buisness`).
+	mock.ExpectQuery(`SELECT id, name, description, logo_url, website, contact_email, categories, country FROM buisness`).
 		WithArgs(bis.Guid).
 		WillReturnRows(rows)
 
-	name, err := repo.GetBuisness(ctx, bis)
+	profile, err := repo.GetBuisness(ctx, bis)
+	require.NoError(t, err)
+	require.Equal(t, expected, profile)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateUser_ReferralCodeTaken(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = logger.New(ctx)
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	repo := repository.NewRepository(mock)
+
+	user := &domain.User{Guid: "u1", ReferralCode: "ABCD2345"}
+
+	mock.ExpectExec(`INSERT INTO users`).
+		WithArgs(user.Guid, user.Name, user.Surname, user.Avatar_url, user.Age, user.Country, user.ReferralCode).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "users_referral_code_idx"})
+
+	err = repo.CreateUser(ctx, user)
+	require.ErrorIs(t, err, repository.ErrReferralCodeTaken)
+}
+
+func TestRepository_CreateReferral(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = logger.New(ctx)
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	repo := repository.NewRepository(mock)
+
+	mock.ExpectExec(`INSERT INTO referrals`).
+		WithArgs("u2", "abcd2345").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	attributed, err := repo.CreateReferral(ctx, "u2", "abcd2345")
+	require.NoError(t, err)
+	require.True(t, attributed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ConvertReferral(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = logger.New(ctx)
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	repo := repository.NewRepository(mock)
+
+	conversion := &domain.ReferralConversion{
+		RefereeId: "u2",
+		PromoId:   "p1",
+		CompanyId: "c1",
+		Reward:    &domain.ReferralReward{Amount: 50000, Currency: "RUB"},
+	}
+
+	mock.ExpectQuery(`WITH referral AS`).
+		WithArgs("u2", "c1", "p1", int64(50000), "RUB").
+		WillReturnRows(pgxmock.NewRows([]string{"referrer_id", "exists"}).AddRow("u1", true))
+
+	referrerId, credited, err := repo.ConvertReferral(ctx, conversion)
+	require.NoError(t, err)
+	require.Equal(t, "u1", referrerId)
+	require.True(t, credited)
+
+	mock.ExpectQuery(`WITH referral AS`).
+		WithArgs("u3", "c1", "p1", int64(0), "").
+		WillReturnError(pgx.ErrNoRows)
+
+	referrerId, credited, err = repo.ConvertReferral(ctx, &domain.ReferralConversion{RefereeId: "u3", PromoId: "p1", CompanyId: "c1"})
+	require.NoError(t, err)
+	require.Empty(t, referrerId)
+	require.False(t, credited)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetReferralStats(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = logger.New(ctx)
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	repo := repository.NewRepository(mock)
+
+	mock.ExpectQuery(`SELECT`).
+		WithArgs("u1").
+		WillReturnRows(pgxmock.NewRows([]string{"referral_code", "invited", "converted", "rewards"}).
+			AddRow("ABCD2345", int64(5), int64(2), []byte(`[{"amount": 100000, "currency": "RUB"}]`)))
+
+	stats, err := repo.GetReferralStats(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, &domain.ReferralStats{
+		ReferralCode: "ABCD2345",
+		Invited:      5,
+		Converted:    2,
+		Rewards:      []domain.ReferralReward{{Amount: 100000, Currency: "RUB"}},
+	}, stats)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateBusiness_NotFound(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = logger.New(ctx)
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	repo := repository.NewRepository(mock)
+
+	bis := &domain.Business{Guid: "b1", Name: "My Biz", Country: "RU"}
+
+	mock.ExpectExec(`UPDATE buisness SET name = \$1, description = \$2, logo_url = \$3, website = \$4, contact_email = \$5, categories = \$6, country = \$7, updated_at = now\(\) WHERE id = \$8`).
+		WithArgs(bis.Name, "", "", "", "", []string{}, bis.Country, bis.Guid).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.UpdateBusiness(ctx, bis)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListBusinesses(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = logger.New(ctx)
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	repo := repository.NewRepository(mock)
+
+	rows := pgxmock.NewRows([]string{"id", "name", "description", "logo_url", "website", "contact_email", "categories", "country", "count"}).
+		AddRow("b1", "Coffee", "", "", "", "", []string{"food"}, "RU", int64(3)).
+		AddRow("b2", "Bakery", "", "", "", "", []string{"food"}, "RU", int64(3))
+
+	mock.ExpectQuery(`FROM buisness WHERE country = \$1 AND categories @> ARRAY\[\$2\]::text\[\] ORDER BY created_at DESC, id LIMIT 2 OFFSET 1`).
+		WithArgs("RU", "food").
+		WillReturnRows(rows)
+
+	businesses, total, err := repo.ListBusinesses(ctx, &domain.BusinessFilter{Country: "RU", Category: "food", Limit: 2, Offset: 1})
 	require.NoError(t, err)
-	require.Equal(t, expectedName, name)
+	require.Equal(t, int64(3), total)
+	require.Len(t, businesses, 2)
+	require.Equal(t, "Bakery", businesses[1].Name)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	businessNameMaxLen        = 100
+	businessDescriptionMaxLen = 1000
+	businessUrlMaxLen         = 2048
+	businessEmailMaxLen       = 254
+	businessCategoryMaxLen    = 50
+	businessCategoriesMax     = 20
+
+	businessListDefaultLimit = 20
+	businessListMaxLimit     = 100
+)
+
+var (
+	ErrBusinessNotFound = errors.New("business not found")
+	// ErrInvalidBusiness профиль компании не прошёл проверку, текст ошибки указывает поле
+	ErrInvalidBusiness = errors.New("invalid business profile")
+)
+
+// businessCacheKey ключ профиля компании в redis
+func businessCacheKey(id string) string {
+	return "business:" + id
+}
+
+func (s *Service) UpdateBusiness(ctx context.Context, bis *domain.Business) error {
+	if err := normalizeBusiness(bis); err != nil {
+		return err
+	}
+
+	err := s.repo.UpdateBusiness(ctx, bis)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrBusinessNotFound
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed update business error:", zap.Error(err))
+
+		return err
+	}
+
+	s.dropBusinessCache(ctx, bis.Guid)
+
+	return nil
+}
+
+func (s *Service) DeleteBusiness(ctx context.Context, businessId string) error {
+	err := s.repo.DeleteBusiness(ctx, businessId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrBusinessNotFound
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed delete business error:", zap.Error(err))
+
+		return err
+	}
+
+	s.dropBusinessCache(ctx, businessId)
+
+	return nil
+}
+
+func (s *Service) ListBusinesses(ctx context.Context, filter *domain.BusinessFilter) ([]domain.Business, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = businessListDefaultLimit
+	}
+	if filter.Limit > businessListMaxLimit {
+		filter.Limit = businessListMaxLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	filter.Country = strings.ToUpper(strings.TrimSpace(filter.Country))
+	filter.Category = strings.ToLower(strings.TrimSpace(filter.Category))
+
+	businesses, total, err := s.repo.ListBusinesses(ctx, filter)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed list businesses error:", zap.Error(err))
+
+		return nil, 0, err
+	}
+
+	return businesses, total, nil
+}
+
+// dropBusinessCache сбрасывает закэшированный профиль. Ошибка redis не прерывает запрос:
+// запись всё равно истечёт через domain.RedisTLl
+func (s *Service) dropBusinessCache(ctx context.Context, id string) {
+	if err := s.redisClient.Del(ctx, businessCacheKey(id)).Err(); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed drop business cache:", zap.Error(err))
+	}
+}
+
+// normalizeBusiness обрезает пробелы, приводит категории и страну к каноническому виду
+// и проверяет поля профиля
+func normalizeBusiness(bis *domain.Business) error {
+	bis.Name = strings.TrimSpace(bis.Name)
+	bis.Description = strings.TrimSpace(bis.Description)
+	bis.LogoUrl = strings.TrimSpace(bis.LogoUrl)
+	bis.Website = strings.TrimSpace(bis.Website)
+	bis.ContactEmail = strings.TrimSpace(bis.ContactEmail)
+	bis.Country = strings.ToUpper(strings.TrimSpace(bis.Country))
+
+	if bis.Name == "" || utf8.RuneCountInString(bis.Name) > businessNameMaxLen {
+		return fmt.Errorf("%w: name must be 1-%d characters", ErrInvalidBusiness, businessNameMaxLen)
+	}
+	if utf8.RuneCountInString(bis.Description) > businessDescriptionMaxLen {
+		return fmt.Errorf("%w: description must be at most %d characters", ErrInvalidBusiness, businessDescriptionMaxLen)
+	}
+	if !isHttpUrl(bis.LogoUrl) {
+		return fmt.Errorf("%w: logo_url must be an absolute http(s) URL", ErrInvalidBusiness)
+	}
+	if !isHttpUrl(bis.Website) {
+		return fmt.Errorf("%w: website must be an absolute http(s) URL", ErrInvalidBusiness)
+	}
+	if bis.ContactEmail != "" {
+		addr, err := mail.ParseAddress(bis.ContactEmail)
+		if err != nil || addr.Address != bis.ContactEmail || len(bis.ContactEmail) > businessEmailMaxLen {
+			return fmt.Errorf("%w: contact_email is not a valid address", ErrInvalidBusiness)
+		}
+	}
+	if bis.Country != "" && !isCountryCode(bis.Country) {
+		return fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code", ErrInvalidBusiness)
+	}
+
+	if len(bis.Categories) > businessCategoriesMax {
+		return fmt.Errorf("%w: at most %d categories allowed", ErrInvalidBusiness, businessCategoriesMax)
+	}
+
+	categories := make([]string, 0, len(bis.Categories))
+	seen := make(map[string]struct{}, len(bis.Categories))
+	for _, category := range bis.Categories {
+		category = strings.ToLower(strings.TrimSpace(category))
+		if category == "" || utf8.RuneCountInString(category) > businessCategoryMaxLen {
+			return fmt.Errorf("%w: category must be 1-%d characters", ErrInvalidBusiness, businessCategoryMaxLen)
+		}
+		if _, ok := seen[category]; ok {
+			continue
+		}
+		seen[category] = struct{}{}
+		categories = append(categories, category)
+	}
+	bis.Categories = categories
+
+	return nil
+}
+
+// isHttpUrl допускает пустую строку или абсолютный http(s) адрес
+func isHttpUrl(raw string) bool {
+	if raw == "" {
+		return true
+	}
+	if len(raw) > businessUrlMaxLen {
+		return false
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < 'A' || code[i] > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	// алфавит без похожих символов (0/O, 1/I), чтобы код было удобно диктовать
+	referralCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	referralCodeLength   = 8
+	referralCodeAttempts = 3
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+func newReferralCode() (string, error) {
+	code := make([]byte, referralCodeLength)
+	alphabetSize := big.NewInt(int64(len(referralCodeAlphabet)))
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		code[i] = referralCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+// attributeReferral связывает нового пользователя с пригласившим.
+// Ошибки не прерывают регистрацию: неизвестный код просто не засчитывается
+func (s *Service) attributeReferral(ctx context.Context, user *domain.User) {
+	attributed, err := s.repo.CreateReferral(ctx, user.Guid, user.ReferrerCode)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed create referral error:", zap.Error(err))
+
+		return
+	}
+
+	if !attributed {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "referral code not attributed", zap.String("referral_code", user.ReferrerCode))
+	}
+}
+
+func (s *Service) ConvertReferral(ctx context.Context, conversion *domain.ReferralConversion) (string, bool, error) {
+	referrerId, credited, err := s.repo.ConvertReferral(ctx, conversion)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed convert referral error:", zap.Error(err))
+
+		return "", false, err
+	}
+
+	return referrerId, credited, nil
+}
+
+func (s *Service) GetReferralStats(ctx context.Context, userId string) (*domain.ReferralStats, error) {
+	stats, err := s.repo.GetReferralStats(ctx, userId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed get referral stats error:", zap.Error(err))
+
+		return nil, err
+	}
+
+	return stats, nil
+}
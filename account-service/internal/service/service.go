@@ -2,24 +2,34 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
 
 	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/internal/repository"
 	"gitlab.com/pisya-dev/account-service/pkg/logger"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
 type Repository interface {
 	CreateUser(context.Context, *domain.User) error
-	UpdateUser(context.Context, *domain.User) error
+	UpdateUser(ctx context.Context, user *domain.User, fields []string) error
 	DeleteUser(context.Context, *domain.User) error
 
 	CreateBuisness(context.Context, *domain.Business) error
-	GetBuisness(context.Context, *domain.Business) (string, error)
+	GetBuisness(context.Context, *domain.Business) (*domain.Business, error)
+	UpdateBusiness(context.Context, *domain.Business) error
+	DeleteBusiness(ctx context.Context, businessId string) error
+	ListBusinesses(context.Context, *domain.BusinessFilter) ([]domain.Business, int64, error)
 	GetUser(context.Context, string) (*domain.User, error)
+
+	CreateReferral(ctx context.Context, refereeId string, referrerCode string) (bool, error)
+	ConvertReferral(context.Context, *domain.ReferralConversion) (string, bool, error)
+	GetReferralStats(ctx context.Context, userId string) (*domain.ReferralStats, error)
 }
 
 type Service struct {
@@ -33,7 +43,19 @@ func NewService(repo Repository, client *redis.Client) *Service {
 }
 
 func (s *Service) CreateUser(ctx context.Context, user *domain.User) error {
-	err := s.repo.CreateUser(ctx, user)
+	var err error
+
+	for attempt := 0; attempt < referralCodeAttempts; attempt++ {
+		user.ReferralCode, err = newReferralCode()
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.CreateUser(ctx, user)
+		if !errors.Is(err, repository.ErrReferralCodeTaken) {
+			break
+		}
+	}
 
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed create user error:", zap.Error(err))
@@ -41,19 +63,36 @@ func (s *Service) CreateUser(ctx context.Context, user *domain.User) error {
 		return err
 	}
 
+	if user.ReferrerCode != "" {
+		s.attributeReferral(ctx, user)
+	}
+
 	return nil
 }
 
-func (s *Service) UpdateUser(ctx context.Context, user *domain.User) error {
-	err := s.repo.UpdateUser(ctx, user)
+// UpdateUser обновляет поля профиля из fields, пустой fields означает все поля.
+// Возвращает профиль после обновления
+func (s *Service) UpdateUser(ctx context.Context, user *domain.User, fields []string) (*domain.User, error) {
+	fields, err := userUpdateFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateUser(user, fields); err != nil {
+		return nil, err
+	}
 
+	err = s.repo.UpdateUser(ctx, user, fields)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed update user error:", zap.Error(err))
 
-		return err
+		return nil, err
 	}
 
-	return nil
+	return s.GetUser(ctx, user)
 }
 
 func (s *Service) DeleteUser(ctx context.Context, user *domain.User) error {
@@ -82,30 +121,42 @@ func (s *Service) CreateBuisness(ctx context.Context, bis *domain.Business) erro
 	return nil
 }
 
-func (s *Service) GetBuisness(ctx context.Context, bis *domain.Business) (string, error) {
-	val, err := s.redisClient.Get(ctx, bis.Guid).Result()
+func (s *Service) GetBuisness(ctx context.Context, bis *domain.Business) (*domain.Business, error) {
+	val, err := s.redisClient.Get(ctx, businessCacheKey(bis.Guid)).Bytes()
 
 	if errors.Is(err, redis.Nil) {
-		name, err := s.repo.GetBuisness(ctx, bis)
+		profile, err := s.repo.GetBuisness(ctx, bis)
 
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBusinessNotFound
+		}
 		if err != nil {
 			logger.GetLoggerFromCtx(ctx).Info(ctx, "filed get business error:", zap.Error(err))
 
-			return "", err
+			return nil, err
 		}
 
-		s.redisClient.Set(ctx, bis.Guid, name, domain.RedisTLl)
+		if data, err := json.Marshal(profile); err == nil {
+			s.redisClient.Set(ctx, businessCacheKey(bis.Guid), data, domain.RedisTLl)
+		}
 
-		return name, nil
+		return profile, nil
 	}
 
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, "error:", zap.Error(err))
 
-		return "", err
+		return nil, err
+	}
+
+	var profile domain.Business
+	if err = json.Unmarshal(val, &profile); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed decode cached business:", zap.Error(err))
+
+		return nil, err
 	}
 
-	return val, nil
+	return &profile, nil
 }
 
 func (s *Service) GetUser(ctx context.Context, user *domain.User) (*domain.User, error) {
@@ -2,13 +2,17 @@ package service_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/internal/repository"
 	"gitlab.com/pisya-dev/account-service/internal/service"
+	"gitlab.com/pisya-dev/account-service/pkg/logger"
 
 	"github.com/go-redis/redismock/v9"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -24,8 +28,8 @@ func (m *MockRepository) CreateUser(ctx context.Context, user *domain.User) erro
 	return args.Error(0)
 }
 
-func (m *MockRepository) UpdateUser(ctx context.Context, user *domain.User) error {
-	args := m.Called(ctx, user)
+func (m *MockRepository) UpdateUser(ctx context.Context, user *domain.User, fields []string) error {
+	args := m.Called(ctx, user, fields)
 
 	return args.Error(0)
 }
@@ -42,16 +46,60 @@ func (m *MockRepository) CreateBuisness(ctx context.Context, bis *domain.Busines
 	return args.Error(0)
 }
 
-func (m *MockRepository) GetBuisness(ctx context.Context, bis *domain.Business) (string, error) {
+func (m *MockRepository) GetBuisness(ctx context.Context, bis *domain.Business) (*domain.Business, error) {
+	args := m.Called(ctx, bis)
+
+	profile, _ := args.Get(0).(*domain.Business)
+
+	return profile, args.Error(1)
+}
+
+func (m *MockRepository) UpdateBusiness(ctx context.Context, bis *domain.Business) error {
 	args := m.Called(ctx, bis)
 
-	return args.String(0), args.Error(1)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteBusiness(ctx context.Context, businessId string) error {
+	args := m.Called(ctx, businessId)
+
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListBusinesses(ctx context.Context, filter *domain.BusinessFilter) ([]domain.Business, int64, error) {
+	args := m.Called(ctx, filter)
+
+	businesses, _ := args.Get(0).([]domain.Business)
+
+	return businesses, args.Get(1).(int64), args.Error(2)
 }
 
 func (m *MockRepository) GetUser(ctx context.Context, user string) (*domain.User, error) {
 	args := m.Called(ctx, user)
 
-	return nil, args.Error(1)
+	profile, _ := args.Get(0).(*domain.User)
+
+	return profile, args.Error(1)
+}
+
+func (m *MockRepository) CreateReferral(ctx context.Context, refereeId string, referrerCode string) (bool, error) {
+	args := m.Called(ctx, refereeId, referrerCode)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ConvertReferral(ctx context.Context, conversion *domain.ReferralConversion) (string, bool, error) {
+	args := m.Called(ctx, conversion)
+
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRepository) GetReferralStats(ctx context.Context, userId string) (*domain.ReferralStats, error) {
+	args := m.Called(ctx, userId)
+
+	stats, _ := args.Get(0).(*domain.ReferralStats)
+
+	return stats, args.Error(1)
 }
 
 // Тест CreateUser.
@@ -71,6 +119,41 @@ func TestService_CreateUser(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+// Тест CreateUser: пользователь получает реферальный код, приглашение связывается с пригласившим.
+func TestService_CreateUser_WithReferrer(t *testing.T) {
+	ctx, err := logger.New(context.Background())
+	require.NoError(t, err)
+	repo := new(MockRepository)
+	rdb, _ := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	user := &domain.User{Guid: "2", Name: "Invited", ReferrerCode: "ABCD2345"}
+
+	repo.On("CreateUser", ctx, user).Return(repository.ErrReferralCodeTaken).Once()
+	repo.On("CreateUser", ctx, user).Return(nil).Once()
+	repo.On("CreateReferral", ctx, "2", "ABCD2345").Return(true, nil)
+
+	err = svc.CreateUser(ctx, user)
+	require.NoError(t, err)
+	require.Len(t, user.ReferralCode, 8)
+
+	repo.AssertExpectations(t)
+}
+
+// Тест GetReferralStats для несуществующего пользователя.
+func TestService_GetReferralStats_NotFound(t *testing.T) {
+	ctx, err := logger.New(context.Background())
+	require.NoError(t, err)
+	repo := new(MockRepository)
+	rdb, _ := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	repo.On("GetReferralStats", ctx, "missing").Return(nil, pgx.ErrNoRows)
+
+	_, err = svc.GetReferralStats(ctx, "missing")
+	require.ErrorIs(t, err, service.ErrUserNotFound)
+}
+
 // Тест UpdateUser.
 func TestService_UpdateUser(t *testing.T) {
 	ctx := context.Background()
@@ -78,12 +161,60 @@ func TestService_UpdateUser(t *testing.T) {
 	rdb, _ := redismock.NewClientMock()
 	svc := service.NewService(repo, rdb)
 
-	user := &domain.User{Guid: "1", Name: "Test"}
+	user := &domain.User{Guid: "1", Name: " Test "}
+	stored := &domain.User{Name: "Test", Surname: "Ivanov", Age: 25, Country: "RU"}
 
-	repo.On("UpdateUser", ctx, user).Return(nil)
+	repo.On("UpdateUser", ctx, user, []string{domain.UserFieldName}).Return(nil)
+	repo.On("GetUser", ctx, "1").Return(stored, nil)
 
-	err := svc.UpdateUser(ctx, user)
+	profile, err := svc.UpdateUser(ctx, user, []string{"name", "name"})
 	require.NoError(t, err)
+	require.Equal(t, "Test", user.Name)
+	require.Equal(t, stored, profile)
+
+	repo.AssertExpectations(t)
+}
+
+// Тест UpdateUser: неизвестное поле маски и некорректные значения отклоняются до репозитория.
+func TestService_UpdateUser_Invalid(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	rdb, _ := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	for name, tc := range map[string]struct {
+		user   *domain.User
+		fields []string
+	}{
+		"unknown field": {&domain.User{Guid: "1"}, []string{"referral_code"}},
+		"empty name":    {&domain.User{Guid: "1", Name: " "}, []string{"name"}},
+		"age":           {&domain.User{Guid: "1", Age: 101}, []string{"age"}},
+		"avatar":        {&domain.User{Guid: "1", Avatar_url: "ftp://cdn/a.jpg"}, []string{"avatar_url"}},
+		"country":       {&domain.User{Guid: "1", Country: "Russia"}, []string{"country"}},
+		"full update":   {&domain.User{Guid: "1", Name: "Ivan"}, nil},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.UpdateUser(ctx, tc.user, tc.fields)
+			require.ErrorIs(t, err, service.ErrInvalidUser)
+		})
+	}
+
+	repo.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Тест UpdateUser для несуществующего пользователя.
+func TestService_UpdateUser_NotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	rdb, _ := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	user := &domain.User{Guid: "missing", Age: 20}
+
+	repo.On("UpdateUser", ctx, user, []string{domain.UserFieldAge}).Return(pgx.ErrNoRows)
+
+	_, err := svc.UpdateUser(ctx, user, []string{"age"})
+	require.ErrorIs(t, err, service.ErrUserNotFound)
 }
 
 // Тест DeleteUser.
@@ -128,11 +259,12 @@ func TestService_GetBuisness_CacheHit(t *testing.T) {
 
 	bis := &domain.Business{Guid: "123"}
 
-	mock.ExpectGet(bis.Guid).SetVal("CachedName")
+	mock.ExpectGet("business:" + bis.Guid).SetVal(`{"id":"123","name":"CachedName","categories":["food"]}`)
 
-	name, err := svc.GetBuisness(ctx, bis)
+	profile, err := svc.GetBuisness(ctx, bis)
 	require.NoError(t, err)
-	require.Equal(t, "CachedName", name)
+	require.Equal(t, "CachedName", profile.Name)
+	require.Equal(t, []string{"food"}, profile.Categories)
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
@@ -146,13 +278,118 @@ func TestService_GetBuisness_CacheMiss(t *testing.T) {
 
 	bis := &domain.Business{Guid: "123"}
 
-	mock.ExpectGet(bis.Guid).RedisNil()
-	repo.On("GetBuisness", ctx, bis).Return("DBName", nil)
-	mock.ExpectSet(bis.Guid, "DBName", time.Minute*5).SetVal("OK")
+	stored := &domain.Business{Guid: "123", Name: "DBName", Country: "RU"}
+	cached, err := json.Marshal(stored)
+	require.NoError(t, err)
+
+	mock.ExpectGet("business:" + bis.Guid).RedisNil()
+	repo.On("GetBuisness", ctx, bis).Return(stored, nil)
+	mock.ExpectSet("business:"+bis.Guid, cached, domain.RedisTLl).SetVal("OK")
 
-	name, err := svc.GetBuisness(ctx, bis)
+	profile, err := svc.GetBuisness(ctx, bis)
 	require.NoError(t, err)
-	require.Equal(t, "DBName", name)
+	require.Equal(t, stored, profile)
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+// Тест GetBuisness для несуществующей компании.
+func TestService_GetBuisness_NotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	rdb, mock := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	bis := &domain.Business{Guid: "missing"}
+
+	mock.ExpectGet("business:" + bis.Guid).RedisNil()
+	repo.On("GetBuisness", ctx, bis).Return(nil, pgx.ErrNoRows)
+
+	_, err := svc.GetBuisness(ctx, bis)
+	require.ErrorIs(t, err, service.ErrBusinessNotFound)
+}
+
+// Тест UpdateBusiness: поля нормализуются, закэшированный профиль сбрасывается.
+func TestService_UpdateBusiness(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	rdb, mock := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	bis := &domain.Business{
+		Guid:         "123",
+		Name:         "  Coffee House ",
+		Website:      "https://coffee.example",
+		ContactEmail: "hello@coffee.example",
+		Categories:   []string{"Food", " food", "Drinks"},
+		Country:      "ru",
+	}
+
+	repo.On("UpdateBusiness", ctx, bis).Return(nil)
+	mock.ExpectDel("business:123").SetVal(1)
+
+	err := svc.UpdateBusiness(ctx, bis)
+	require.NoError(t, err)
+	require.Equal(t, "Coffee House", bis.Name)
+	require.Equal(t, []string{"food", "drinks"}, bis.Categories)
+	require.Equal(t, "RU", bis.Country)
+
+	repo.AssertExpectations(t)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// Тест UpdateBusiness: некорректный профиль не доходит до репозитория.
+func TestService_UpdateBusiness_Invalid(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	rdb, _ := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	for name, bis := range map[string]*domain.Business{
+		"empty name":    {Guid: "1", Name: " "},
+		"website":       {Guid: "1", Name: "Biz", Website: "javascript:alert(1)"},
+		"logo":          {Guid: "1", Name: "Biz", LogoUrl: "/logo.png"},
+		"contact email": {Guid: "1", Name: "Biz", ContactEmail: "Biz <biz@example.com>"},
+		"country":       {Guid: "1", Name: "Biz", Country: "RUS"},
+		"category":      {Guid: "1", Name: "Biz", Categories: []string{""}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := svc.UpdateBusiness(ctx, bis)
+			require.ErrorIs(t, err, service.ErrInvalidBusiness)
+		})
+	}
+
+	repo.AssertNotCalled(t, "UpdateBusiness", mock.Anything, mock.Anything)
+}
+
+// Тест DeleteBusiness для несуществующей компании.
+func TestService_DeleteBusiness_NotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	rdb, _ := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	repo.On("DeleteBusiness", ctx, "missing").Return(pgx.ErrNoRows)
+
+	err := svc.DeleteBusiness(ctx, "missing")
+	require.ErrorIs(t, err, service.ErrBusinessNotFound)
+}
+
+// Тест ListBusinesses: лимит ограничивается, фильтры нормализуются.
+func TestService_ListBusinesses(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	rdb, _ := redismock.NewClientMock()
+	svc := service.NewService(repo, rdb)
+
+	filter := &domain.BusinessFilter{Country: "ru", Category: " Food ", Limit: 1000}
+	businesses := []domain.Business{{Guid: "1", Name: "Biz"}}
+
+	repo.On("ListBusinesses", ctx, &domain.BusinessFilter{Country: "RU", Category: "food", Limit: 100}).
+		Return(businesses, int64(1), nil)
+
+	result, total, err := svc.ListBusinesses(ctx, filter)
+	require.NoError(t, err)
+	require.Equal(t, businesses, result)
+	require.Equal(t, int64(1), total)
+}
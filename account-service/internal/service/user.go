@@ -0,0 +1,75 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"gitlab.com/pisya-dev/account-service/internal/domain"
+)
+
+const (
+	userNameMaxLen      = 100
+	userSurnameMaxLen   = 120
+	userAvatarUrlMaxLen = 350
+	userAgeMax          = 100
+)
+
+// ErrInvalidUser профиль пользователя не прошёл проверку, текст ошибки указывает поле
+var ErrInvalidUser = errors.New("invalid user profile")
+
+// userUpdateFields проверяет маску обновления и убирает повторы.
+// Пустая маска означает обновление всех полей
+func userUpdateFields(fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		return domain.UserFields, nil
+	}
+
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !slices.Contains(domain.UserFields, field) {
+			return nil, fmt.Errorf("%w: field %q cannot be updated", ErrInvalidUser, field)
+		}
+		if !slices.Contains(result, field) {
+			result = append(result, field)
+		}
+	}
+
+	return result, nil
+}
+
+// validateUser проверяет только обновляемые поля, остальные остаются как в базе
+func validateUser(user *domain.User, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case domain.UserFieldName:
+			user.Name = strings.TrimSpace(user.Name)
+			if user.Name == "" || utf8.RuneCountInString(user.Name) > userNameMaxLen {
+				return fmt.Errorf("%w: name must be 1-%d characters", ErrInvalidUser, userNameMaxLen)
+			}
+		case domain.UserFieldSurname:
+			user.Surname = strings.TrimSpace(user.Surname)
+			if user.Surname == "" || utf8.RuneCountInString(user.Surname) > userSurnameMaxLen {
+				return fmt.Errorf("%w: surname must be 1-%d characters", ErrInvalidUser, userSurnameMaxLen)
+			}
+		case domain.UserFieldAvatarUrl:
+			user.Avatar_url = strings.TrimSpace(user.Avatar_url)
+			if len(user.Avatar_url) > userAvatarUrlMaxLen || !isHttpUrl(user.Avatar_url) {
+				return fmt.Errorf("%w: avatar_url must be an absolute http(s) URL up to %d characters", ErrInvalidUser, userAvatarUrlMaxLen)
+			}
+		case domain.UserFieldAge:
+			if user.Age < 0 || user.Age > userAgeMax {
+				return fmt.Errorf("%w: age must be 0-%d", ErrInvalidUser, userAgeMax)
+			}
+		case domain.UserFieldCountry:
+			user.Country = strings.TrimSpace(user.Country)
+			if !isCountryCode(strings.ToUpper(user.Country)) {
+				return fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code", ErrInvalidUser)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package grpc_server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"gitlab.com/pisya-dev/account-service/internal/domain"
+	pb "gitlab.com/pisya-dev/account-service/pkg/api/account_service"
+	"gitlab.com/pisya-dev/account-service/pkg/jwt"
+	"gitlab.com/pisya-dev/account-service/pkg/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthorizationHeader содержит access token пользователя: "Bearer <jwt>"
+	AuthorizationHeader = "authorization"
+	// ServiceTokenHeader содержит токен доверенного сервиса из config.Auth
+	ServiceTokenHeader = "x-service-token"
+	// UserIdHeader id аккаунта, от имени которого сервис вызывает метод, например при регистрации компании.
+	// Учитывается только вместе с ServiceTokenHeader
+	UserIdHeader = "x-user-id"
+)
+
+type access int
+
+const (
+	// accessPublic метод доступен без учётных данных
+	accessPublic access = iota
+	// accessAuthenticated метод доступен любому пользователю и сервису
+	accessAuthenticated
+	// accessOwner пользователь может обращаться только к своему id, сервис к любому
+	accessOwner
+	// accessService метод доступен только сервисам
+	accessService
+)
+
+type rule struct {
+	access access
+	// ownerId достаёт из запроса id, который должен совпасть с subject пользователя
+	ownerId func(req any) string
+}
+
+// rules права на методы Account_Service. Метод, которого нет в таблице, запрещён
+var rules = map[string]rule{
+	pb.Account_Service_Ping_FullMethodName: {access: accessPublic},
+	healthpb.Health_Check_FullMethodName:   {access: accessPublic},
+
+	pb.Account_Service_CreateUser_FullMethodName:      {access: accessService},
+	pb.Account_Service_CreateBuisness_FullMethodName:  {access: accessService},
+	pb.Account_Service_ConvertReferral_FullMethodName: {access: accessService},
+
+	pb.Account_Service_ListBusinesses_FullMethodName: {access: accessAuthenticated},
+
+	pb.Account_Service_GetUserProfile_FullMethodName: {access: accessOwner, ownerId: func(req any) string {
+		return req.(*pb.GetUserProfileRequest).GetUuid()
+	}},
+	pb.Account_Service_UpdateUser_FullMethodName: {access: accessOwner, ownerId: func(req any) string {
+		return req.(*pb.UpdateUserRequest).GetUuid()
+	}},
+	pb.Account_Service_DeleteUser_FullMethodName: {access: accessOwner, ownerId: func(req any) string {
+		return req.(*pb.DeleteUserRequest).GetId()
+	}},
+	pb.Account_Service_GetBuisness_FullMethodName: {access: accessOwner, ownerId: func(req any) string {
+		return req.(*pb.GetBuisnessRequest).GetId()
+	}},
+	pb.Account_Service_UpdateBusiness_FullMethodName: {access: accessOwner, ownerId: func(req any) string {
+		return req.(*pb.UpdateBusinessRequest).GetId()
+	}},
+	pb.Account_Service_DeleteBusiness_FullMethodName: {access: accessOwner, ownerId: func(req any) string {
+		return req.(*pb.DeleteBusinessRequest).GetId()
+	}},
+	pb.Account_Service_GetReferralStats_FullMethodName: {access: accessOwner, ownerId: func(req any) string {
+		return req.(*pb.GetReferralStatsRequest).GetUserId()
+	}},
+}
+
+type AuthInterceptor struct {
+	jwtService    *jwt.ServiceJWT
+	serviceTokens map[string]string
+}
+
+func NewAuthInterceptor(jwtService *jwt.ServiceJWT, serviceTokens map[string]string) *AuthInterceptor {
+	return &AuthInterceptor{jwtService: jwtService, serviceTokens: serviceTokens}
+}
+
+// Unary проверяет учётные данные из metadata и права на метод. Пользователь передаёт
+// access token в AuthorizationHeader, сервис передаёт свой токен в ServiceTokenHeader.
+// В контекст кладутся domain.Role и domain.Uuid для пользователя или domain.Caller для сервиса
+// и domain.Uuid из UserIdHeader, если сервис действует от имени аккаунта
+func (a *AuthInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+	rule, ok := rules[info.FullMethod]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+	if rule.access == accessPublic {
+		return next(ctx, req)
+	}
+
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed authenticate:", zap.String("method", info.FullMethod), zap.Error(err))
+
+		return nil, err
+	}
+
+	if ctx.Value(domain.Role) == domain.RoleService {
+		return next(ctx, req)
+	}
+
+	switch rule.access {
+	case accessService:
+		return nil, status.Error(codes.PermissionDenied, "method is available to services only")
+	case accessOwner:
+		// пустой id в запросе обработчик заменяет на subject из контекста
+		if id := rule.ownerId(req); id != "" && id != ctx.Value(domain.Uuid) {
+			return nil, status.Error(codes.PermissionDenied, "access to another account is denied")
+		}
+	}
+
+	return next(ctx, req)
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(AuthorizationHeader); len(values) > 0 {
+		token, found := strings.CutPrefix(values[0], "Bearer ")
+		if !found {
+			return ctx, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+		}
+
+		claims, err := a.jwtService.DecodeAccessKey(token)
+		if err != nil {
+			return ctx, status.Error(codes.Unauthenticated, "invalid jwt")
+		}
+		if claims.Subject == "" {
+			return ctx, status.Error(codes.Unauthenticated, "jwt without subject")
+		}
+
+		ctx = context.WithValue(ctx, domain.Role, domain.RoleUser)
+		ctx = context.WithValue(ctx, domain.Uuid, claims.Subject)
+
+		return ctx, nil
+	}
+
+	if values := md.Get(ServiceTokenHeader); len(values) > 0 {
+		for name, token := range a.serviceTokens {
+			if token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(values[0])) == 1 {
+				ctx = context.WithValue(ctx, domain.Role, domain.RoleService)
+				ctx = context.WithValue(ctx, domain.Caller, name)
+				if ids := md.Get(UserIdHeader); len(ids) > 0 && ids[0] != "" {
+					ctx = context.WithValue(ctx, domain.Uuid, ids[0])
+				}
+
+				return ctx, nil
+			}
+		}
+
+		return ctx, status.Error(codes.Unauthenticated, "invalid service token")
+	}
+
+	return ctx, status.Error(codes.Unauthenticated, "missing credentials")
+}
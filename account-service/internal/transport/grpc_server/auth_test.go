@@ -0,0 +1,188 @@
+package grpc_server_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/internal/transport/grpc_server"
+	pb "gitlab.com/pisya-dev/account-service/pkg/api/account_service"
+	jwtlib "gitlab.com/pisya-dev/account-service/pkg/jwt"
+	"gitlab.com/pisya-dev/account-service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newAuthInterceptor(t *testing.T) (*grpc_server.AuthInterceptor, func(subject string) string) {
+	t.Helper()
+
+	a, svc, priv := newAuthInterceptorKeys(t)
+	sign := func(subject string) string {
+		token, err := svc.Encode(svc.GetClaims(subject, jwtlib.AccessTokenMode), priv)
+		require.NoError(t, err)
+		return token
+	}
+
+	return a, sign
+}
+
+func newAuthInterceptorKeys(t *testing.T) (*grpc_server.AuthInterceptor, *jwtlib.ServiceJWT, *rsa.PrivateKey) {
+	t.Helper()
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	svc := jwtlib.NewServiceJWT(&priv.PublicKey, time.Hour, time.Minute)
+
+	return grpc_server.NewAuthInterceptor(svc, map[string]string{"auth-service": "secret"}), svc, priv
+}
+
+func callUnary(a *grpc_server.AuthInterceptor, ctx context.Context, method string, req any) (context.Context, error) {
+	// в сервере логгер кладёт в контекст MiddlewareInterceptor
+	ctx, _ = logger.New(ctx)
+
+	var got context.Context
+	_, err := a.Unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+		got = ctx
+		return "response", nil
+	})
+
+	return got, err
+}
+
+func withMetadata(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+// Тест публичного метода без учётных данных.
+func TestAuthInterceptor_Public(t *testing.T) {
+	a, _ := newAuthInterceptor(t)
+
+	_, err := callUnary(a, context.Background(), pb.Account_Service_Ping_FullMethodName, &pb.PingRequest{})
+	assert.NoError(t, err)
+
+	_, err = callUnary(a, context.Background(), healthpb.Health_Check_FullMethodName, &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+}
+
+// Тест запроса без учётных данных.
+func TestAuthInterceptor_MissingCredentials(t *testing.T) {
+	a, _ := newAuthInterceptor(t)
+
+	_, err := callUnary(a, context.Background(), pb.Account_Service_GetUserProfile_FullMethodName, &pb.GetUserProfileRequest{Uuid: "u1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// Тест невалидного jwt.
+func TestAuthInterceptor_InvalidJWT(t *testing.T) {
+	a, _ := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.AuthorizationHeader, "Bearer not-a-jwt")
+	_, err := callUnary(a, ctx, pb.Account_Service_GetUserProfile_FullMethodName, &pb.GetUserProfileRequest{Uuid: "u1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// Тест refresh token в заголовке authorization: вызывать методы можно только с access token.
+func TestAuthInterceptor_RefreshTokenRejected(t *testing.T) {
+	a, svc, priv := newAuthInterceptorKeys(t)
+
+	token, err := svc.Encode(svc.GetClaims("u1", jwtlib.RefreshTokenMode), priv)
+	require.NoError(t, err)
+
+	ctx := withMetadata(grpc_server.AuthorizationHeader, "Bearer "+token)
+	_, err = callUnary(a, ctx, pb.Account_Service_GetUserProfile_FullMethodName, &pb.GetUserProfileRequest{Uuid: "u1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// Тест доступа пользователя к своему профилю: subject и роль попадают в контекст.
+func TestAuthInterceptor_OwnerAllowed(t *testing.T) {
+	a, sign := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.AuthorizationHeader, "Bearer "+sign("u1"))
+	got, err := callUnary(a, ctx, pb.Account_Service_GetUserProfile_FullMethodName, &pb.GetUserProfileRequest{Uuid: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, "u1", got.Value(domain.Uuid))
+	assert.Equal(t, domain.RoleUser, got.Value(domain.Role))
+}
+
+// Тест доступа пользователя к чужому аккаунту.
+func TestAuthInterceptor_OwnerDenied(t *testing.T) {
+	a, sign := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.AuthorizationHeader, "Bearer "+sign("u1"))
+	_, err := callUnary(a, ctx, pb.Account_Service_DeleteUser_FullMethodName, &pb.DeleteUserRequest{Id: "u2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// Тест вызова сервисного метода пользователем.
+func TestAuthInterceptor_ServiceOnlyDeniedForUser(t *testing.T) {
+	a, sign := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.AuthorizationHeader, "Bearer "+sign("u1"))
+	_, err := callUnary(a, ctx, pb.Account_Service_ConvertReferral_FullMethodName, &pb.ConvertReferralRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// Тест сервисного токена: сервису доступны сервисные методы и любые аккаунты.
+func TestAuthInterceptor_Service(t *testing.T) {
+	a, _ := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.ServiceTokenHeader, "secret")
+
+	got, err := callUnary(a, ctx, pb.Account_Service_CreateUser_FullMethodName, &pb.CreateUserRequest{Id: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleService, got.Value(domain.Role))
+	assert.Equal(t, "auth-service", got.Value(domain.Caller))
+	assert.Nil(t, got.Value(domain.Uuid))
+
+	_, err = callUnary(a, ctx, pb.Account_Service_DeleteUser_FullMethodName, &pb.DeleteUserRequest{Id: "u2"})
+	assert.NoError(t, err)
+}
+
+// Тест сервисного вызова от имени аккаунта: id из x-user-id попадает в контекст.
+func TestAuthInterceptor_ServiceOnBehalfOfUser(t *testing.T) {
+	a, _ := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.ServiceTokenHeader, "secret", grpc_server.UserIdHeader, "b1")
+	got, err := callUnary(a, ctx, pb.Account_Service_CreateBuisness_FullMethodName, &pb.CreateBuisnessRequest{Name: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleService, got.Value(domain.Role))
+	assert.Equal(t, "b1", got.Value(domain.Uuid))
+}
+
+// Тест x-user-id без сервисного токена.
+func TestAuthInterceptor_UserIdWithoutServiceToken(t *testing.T) {
+	a, sign := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.AuthorizationHeader, "Bearer "+sign("u1"), grpc_server.UserIdHeader, "u2")
+	got, err := callUnary(a, ctx, pb.Account_Service_GetUserProfile_FullMethodName, &pb.GetUserProfileRequest{Uuid: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, "u1", got.Value(domain.Uuid))
+}
+
+// Тест неизвестного сервисного токена.
+func TestAuthInterceptor_InvalidServiceToken(t *testing.T) {
+	a, _ := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.ServiceTokenHeader, "wrong")
+	_, err := callUnary(a, ctx, pb.Account_Service_CreateUser_FullMethodName, &pb.CreateUserRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// Тест метода, которого нет в таблице прав.
+func TestAuthInterceptor_UnknownMethod(t *testing.T) {
+	a, _ := newAuthInterceptor(t)
+
+	ctx := withMetadata(grpc_server.ServiceTokenHeader, "secret")
+	_, err := callUnary(a, ctx, "/api.Account_Service/Unknown", &pb.PingRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
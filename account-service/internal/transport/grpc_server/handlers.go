@@ -2,9 +2,10 @@ package grpc_server
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/internal/service"
 	pb "gitlab.com/pisya-dev/account-service/pkg/api/account_service"
 	"gitlab.com/pisya-dev/account-service/pkg/jwt"
 	"google.golang.org/grpc/codes"
@@ -13,12 +14,18 @@ import (
 
 type Service interface {
 	CreateUser(context.Context, *domain.User) error
-	UpdateUser(context.Context, *domain.User) error
+	UpdateUser(ctx context.Context, user *domain.User, fields []string) (*domain.User, error)
 	DeleteUser(context.Context, *domain.User) error
 
 	CreateBuisness(context.Context, *domain.Business) error
-	GetBuisness(context.Context, *domain.Business) (string, error)
+	GetBuisness(context.Context, *domain.Business) (*domain.Business, error)
+	UpdateBusiness(context.Context, *domain.Business) error
+	DeleteBusiness(ctx context.Context, businessId string) error
+	ListBusinesses(context.Context, *domain.BusinessFilter) ([]domain.Business, int64, error)
 	GetUser(context.Context, *domain.User) (*domain.User, error)
+
+	ConvertReferral(context.Context, *domain.ReferralConversion) (string, bool, error)
+	GetReferralStats(ctx context.Context, userId string) (*domain.ReferralStats, error)
 }
 
 type Server struct {
@@ -43,6 +50,8 @@ func (s *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb
 		Avatar_url: req.GetAvatarUrl(),
 		Age:        req.GetAge(),
 		Country:    req.GetCountry(),
+
+		ReferrerCode: req.GetReferrerCode(),
 	}
 
 	err := s.service.CreateUser(ctx, user)
@@ -64,12 +73,26 @@ func (s *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb
 		Country:    req.GetCountry(),
 	}
 
-	err := s.service.UpdateUser(ctx, user)
+	userProfile, err := s.service.UpdateUser(ctx, user, req.GetUpdateMask().GetPaths())
+	if errors.Is(err, service.ErrInvalidUser) {
+		return &pb.UpdateUserResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrUserNotFound) {
+		return &pb.UpdateUserResponse{}, status.Errorf(codes.NotFound, "filed found user")
+	}
 	if err != nil {
 		return &pb.UpdateUserResponse{}, status.Errorf(codes.Internal, "filed update user")
 	}
 
-	return &pb.UpdateUserResponse{}, nil
+	return &pb.UpdateUserResponse{
+		Name:      userProfile.Name,
+		Surname:   userProfile.Surname,
+		AvatarUrl: userProfile.Avatar_url,
+		Age:       userProfile.Age,
+		Country:   userProfile.Country,
+
+		ReferralCode: userProfile.ReferralCode,
+	}, nil
 }
 
 func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
@@ -86,15 +109,10 @@ func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb
 
 func (s *Server) CreateBuisness(ctx context.Context, req *pb.CreateBuisnessRequest) (*pb.CreateBuisnessResponse, error) {
 
-	fmt.Println(req)
+	// id компании передаёт auth-service в UserIdHeader вместе с сервисным токеном
 	id, ok := ctx.Value(domain.Uuid).(string)
-
-	if !ok {
-		if req.GetUuid() == "" {
-			return &pb.CreateBuisnessResponse{}, status.Errorf(codes.Internal, "nothing uuid or jwt")
-		}
-		id = req.Uuid
-
+	if !ok || id == "" {
+		return &pb.CreateBuisnessResponse{}, status.Errorf(codes.InvalidArgument, "nothing uuid in metadata")
 	}
 
 	bis := &domain.Business{
@@ -114,12 +132,109 @@ func (s *Server) GetBuisness(ctx context.Context, req *pb.GetBuisnessRequest) (*
 		Guid: req.GetId(),
 	}
 
-	name, err := s.service.GetBuisness(ctx, bis)
-	if err != nil {
+	profile, err := s.service.GetBuisness(ctx, bis)
+	if errors.Is(err, service.ErrBusinessNotFound) {
 		return &pb.GetBuisnessResponse{}, status.Errorf(codes.NotFound, "filed found business")
 	}
+	if err != nil {
+		return &pb.GetBuisnessResponse{}, status.Errorf(codes.Internal, "filed get business")
+	}
+
+	return &pb.GetBuisnessResponse{Name: profile.Name, Profile: businessToPb(profile)}, nil
+}
+
+func (s *Server) UpdateBusiness(ctx context.Context, req *pb.UpdateBusinessRequest) (*pb.UpdateBusinessResponse, error) {
+	id, ok := ctx.Value(domain.Uuid).(string)
+
+	if !ok {
+		if req.GetId() == "" {
+			return &pb.UpdateBusinessResponse{}, status.Errorf(codes.InvalidArgument, "nothing uuid or jwt")
+		}
+		id = req.GetId()
+	}
+
+	bis := &domain.Business{
+		Guid:         id,
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		LogoUrl:      req.GetLogoUrl(),
+		Website:      req.GetWebsite(),
+		ContactEmail: req.GetContactEmail(),
+		Categories:   req.GetCategories(),
+		Country:      req.GetCountry(),
+	}
+
+	err := s.service.UpdateBusiness(ctx, bis)
+	if errors.Is(err, service.ErrInvalidBusiness) {
+		return &pb.UpdateBusinessResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrBusinessNotFound) {
+		return &pb.UpdateBusinessResponse{}, status.Errorf(codes.NotFound, "filed found business")
+	}
+	if err != nil {
+		return &pb.UpdateBusinessResponse{}, status.Errorf(codes.Internal, "filed update business")
+	}
 
-	return &pb.GetBuisnessResponse{Name: name}, nil
+	return &pb.UpdateBusinessResponse{Profile: businessToPb(bis)}, nil
+}
+
+func (s *Server) DeleteBusiness(ctx context.Context, req *pb.DeleteBusinessRequest) (*pb.DeleteBusinessResponse, error) {
+	id, ok := ctx.Value(domain.Uuid).(string)
+
+	if !ok {
+		if req.GetId() == "" {
+			return &pb.DeleteBusinessResponse{}, status.Errorf(codes.InvalidArgument, "nothing uuid or jwt")
+		}
+		id = req.GetId()
+	}
+
+	err := s.service.DeleteBusiness(ctx, id)
+	if errors.Is(err, service.ErrBusinessNotFound) {
+		return &pb.DeleteBusinessResponse{}, status.Errorf(codes.NotFound, "filed found business")
+	}
+	if err != nil {
+		return &pb.DeleteBusinessResponse{}, status.Errorf(codes.Internal, "filed delete business")
+	}
+
+	return &pb.DeleteBusinessResponse{}, nil
+}
+
+func (s *Server) ListBusinesses(ctx context.Context, req *pb.ListBusinessesRequest) (*pb.ListBusinessesResponse, error) {
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return &pb.ListBusinessesResponse{}, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	filter := &domain.BusinessFilter{
+		Country:  req.GetCountry(),
+		Category: req.GetCategory(),
+		Limit:    req.GetLimit(),
+		Offset:   req.GetOffset(),
+	}
+
+	businesses, total, err := s.service.ListBusinesses(ctx, filter)
+	if err != nil {
+		return &pb.ListBusinessesResponse{}, status.Errorf(codes.Internal, "filed list businesses")
+	}
+
+	profiles := make([]*pb.BusinessProfile, 0, len(businesses))
+	for i := range businesses {
+		profiles = append(profiles, businessToPb(&businesses[i]))
+	}
+
+	return &pb.ListBusinessesResponse{Businesses: profiles, Total: total}, nil
+}
+
+func businessToPb(bis *domain.Business) *pb.BusinessProfile {
+	return &pb.BusinessProfile{
+		Id:           bis.Guid,
+		Name:         bis.Name,
+		Description:  bis.Description,
+		LogoUrl:      bis.LogoUrl,
+		Website:      bis.Website,
+		ContactEmail: bis.ContactEmail,
+		Categories:   bis.Categories,
+		Country:      bis.Country,
+	}
 }
 
 func (s *Server) GetUserProfile(ctx context.Context, req *pb.GetUserProfileRequest) (*pb.GetUserProfileResponse, error) {
@@ -135,8 +250,66 @@ func (s *Server) GetUserProfile(ctx context.Context, req *pb.GetUserProfileReque
 
 	return &pb.GetUserProfileResponse{Name: userProfile.Name,
 		Surname:   userProfile.Surname,
-		Age:       user.Age,
+		Age:       userProfile.Age,
 		AvatarUrl: userProfile.Avatar_url,
 		Country:   userProfile.Country,
+
+		ReferralCode: userProfile.ReferralCode,
+	}, nil
+}
+
+func (s *Server) ConvertReferral(ctx context.Context, req *pb.ConvertReferralRequest) (*pb.ConvertReferralResponse, error) {
+	if req.GetRefereeId() == "" || req.GetPromoId() == "" || req.GetCompanyId() == "" {
+		return &pb.ConvertReferralResponse{}, status.Errorf(codes.InvalidArgument, "referee_id, promo_id and company_id are required")
+	}
+
+	conversion := &domain.ReferralConversion{
+		RefereeId: req.GetRefereeId(),
+		PromoId:   req.GetPromoId(),
+		CompanyId: req.GetCompanyId(),
+	}
+
+	if req.Reward != nil {
+		if req.Reward.GetAmount() <= 0 || len(req.Reward.GetCurrency()) != 3 {
+			return &pb.ConvertReferralResponse{}, status.Errorf(codes.InvalidArgument, "reward requires positive amount and ISO 4217 currency")
+		}
+
+		conversion.Reward = &domain.ReferralReward{
+			Amount:   req.Reward.GetAmount(),
+			Currency: req.Reward.GetCurrency(),
+		}
+	}
+
+	referrerId, credited, err := s.service.ConvertReferral(ctx, conversion)
+	if err != nil {
+		return &pb.ConvertReferralResponse{}, status.Errorf(codes.Internal, "filed convert referral")
+	}
+
+	return &pb.ConvertReferralResponse{
+		Referred:   referrerId != "",
+		ReferrerId: referrerId,
+		Credited:   credited,
+	}, nil
+}
+
+func (s *Server) GetReferralStats(ctx context.Context, req *pb.GetReferralStatsRequest) (*pb.GetReferralStatsResponse, error) {
+	stats, err := s.service.GetReferralStats(ctx, req.GetUserId())
+	if errors.Is(err, service.ErrUserNotFound) {
+		return &pb.GetReferralStatsResponse{}, status.Errorf(codes.NotFound, "filed found user")
+	}
+	if err != nil {
+		return &pb.GetReferralStatsResponse{}, status.Errorf(codes.Internal, "filed get referral stats")
+	}
+
+	rewards := make([]*pb.ReferrerReward, 0, len(stats.Rewards))
+	for _, reward := range stats.Rewards {
+		rewards = append(rewards, &pb.ReferrerReward{Amount: reward.Amount, Currency: reward.Currency})
+	}
+
+	return &pb.GetReferralStatsResponse{
+		ReferralCode: stats.ReferralCode,
+		Invited:      stats.Invited,
+		Converted:    stats.Converted,
+		Rewards:      rewards,
 	}, nil
 }
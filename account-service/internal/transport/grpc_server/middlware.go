@@ -2,38 +2,78 @@ package grpc_server
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"gitlab.com/pisya-dev/account-service/internal/domain"
 	"gitlab.com/pisya-dev/account-service/pkg/logger"
+	"gitlab.com/pisya-dev/account-service/pkg/reqlog"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
-func MiddlewareInterceptor(
+// RequestIDHeader сквозной идентификатор запроса. Его выдаёт auth-service, account-service
+// принимает его из metadata и возвращает в заголовках ответа
+const RequestIDHeader = "x-request-id"
 
-	ctx context.Context,
+// requestIDMaxLen ограничивает длину принятого идентификатора, чтобы он не раздувал логи
+const requestIDMaxLen = 128
 
-	req interface{},
+// MiddlewareInterceptor кладёт в контекст логгер и идентификатор запроса и пишет одну строку о вызове.
+// Уровень, семплирование успешных вызовов и скрытие полей тела запроса задаёт policy
+func MiddlewareInterceptor(policy *reqlog.Policy) grpc.UnaryServerInterceptor {
+	logRequest := policy.UnaryServerInterceptor(func(ctx context.Context, level zapcore.Level, msg string, fields ...zap.Field) {
+		logger.GetLoggerFromCtx(ctx).Log(ctx, level, msg, fields...)
+	})
 
-	info *grpc.UnaryServerInfo,
+	return func(
 
-	next grpc.UnaryHandler,
+		ctx context.Context,
 
-) (any, error) {
-	guid := uuid.New()
-	str := strconv.FormatUint(uint64(guid.ID()), 10)
+		req interface{},
 
-	ctx = context.WithValue(ctx, domain.RequestID, str)
+		info *grpc.UnaryServerInfo,
 
-	ctx, _ = logger.New(ctx)
+		next grpc.UnaryHandler,
 
-	logger.GetLoggerFromCtx(ctx).Info(ctx,
-		"request", zap.String("method", info.FullMethod),
-		zap.Time("request time", time.Now()), zap.String("request id:", str))
+	) (any, error) {
+		requestID := incomingRequestID(ctx)
 
-	return next(ctx, req)
+		ctx = context.WithValue(ctx, domain.RequestID, requestID)
+
+		ctx, _ = logger.New(ctx)
+
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID)); err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, "filed set request id header", zap.Error(err))
+		}
+
+		return logRequest(ctx, req, info, next)
+	}
+}
+
+// incomingRequestID берёт идентификатор вызывающего сервиса или создаёт новый, если его нет
+// или он не похож на идентификатор
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(RequestIDHeader); len(values) > 0 && validRequestID(values[0]) {
+		return values[0]
+	}
+
+	return uuid.NewString()
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > requestIDMaxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.' || c == ':') {
+			return false
+		}
+	}
+
+	return true
 }
@@ -6,11 +6,20 @@ import (
 
 	"gitlab.com/pisya-dev/account-service/internal/domain"
 	"gitlab.com/pisya-dev/account-service/internal/transport/grpc_server"
+	"gitlab.com/pisya-dev/account-service/pkg/reqlog"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
+func newLogPolicy(t *testing.T) *reqlog.Policy {
+	policy, err := reqlog.NewPolicy(reqlog.Config{SuccessSampleRate: 1})
+	require.NoError(t, err)
+	return policy
+}
+
 func TestMiddlewareInterceptor(t *testing.T) {
 	// Входные значения
 	req := "test request"
@@ -33,10 +42,36 @@ func TestMiddlewareInterceptor(t *testing.T) {
 	}
 
 	// Вызов middleware
-	resp, err := grpc_server.MiddlewareInterceptor(context.Background(), req, info, next)
+	resp, err := grpc_server.MiddlewareInterceptor(newLogPolicy(t))(context.Background(), req, info, next)
 
 	// Проверки
 	assert.NoError(t, err)
 	assert.Equal(t, "response", resp)
 	assert.True(t, called, "next handler должен быть вызван")
 }
+
+// Тест: идентификатор из metadata вызывающего сервиса сохраняется.
+func TestMiddlewareInterceptor_PropagatesRequestID(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpc_server.RequestIDHeader, "req-123"))
+
+	_, err := grpc_server.MiddlewareInterceptor(newLogPolicy(t))(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, "req-123", ctx.Value(domain.RequestID))
+		return nil, nil
+	})
+	assert.NoError(t, err)
+}
+
+// Тест: некорректный идентификатор заменяется новым.
+func TestMiddlewareInterceptor_ReplacesInvalidRequestID(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpc_server.RequestIDHeader, "bad id\nwith newline"))
+
+	_, err := grpc_server.MiddlewareInterceptor(newLogPolicy(t))(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		requestID, _ := ctx.Value(domain.RequestID).(string)
+		assert.NotEqual(t, "bad id\nwith newline", requestID)
+		assert.NotEmpty(t, requestID)
+		return nil, nil
+	})
+	assert.NoError(t, err)
+}
@@ -9,9 +9,17 @@ import (
 	"gitlab.com/pisya-dev/account-service/internal/config"
 	pb "gitlab.com/pisya-dev/account-service/pkg/api/account_service"
 	"gitlab.com/pisya-dev/account-service/pkg/logger"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/mtls"
+	"gitlab.com/pisya-dev/account-service/pkg/reqlog"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type GrpcServer struct {
@@ -19,6 +27,8 @@ type GrpcServer struct {
 	server *grpc.Server
 
 	accountSrvc *Server
+
+	health *health.Server
 }
 
 func (g *GrpcServer) Run(ctx context.Context) {
@@ -29,19 +39,53 @@ func (g *GrpcServer) Run(ctx context.Context) {
 
 	logger.GetLoggerFromCtx(ctx).Info(ctx, "Server start on:", zap.String("url", lis.Addr().String()), zap.String("cfg port:", strconv.Itoa(g.cfg.GRPCPort)))
 
-	g.server = grpc.NewServer(
-		grpc.UnaryInterceptor(MiddlewareInterceptor),
-	)
+	logPolicy, err := reqlog.NewPolicy(reqlog.Config{
+		Levels:            g.cfg.Log.MethodLevels,
+		SuccessSampleRate: g.cfg.Log.SuccessSampleRate,
+		Redact:            g.cfg.Log.RedactFields,
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed configure request logging", zap.Error(err))
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{metrics.UnaryServerInterceptor, MiddlewareInterceptor(logPolicy)}
+	opts := []grpc.ServerOption{grpc.StatsHandler(tracing.ServerHandler())}
+
+	if g.cfg.TLS.Enabled {
+		reloader, err := mtls.NewReloader(mtls.Config{
+			CertFile:       g.cfg.TLS.CertFile,
+			KeyFile:        g.cfg.TLS.KeyFile,
+			CAFile:         g.cfg.TLS.CAFile,
+			ReloadInterval: g.cfg.TLS.ReloadInterval,
+		}, func(err error) {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, "filed reload tls certificates", zap.Error(err))
+		})
+		if err != nil {
+			logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed load tls certificates", zap.Error(err))
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.ServerTLSConfig())))
+		interceptors = append(interceptors, mtls.NewAllowList(g.cfg.TLS.AllowedPeers).UnaryServerInterceptor())
+	} else {
+		// без mTLS пиры не проверяются и TLS_ALLOWED_PEERS не действует
+		logger.GetLoggerFromCtx(ctx).Log(ctx, zapcore.WarnLevel, "grpc tls disabled: peer allow-list is not enforced")
+	}
+
+	interceptors = append(interceptors, NewAuthInterceptor(g.accountSrvc.JWTservice, g.cfg.ServiceTokens).Unary)
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...))
+
+	g.server = grpc.NewServer(opts...)
 
 	pb.RegisterAccount_ServiceServer(g.server, g.accountSrvc)
+	healthpb.RegisterHealthServer(g.server, g.health)
 
 	if err := g.server.Serve(lis); err != nil {
 		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed to serve", zap.Error(err))
 	}
 }
 
-func NewGRPCServer(cfg *config.Config, accountServer *Server) *GrpcServer {
-	return &GrpcServer{cfg: cfg, accountSrvc: accountServer}
+func NewGRPCServer(cfg *config.Config, accountServer *Server, healthServer *health.Server) *GrpcServer {
+	return &GrpcServer{cfg: cfg, accountSrvc: accountServer, health: healthServer}
 }
 
 func (g *GrpcServer) ShutDown() {
@@ -19,13 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Account_Service_Ping_FullMethodName           = "/api.Account_Service/Ping"
-	Account_Service_GetUserProfile_FullMethodName = "/api.Account_Service/GetUserProfile"
-	Account_Service_CreateUser_FullMethodName     = "/api.Account_Service/CreateUser"
-	Account_Service_UpdateUser_FullMethodName     = "/api.Account_Service/UpdateUser"
-	Account_Service_DeleteUser_FullMethodName     = "/api.Account_Service/DeleteUser"
-	Account_Service_CreateBuisness_FullMethodName = "/api.Account_Service/CreateBuisness"
-	Account_Service_GetBuisness_FullMethodName    = "/api.Account_Service/GetBuisness"
+	Account_Service_Ping_FullMethodName             = "/api.Account_Service/Ping"
+	Account_Service_GetUserProfile_FullMethodName   = "/api.Account_Service/GetUserProfile"
+	Account_Service_CreateUser_FullMethodName       = "/api.Account_Service/CreateUser"
+	Account_Service_UpdateUser_FullMethodName       = "/api.Account_Service/UpdateUser"
+	Account_Service_DeleteUser_FullMethodName       = "/api.Account_Service/DeleteUser"
+	Account_Service_CreateBuisness_FullMethodName   = "/api.Account_Service/CreateBuisness"
+	Account_Service_GetBuisness_FullMethodName      = "/api.Account_Service/GetBuisness"
+	Account_Service_UpdateBusiness_FullMethodName   = "/api.Account_Service/UpdateBusiness"
+	Account_Service_DeleteBusiness_FullMethodName   = "/api.Account_Service/DeleteBusiness"
+	Account_Service_ListBusinesses_FullMethodName   = "/api.Account_Service/ListBusinesses"
+	Account_Service_ConvertReferral_FullMethodName  = "/api.Account_Service/ConvertReferral"
+	Account_Service_GetReferralStats_FullMethodName = "/api.Account_Service/GetReferralStats"
 )
 
 // Account_ServiceClient is the client API for Account_Service service.
@@ -39,6 +44,11 @@ type Account_ServiceClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	CreateBuisness(ctx context.Context, in *CreateBuisnessRequest, opts ...grpc.CallOption) (*CreateBuisnessResponse, error)
 	GetBuisness(ctx context.Context, in *GetBuisnessRequest, opts ...grpc.CallOption) (*GetBuisnessResponse, error)
+	UpdateBusiness(ctx context.Context, in *UpdateBusinessRequest, opts ...grpc.CallOption) (*UpdateBusinessResponse, error)
+	DeleteBusiness(ctx context.Context, in *DeleteBusinessRequest, opts ...grpc.CallOption) (*DeleteBusinessResponse, error)
+	ListBusinesses(ctx context.Context, in *ListBusinessesRequest, opts ...grpc.CallOption) (*ListBusinessesResponse, error)
+	ConvertReferral(ctx context.Context, in *ConvertReferralRequest, opts ...grpc.CallOption) (*ConvertReferralResponse, error)
+	GetReferralStats(ctx context.Context, in *GetReferralStatsRequest, opts ...grpc.CallOption) (*GetReferralStatsResponse, error)
 }
 
 type account_ServiceClient struct {
@@ -119,6 +129,56 @@ func (c *account_ServiceClient) GetBuisness(ctx context.Context, in *GetBuisness
 	return out, nil
 }
 
+func (c *account_ServiceClient) UpdateBusiness(ctx context.Context, in *UpdateBusinessRequest, opts ...grpc.CallOption) (*UpdateBusinessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBusinessResponse)
+	err := c.cc.Invoke(ctx, Account_Service_UpdateBusiness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *account_ServiceClient) DeleteBusiness(ctx context.Context, in *DeleteBusinessRequest, opts ...grpc.CallOption) (*DeleteBusinessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBusinessResponse)
+	err := c.cc.Invoke(ctx, Account_Service_DeleteBusiness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *account_ServiceClient) ListBusinesses(ctx context.Context, in *ListBusinessesRequest, opts ...grpc.CallOption) (*ListBusinessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBusinessesResponse)
+	err := c.cc.Invoke(ctx, Account_Service_ListBusinesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *account_ServiceClient) ConvertReferral(ctx context.Context, in *ConvertReferralRequest, opts ...grpc.CallOption) (*ConvertReferralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertReferralResponse)
+	err := c.cc.Invoke(ctx, Account_Service_ConvertReferral_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *account_ServiceClient) GetReferralStats(ctx context.Context, in *GetReferralStatsRequest, opts ...grpc.CallOption) (*GetReferralStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReferralStatsResponse)
+	err := c.cc.Invoke(ctx, Account_Service_GetReferralStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Account_ServiceServer is the server API for Account_Service service.
 // All implementations must embed UnimplementedAccount_ServiceServer
 // for forward compatibility.
@@ -130,6 +190,11 @@ type Account_ServiceServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	CreateBuisness(context.Context, *CreateBuisnessRequest) (*CreateBuisnessResponse, error)
 	GetBuisness(context.Context, *GetBuisnessRequest) (*GetBuisnessResponse, error)
+	UpdateBusiness(context.Context, *UpdateBusinessRequest) (*UpdateBusinessResponse, error)
+	DeleteBusiness(context.Context, *DeleteBusinessRequest) (*DeleteBusinessResponse, error)
+	ListBusinesses(context.Context, *ListBusinessesRequest) (*ListBusinessesResponse, error)
+	ConvertReferral(context.Context, *ConvertReferralRequest) (*ConvertReferralResponse, error)
+	GetReferralStats(context.Context, *GetReferralStatsRequest) (*GetReferralStatsResponse, error)
 	mustEmbedUnimplementedAccount_ServiceServer()
 }
 
@@ -161,6 +226,21 @@ func (UnimplementedAccount_ServiceServer) CreateBuisness(context.Context, *Creat
 func (UnimplementedAccount_ServiceServer) GetBuisness(context.Context, *GetBuisnessRequest) (*GetBuisnessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBuisness not implemented")
 }
+func (UnimplementedAccount_ServiceServer) UpdateBusiness(context.Context, *UpdateBusinessRequest) (*UpdateBusinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBusiness not implemented")
+}
+func (UnimplementedAccount_ServiceServer) DeleteBusiness(context.Context, *DeleteBusinessRequest) (*DeleteBusinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBusiness not implemented")
+}
+func (UnimplementedAccount_ServiceServer) ListBusinesses(context.Context, *ListBusinessesRequest) (*ListBusinessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBusinesses not implemented")
+}
+func (UnimplementedAccount_ServiceServer) ConvertReferral(context.Context, *ConvertReferralRequest) (*ConvertReferralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertReferral not implemented")
+}
+func (UnimplementedAccount_ServiceServer) GetReferralStats(context.Context, *GetReferralStatsRequest) (*GetReferralStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferralStats not implemented")
+}
 func (UnimplementedAccount_ServiceServer) mustEmbedUnimplementedAccount_ServiceServer() {}
 func (UnimplementedAccount_ServiceServer) testEmbeddedByValue()                         {}
 
@@ -308,6 +388,96 @@ func _Account_Service_GetBuisness_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Account_Service_UpdateBusiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBusinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Account_ServiceServer).UpdateBusiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_Service_UpdateBusiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Account_ServiceServer).UpdateBusiness(ctx, req.(*UpdateBusinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_Service_DeleteBusiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBusinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Account_ServiceServer).DeleteBusiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_Service_DeleteBusiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Account_ServiceServer).DeleteBusiness(ctx, req.(*DeleteBusinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_Service_ListBusinesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBusinessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Account_ServiceServer).ListBusinesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_Service_ListBusinesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Account_ServiceServer).ListBusinesses(ctx, req.(*ListBusinessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_Service_ConvertReferral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertReferralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Account_ServiceServer).ConvertReferral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_Service_ConvertReferral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Account_ServiceServer).ConvertReferral(ctx, req.(*ConvertReferralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_Service_GetReferralStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferralStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Account_ServiceServer).GetReferralStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_Service_GetReferralStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Account_ServiceServer).GetReferralStats(ctx, req.(*GetReferralStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Account_Service_ServiceDesc is the grpc.ServiceDesc for Account_Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +513,26 @@ var Account_Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBuisness",
 			Handler:    _Account_Service_GetBuisness_Handler,
 		},
+		{
+			MethodName: "UpdateBusiness",
+			Handler:    _Account_Service_UpdateBusiness_Handler,
+		},
+		{
+			MethodName: "DeleteBusiness",
+			Handler:    _Account_Service_DeleteBusiness_Handler,
+		},
+		{
+			MethodName: "ListBusinesses",
+			Handler:    _Account_Service_ListBusinesses_Handler,
+		},
+		{
+			MethodName: "ConvertReferral",
+			Handler:    _Account_Service_ConvertReferral_Handler,
+		},
+		{
+			MethodName: "GetReferralStats",
+			Handler:    _Account_Service_GetReferralStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/account.proto",
@@ -0,0 +1,215 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var (
+	ErrNotChecked   = errors.New("not checked yet")
+	ErrShuttingDown = errors.New("shutting down")
+)
+
+// Check проверяет доступность одной зависимости
+type Check func(ctx context.Context) error
+
+// GRPCCheck проверяет downstream gRPC-сервис по протоколу grpc.health.v1
+func GRPCCheck(conn grpc.ClientConnInterface) Check {
+	client := healthpb.NewHealthClient(conn)
+
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("status %s", resp.GetStatus())
+		}
+
+		return nil
+	}
+}
+
+// Checker периодически проверяет зависимости сервиса. Сервис готов, когда доступны
+// все зависимости и не началась остановка. Если передан server, результат публикуется
+// через grpc.health.v1: у каждой зависимости свой статус под её именем, а "" и переданные
+// gRPC-сервисы готовы, только когда готов сервис
+type Checker struct {
+	server   *health.Server
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+	onChange func(name string, err error)
+
+	names  []string
+	checks map[string]Check
+
+	mu       sync.RWMutex
+	statuses map[string]error
+	shutdown bool
+}
+
+// NewChecker создаёт проверку зависимостей. server может быть nil, тогда готовность отдаётся
+// только через Ready. onChange вызывается, когда зависимость становится доступной или недоступной
+func NewChecker(server *health.Server, interval time.Duration, timeout time.Duration, onChange func(name string, err error), services ...string) *Checker {
+	c := &Checker{
+		server:   server,
+		services: append([]string{""}, services...),
+		interval: interval,
+		timeout:  timeout,
+		onChange: onChange,
+		checks:   make(map[string]Check),
+		statuses: make(map[string]error),
+	}
+
+	c.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING, c.services...)
+
+	return c
+}
+
+// Add регистрирует зависимость, до первой проверки она считается недоступной
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.names = append(c.names, name)
+	c.checks[name] = check
+	c.statuses[name] = ErrNotChecked
+	c.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING, name)
+}
+
+// Run проверяет зависимости сразу и затем каждые interval, пока не отменён ctx
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll параллельно проверяет все зависимости и обновляет статусы
+func (c *Checker) CheckAll(ctx context.Context) {
+	c.mu.RLock()
+	names := append([]string(nil), c.names...)
+	c.mu.RUnlock()
+
+	results := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			results[i] = c.checks[name](checkCtx)
+		}()
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ready := true
+	for i, name := range names {
+		err := results[i]
+		if (err == nil) != (c.statuses[name] == nil) && c.onChange != nil {
+			c.onChange(name, err)
+		}
+		c.statuses[name] = err
+
+		if c.shutdown {
+			continue
+		}
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			ready = false
+		}
+		c.setServingStatus(status, name)
+	}
+
+	if c.shutdown {
+		return
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if !ready {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.setServingStatus(status, c.services...)
+}
+
+// Statuses результат последней проверки: nil для доступной зависимости
+func (c *Checker) Statuses() map[string]error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	statuses := make(map[string]error, len(c.statuses))
+	for name, err := range c.statuses {
+		statuses[name] = err
+	}
+
+	return statuses
+}
+
+// Ready возвращает готовность сервиса и статус каждой зависимости: "ok" или текст ошибки.
+// Во время остановки в статусах появляется "shutdown"
+func (c *Checker) Ready() (bool, map[string]string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ready := !c.shutdown
+	statuses := make(map[string]string, len(c.statuses)+1)
+	if c.shutdown {
+		statuses["shutdown"] = ErrShuttingDown.Error()
+	}
+	for name, err := range c.statuses {
+		if err != nil {
+			ready = false
+			statuses[name] = err.Error()
+			continue
+		}
+		statuses[name] = "ok"
+	}
+
+	return ready, statuses
+}
+
+// Shutdown переводит сервис в неготовое состояние до остановки сервера, чтобы балансировщик
+// перестал направлять запросы, пока обрабатываются текущие
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shutdown = true
+	if c.server != nil {
+		c.server.Shutdown()
+	}
+}
+
+func (c *Checker) setServingStatus(status healthpb.HealthCheckResponse_ServingStatus, services ...string) {
+	if c.server == nil {
+		return
+	}
+
+	for _, service := range services {
+		c.server.SetServingStatus(service, status)
+	}
+}
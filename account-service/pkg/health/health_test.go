@@ -0,0 +1,151 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	healthcheck "gitlab.com/pisya-dev/account-service/pkg/health"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeConn отвечает на Health/Check заданным статусом или ошибкой
+type fakeConn struct {
+	grpc.ClientConnInterface
+	status healthpb.HealthCheckResponse_ServingStatus
+	err    error
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	if c.err != nil {
+		return c.err
+	}
+	proto.Merge(reply.(*healthpb.HealthCheckResponse), &healthpb.HealthCheckResponse{Status: c.status})
+	return nil
+}
+
+func servingStatus(t *testing.T, server *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+
+	return resp.GetStatus()
+}
+
+// Тест: до первой проверки сервис не готов.
+func TestChecker_NotReadyBeforeFirstCheck(t *testing.T) {
+	server := health.NewServer()
+	checker := healthcheck.NewChecker(server, time.Second, time.Second, nil, "api.Account_Service")
+	checker.Add("postgres", func(ctx context.Context) error { return nil })
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "postgres"))
+}
+
+// Тест статусов по зависимостям: недоступный redis делает сервис неготовым.
+func TestChecker_PerDependencyStatus(t *testing.T) {
+	ctx := context.Background()
+
+	changed := map[string]error{}
+	server := health.NewServer()
+	checker := healthcheck.NewChecker(server, time.Second, time.Second, func(name string, err error) {
+		changed[name] = err
+	}, "api.Account_Service")
+
+	redisErr := errors.New("connection refused")
+	checker.Add("postgres", func(ctx context.Context) error { return nil })
+	checker.Add("redis", func(ctx context.Context) error { return redisErr })
+
+	checker.CheckAll(ctx)
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, "postgres"))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "redis"))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "api.Account_Service"))
+	assert.Equal(t, map[string]error{"postgres": nil, "redis": redisErr}, checker.Statuses())
+	// о смене статуса сообщается только для зависимости, которая стала доступной
+	assert.Equal(t, map[string]error{"postgres": nil}, changed)
+
+	redisErr = nil
+	checker.CheckAll(ctx)
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, "api.Account_Service"))
+}
+
+// Тест таймаута проверки зависимости.
+func TestChecker_Timeout(t *testing.T) {
+	ctx := context.Background()
+
+	server := health.NewServer()
+	checker := healthcheck.NewChecker(server, time.Second, 10*time.Millisecond, nil)
+	checker.Add("postgres", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	checker.CheckAll(ctx)
+
+	assert.ErrorIs(t, checker.Statuses()["postgres"], context.DeadlineExceeded)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+}
+
+// Тест остановки: после Shutdown сервис не готов, даже если зависимости доступны.
+func TestChecker_Shutdown(t *testing.T) {
+	ctx := context.Background()
+
+	server := health.NewServer()
+	checker := healthcheck.NewChecker(server, time.Second, time.Second, nil)
+	checker.Add("postgres", func(ctx context.Context) error { return nil })
+
+	checker.CheckAll(ctx)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+
+	checker.Shutdown()
+	checker.CheckAll(ctx)
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "postgres"))
+}
+
+func TestGRPCCheck(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, healthcheck.GRPCCheck(&fakeConn{status: healthpb.HealthCheckResponse_SERVING})(ctx))
+	require.Error(t, healthcheck.GRPCCheck(&fakeConn{status: healthpb.HealthCheckResponse_NOT_SERVING})(ctx))
+	require.Error(t, healthcheck.GRPCCheck(&fakeConn{err: errors.New("connection refused")})(ctx))
+}
+
+// Тест готовности без gRPC health-сервера, как в auth-service для /readyz.
+func TestChecker_Ready(t *testing.T) {
+	ctx := context.Background()
+
+	checker := healthcheck.NewChecker(nil, time.Second, time.Second, nil)
+	checker.Add("postgres", func(ctx context.Context) error { return nil })
+	checker.Add("redis", func(ctx context.Context) error { return errors.New("connection refused") })
+
+	ready, statuses := checker.Ready()
+	assert.False(t, ready)
+	assert.Equal(t, map[string]string{"postgres": healthcheck.ErrNotChecked.Error(), "redis": healthcheck.ErrNotChecked.Error()}, statuses)
+
+	checker.CheckAll(ctx)
+
+	ready, statuses = checker.Ready()
+	assert.False(t, ready)
+	assert.Equal(t, map[string]string{"postgres": "ok", "redis": "connection refused"}, statuses)
+
+	checker.Shutdown()
+	checker.CheckAll(ctx)
+
+	ready, statuses = checker.Ready()
+	assert.False(t, ready)
+	assert.Equal(t, healthcheck.ErrShuttingDown.Error(), statuses["shutdown"])
+}
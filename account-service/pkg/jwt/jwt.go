@@ -17,6 +17,13 @@ var (
 	ErrorInvalidToken   = fmt.Errorf(ErrorInvalidTokenString)
 )
 
+// Claims claims токена auth-service вместе с его типом: access и refresh токены
+// выпускаются с одинаковым subject и различаются только claim typ
+type Claims struct {
+	jwt.RegisteredClaims
+	Type mode `json:"typ"`
+}
+
 type ServiceJWT struct {
 	publicKey      *rsa.PublicKey
 	RefreshTimeExp time.Duration
@@ -53,6 +60,29 @@ func (j *ServiceJWT) DecodeKey(tokenString string) (*jwt.RegisteredClaims, error
 	return nil, ErrorInvalidToken
 }
 
+// DecodeAccessKey проверяет токен как DecodeKey и принимает только access token:
+// refresh token нельзя использовать для вызова методов
+func (j *ServiceJWT) DecodeAccessKey(tokenString string) (*Claims, error) {
+	if tokenString == "" {
+		return nil, ErrorUndefinedToken
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{},
+		func(token *jwt.Token) (interface{}, error) {
+			return j.publicKey, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if ok && token.Valid && claims.Type == AccessTokenMode {
+		return claims, nil
+	}
+
+	return nil, ErrorInvalidToken
+}
+
 func (j *ServiceJWT) Encode(claims jwt.Claims, privetToken *rsa.PrivateKey) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 	tokenString, err := token.SignedString(privetToken)
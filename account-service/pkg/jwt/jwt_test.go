@@ -58,6 +58,37 @@ func TestDecodeKey_InvalidToken(t *testing.T) {
 	assert.Nil(t, claims)
 	assert.Error(t, err)
 }
+
+func TestDecodeAccessKey(t *testing.T) {
+	privKey, pubKey := generateKeys(t)
+	svc := jwtlib.NewServiceJWT(pubKey, time.Hour*24, time.Minute*15)
+
+	access, err := svc.Encode(svc.GetClaims("user123", jwtlib.AccessTokenMode), privKey)
+	require.NoError(t, err)
+
+	claims, err := svc.DecodeAccessKey(access)
+	require.NoError(t, err)
+	assert.Equal(t, "user123", claims.Subject)
+
+	refresh, err := svc.Encode(svc.GetClaims("user123", jwtlib.RefreshTokenMode), privKey)
+	require.NoError(t, err)
+
+	claims, err = svc.DecodeAccessKey(refresh)
+	assert.Nil(t, claims)
+	assert.ErrorIs(t, err, jwtlib.ErrorInvalidToken)
+
+	// токен без typ, выпущенный до появления claim
+	untyped, err := svc.Encode(&jwt.RegisteredClaims{
+		Subject:   "user123",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}, privKey)
+	require.NoError(t, err)
+
+	claims, err = svc.DecodeAccessKey(untyped)
+	assert.Nil(t, claims)
+	assert.ErrorIs(t, err, jwtlib.ErrorInvalidToken)
+}
+
 func TestEncode_FailsWithInvalidKey(t *testing.T) {
 	_, pubKey := generateKeys(t)
 	_ = jwtlib.NewServiceJWT(pubKey, time.Hour*24, time.Minute*15)
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"gitlab.com/pisya-dev/account-service/internal/domain"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 type Logger struct {
@@ -31,6 +33,7 @@ func (l *Logger) Info(ctx context.Context, msg string, fields ...zap.Field) {
 	if ctx.Value(domain.RequestID) != nil {
 		fields = append(fields, zap.String(string(domain.RequestID), ctx.Value(domain.RequestID).(string)))
 	}
+	fields = append(fields, tracing.LogFields(ctx)...)
 
 	l.L.Info(msg, fields...)
 }
@@ -39,6 +42,17 @@ func (l *Logger) Fatal(ctx context.Context, msg string, fields ...zap.Field) {
 	if ctx.Value(domain.RequestID) != nil {
 		fields = append(fields, zap.String(string(domain.RequestID), ctx.Value(domain.RequestID).(string)))
 	}
+	fields = append(fields, tracing.LogFields(ctx)...)
 
 	l.L.Fatal(msg, fields...)
 }
+
+// Log пишет строку с уровнем, выбранным вызывающим, например политикой логирования запросов
+func (l *Logger) Log(ctx context.Context, level zapcore.Level, msg string, fields ...zap.Field) {
+	if ctx.Value(domain.RequestID) != nil {
+		fields = append(fields, zap.String(string(domain.RequestID), ctx.Value(domain.RequestID).(string)))
+	}
+	fields = append(fields, tracing.LogFields(ctx)...)
+
+	l.L.Log(level, msg, fields...)
+}
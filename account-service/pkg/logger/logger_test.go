@@ -8,7 +8,10 @@ import (
 	"gitlab.com/pisya-dev/account-service/pkg/logger"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestNewLogger(t *testing.T) {
@@ -38,6 +41,26 @@ func TestLogger_Info(t *testing.T) {
 	l.Info(ctx, "testing info message")
 }
 
+func TestLogger_Info_TraceIds(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	ctx := context.WithValue(context.Background(), domain.Logger, &logger.Logger{L: zap.New(core)})
+	ctx = trace.ContextWithSpanContext(ctx, spanContext)
+
+	logger.GetLoggerFromCtx(ctx).Info(ctx, "traced message")
+
+	entries := logs.All()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", entries[0].ContextMap()["trace_id"])
+	assert.Equal(t, "00f067aa0ba902b7", entries[0].ContextMap()["span_id"])
+}
+
 func TestLogger_Fatal_Skip(t *testing.T) {
 	t.Skip("Logger.Fatal вызывает os.Exit — не тестим напрямую")
 }
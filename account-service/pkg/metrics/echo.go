@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute метка для запросов, не попавших ни в один маршрут, чтобы произвольные пути
+// не раздували число временных рядов
+const unmatchedRoute = "unmatched"
+
+// Middleware считает запросы и время их обработки по шаблону маршрута echo, а не по пути запроса
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+
+		err := next(c)
+
+		code := c.Response().Status
+		if err != nil && !c.Response().Committed {
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				code = httpErr.Code
+			} else {
+				code = http.StatusInternalServerError
+			}
+		}
+
+		route := c.Path()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		method := c.Request().Method
+		httpDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+		httpRequests.WithLabelValues(method, route, strconv.Itoa(code)).Inc()
+
+		return err
+	}
+}
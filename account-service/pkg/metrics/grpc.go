@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor считает запросы и время их обработки по полному имени gRPC-метода
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+
+	resp, err := next(ctx, req)
+
+	observeGRPC(info.FullMethod, start, err)
+
+	return resp, err
+}
+
+// StreamServerInterceptor то же для потоковых методов, время считается до закрытия потока
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+	start := time.Now()
+
+	err := next(srv, ss)
+
+	observeGRPC(info.FullMethod, start, err)
+
+	return err
+}
+
+func observeGRPC(method string, start time.Time, err error) {
+	grpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+}
@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	resultOk    = "ok"
+	resultError = "error"
+)
+
+var (
+	grpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_requests_total",
+		Help: "Number of handled gRPC requests by method and status code",
+	}, []string{"method", "code"})
+
+	grpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_request_duration_seconds",
+		Help:    "gRPC request handling time",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of handled HTTP requests by route and status code",
+	}, []string{"method", "route", "code"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request handling time by route",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	dbDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Postgres query time by statement type",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation", "result"})
+
+	redisDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "redis_command_duration_seconds",
+		Help:    "Redis command time",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"command", "result"})
+
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_requests_total",
+		Help: "Number of cache lookups by cache and result (hit or miss)",
+	}, []string{"cache", "result"})
+)
+
+// Register регистрирует общие метрики с префиксом сервиса, например account_grpc_requests_total.
+// Вызывается один раз при старте. Метрики без значений меток, например HTTP в gRPC-сервисе,
+// в /metrics не попадают
+func Register(namespace string) {
+	prometheus.WrapRegistererWithPrefix(namespace+"_", prometheus.DefaultRegisterer).MustRegister(
+		grpcRequests, grpcDuration, httpRequests, httpDuration, dbDuration, redisDuration, cacheRequests,
+	)
+}
+
+// NewServer admin HTTP-сервер с /metrics. Поднимается на отдельном порту, чтобы метрики
+// не были доступны снаружи вместе с основным API
+func NewServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+
+// ObserveCache учитывает обращение к кэшу, доля попаданий считается как hit / (hit + miss)
+func ObserveCache(cache string, hit bool) {
+	if hit {
+		cacheRequests.WithLabelValues(cache, "hit").Inc()
+		return
+	}
+
+	cacheRequests.WithLabelValues(cache, "miss").Inc()
+}
+
+// result отсутствие ключа в redis не считается ошибкой
+func result(err error) string {
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return resultError
+	}
+
+	return resultOk
+}
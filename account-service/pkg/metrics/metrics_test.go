@@ -0,0 +1,119 @@
+package metrics_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var register sync.Once
+
+func scrape(t *testing.T) string {
+	t.Helper()
+
+	register.Do(func() { metrics.Register("account") })
+
+	rec := httptest.NewRecorder()
+	metrics.NewServer(0).Handler.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, 200, rec.Code)
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+// Тест: интерцептор считает запросы по методу и коду ответа.
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Account_Service/MetricsTest"}
+
+	_, err := metrics.UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+
+	_, err = metrics.UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	body := scrape(t)
+	assert.Contains(t, body, `account_grpc_requests_total{code="OK",method="/api.Account_Service/MetricsTest"} 1`)
+	assert.Contains(t, body, `account_grpc_requests_total{code="NotFound",method="/api.Account_Service/MetricsTest"} 1`)
+	assert.Contains(t, body, `account_grpc_request_duration_seconds_count{method="/api.Account_Service/MetricsTest"} 2`)
+}
+
+// Тест: хук redis замеряет команды, отсутствие ключа не считается ошибкой.
+func TestRedisHook(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	client.AddHook(metrics.RedisHook{})
+	defer client.Close()
+
+	ctx := context.Background()
+	require.NoError(t, client.Set(ctx, "key", "value", 0).Err())
+	require.ErrorIs(t, client.Get(ctx, "missing").Err(), redis.Nil)
+
+	body := scrape(t)
+	assert.Contains(t, body, `account_redis_command_duration_seconds_count{command="set",result="ok"} 1`)
+	assert.Contains(t, body, `account_redis_command_duration_seconds_count{command="get",result="ok"} 1`)
+}
+
+// Тест: потоковый интерцептор считает вызов по коду закрытия потока.
+func TestStreamServerInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/api.Account_Service/MetricsStreamTest"}
+
+	err := metrics.StreamServerInterceptor(nil, nil, info, func(srv any, stream grpc.ServerStream) error {
+		return status.Error(codes.Unavailable, "unavailable")
+	})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	assert.Contains(t, scrape(t), `account_grpc_requests_total{code="Unavailable",method="/api.Account_Service/MetricsStreamTest"} 1`)
+}
+
+// Тест: HTTP-запросы считаются по шаблону маршрута и коду ошибки обработчика.
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/user/promo/p1", nil), httptest.NewRecorder())
+	c.SetPath("/user/promo/:id")
+
+	err := metrics.Middleware(func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusNotFound, "not found")
+	})(c)
+	require.Error(t, err)
+
+	c = e.NewContext(httptest.NewRequest(http.MethodGet, "/unknown", nil), httptest.NewRecorder())
+	err = metrics.Middleware(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})(c)
+	require.NoError(t, err)
+
+	body := scrape(t)
+	assert.Contains(t, body, `account_http_requests_total{code="404",method="GET",route="/user/promo/:id"} 1`)
+	assert.Contains(t, body, `account_http_requests_total{code="200",method="GET",route="unmatched"} 1`)
+}
+
+func TestObserveCache(t *testing.T) {
+	metrics.ObserveCache("metrics-test", true)
+	metrics.ObserveCache("metrics-test", true)
+	metrics.ObserveCache("metrics-test", false)
+
+	body := scrape(t)
+	assert.Contains(t, body, `account_cache_requests_total{cache="metrics-test",result="hit"} 2`)
+	assert.Contains(t, body, `account_cache_requests_total{cache="metrics-test",result="miss"} 1`)
+}
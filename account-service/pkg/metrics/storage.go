@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	operation string
+	at        time.Time
+}
+
+// QueryTracer pgx.QueryTracer, замеряющий время запросов к postgres. Запросы группируются
+// по первому ключевому слову, чтобы текст запроса не попадал в метки
+type QueryTracer struct{}
+
+func (QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{operation: queryOperation(data.SQL), at: time.Now()})
+}
+
+func (QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+
+	dbDuration.WithLabelValues(start.operation, result(data.Err)).Observe(time.Since(start.at).Seconds())
+}
+
+func queryOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "other"
+	}
+
+	switch operation := strings.ToLower(fields[0]); operation {
+	case "select", "insert", "update", "delete", "with":
+		return operation
+	default:
+		return "other"
+	}
+}
+
+// RedisHook redis.Hook, замеряющий время выполнения команд и пайплайнов
+type RedisHook struct{}
+
+func (RedisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (RedisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+
+		err := next(ctx, cmd)
+
+		redisDuration.WithLabelValues(cmd.Name(), result(err)).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+func (RedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+
+		err := next(ctx, cmds)
+
+		redisDuration.WithLabelValues("pipeline", result(err)).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import "testing"
+
+// Тест: запросы группируются по первому ключевому слову.
+func TestQueryOperation(t *testing.T) {
+	cases := map[string]string{
+		"SELECT id FROM users WHERE id = $1":       "select",
+		"\n\t insert into users (id) values ($1)":  "insert",
+		"WITH deleted AS (DELETE FROM x) SELECT 1": "with",
+		"update users set name = $1":               "update",
+		"DELETE FROM users":                        "delete",
+		"TRUNCATE users":                           "other",
+		"":                                         "other",
+	}
+
+	for sql, want := range cases {
+		if got := queryOperation(sql); got != want {
+			t.Errorf("queryOperation(%q) = %q, want %q", sql, got, want)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue выпускает сертификат сервиса и записывает его вместе с CA в dir
+func (ca *testCA) issue(t *testing.T, dir string, commonName string, serial int64) Config {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	cfg := Config{
+		CertFile: filepath.Join(dir, "tls.pem"),
+		KeyFile:  filepath.Join(dir, "tls-key.pem"),
+		CAFile:   filepath.Join(dir, "ca.pem"),
+	}
+	require.NoError(t, os.WriteFile(cfg.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(cfg.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	require.NoError(t, os.WriteFile(cfg.CAFile, ca.pem, 0o600))
+
+	return cfg
+}
+
+// handshake соединяет клиента и сервер через net.Pipe и возвращает состояние соединения сервера
+func handshake(t *testing.T, server *Reloader, client *Reloader) (tls.ConnectionState, error) {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	srv := tls.Server(serverConn, server.ServerTLSConfig())
+	cli := tls.Client(clientConn, client.ClientTLSConfig("localhost"))
+
+	errs := make(chan error, 1)
+	go func() {
+		err := cli.Handshake()
+		if err != nil {
+			clientConn.Close()
+		}
+		errs <- err
+	}()
+
+	err := srv.Handshake()
+	if err != nil {
+		serverConn.Close()
+	}
+	if clientErr := <-errs; err == nil {
+		err = clientErr
+	}
+
+	return srv.ConnectionState(), err
+}
+
+// Тест взаимной аутентификации: сервер видит CommonName клиента.
+func TestReloader_Handshake(t *testing.T) {
+	ca := newTestCA(t)
+
+	server, err := NewReloader(ca.issue(t, t.TempDir(), "account-service", 2), nil)
+	require.NoError(t, err)
+	client, err := NewReloader(ca.issue(t, t.TempDir(), "auth-service", 3), nil)
+	require.NoError(t, err)
+
+	state, err := handshake(t, server, client)
+	require.NoError(t, err)
+	require.NotEmpty(t, state.VerifiedChains)
+	assert.Equal(t, "auth-service", state.VerifiedChains[0][0].Subject.CommonName)
+}
+
+// Тест клиента с сертификатом чужого CA.
+func TestReloader_UntrustedClient(t *testing.T) {
+	server, err := NewReloader(newTestCA(t).issue(t, t.TempDir(), "account-service", 2), nil)
+	require.NoError(t, err)
+	client, err := NewReloader(newTestCA(t).issue(t, t.TempDir(), "auth-service", 3), nil)
+	require.NoError(t, err)
+
+	_, err = handshake(t, server, client)
+	assert.Error(t, err)
+}
+
+// Тест ротации: после замены файлов используется новый сертификат.
+func TestReloader_Rotation(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+
+	cfg := ca.issue(t, dir, "account-service", 2)
+	r, err := NewReloader(cfg, nil)
+	require.NoError(t, err)
+
+	ca.issue(t, dir, "account-service", 42)
+	later := time.Now().Add(time.Minute)
+	for _, path := range []string{cfg.CertFile, cfg.KeyFile, cfg.CAFile} {
+		require.NoError(t, os.Chtimes(path, later, later))
+	}
+
+	cert, _ := r.current()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), leaf.SerialNumber.Int64())
+}
+
+// Тест ротации с повреждённым файлом: остаётся прежний сертификат, ошибка передаётся в onError.
+func TestReloader_RotationError(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+
+	cfg := ca.issue(t, dir, "account-service", 2)
+
+	var reloadErr error
+	r, err := NewReloader(cfg, func(err error) { reloadErr = err })
+	require.NoError(t, err)
+
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.WriteFile(cfg.KeyFile, []byte("broken"), 0o600))
+	require.NoError(t, os.Chtimes(cfg.KeyFile, later, later))
+
+	cert, _ := r.current()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), leaf.SerialNumber.Int64())
+	assert.Error(t, reloadErr)
+}
+
+// Тест списка разрешённых методов.
+func TestAllowList_Allowed(t *testing.T) {
+	list := NewAllowList(map[string]string{
+		"auth-service":  "*",
+		"promo-service": "GetBuisness|ConvertReferral",
+	})
+
+	assert.True(t, list.Allowed("auth-service", "/api.Account_Service/DeleteUser"))
+	assert.True(t, list.Allowed("promo-service", "/api.Account_Service/GetBuisness"))
+	assert.False(t, list.Allowed("promo-service", "/api.Account_Service/DeleteUser"))
+	assert.False(t, list.Allowed("unknown", "/api.Account_Service/Ping"))
+}
+
+func peerContext(commonName string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+}
+
+// Тест интерсептора: пир без права на метод и соединение без сертификата отклоняются.
+func TestAllowList_UnaryServerInterceptor(t *testing.T) {
+	interceptor := NewAllowList(map[string]string{"promo-service": "GetBuisness"}).UnaryServerInterceptor()
+	next := func(ctx context.Context, req any) (any, error) { return "response", nil }
+
+	resp, err := interceptor(peerContext("promo-service"), nil, &grpc.UnaryServerInfo{FullMethod: "/api.Account_Service/GetBuisness"}, next)
+	require.NoError(t, err)
+	assert.Equal(t, "response", resp)
+
+	_, err = interceptor(peerContext("promo-service"), nil, &grpc.UnaryServerInfo{FullMethod: "/api.Account_Service/DeleteUser"}, next)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/api.Account_Service/GetBuisness"}, next)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+// Тест stream интерсептора на методе выгрузки promo-service.
+func TestAllowList_StreamServerInterceptor(t *testing.T) {
+	interceptor := NewAllowList(map[string]string{"report-worker": "ExportPromoData"}).StreamServerInterceptor()
+
+	called := false
+	next := func(srv any, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	}
+
+	err := interceptor(nil, &testStream{ctx: peerContext("report-worker")}, &grpc.StreamServerInfo{FullMethod: "/api.PromoService/ExportPromoData"}, next)
+	require.NoError(t, err)
+	assert.True(t, called)
+
+	err = interceptor(nil, &testStream{ctx: peerContext("auth-service")}, &grpc.StreamServerInfo{FullMethod: "/api.PromoService/ExportPromoData"}, next)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = interceptor(nil, &testStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/api.PromoService/ExportPromoData"}, next)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,102 @@
+package mtls
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AnyMethod в списке методов разрешает пиру все методы сервиса
+const AnyMethod = "*"
+
+// healthService проверки grpc.health.v1 доступны любому пиру с валидным сертификатом
+const healthService = "/grpc.health.v1.Health/"
+
+// AllowList методы, которые может вызывать каждый пир. Пир определяется по CommonName
+// проверенного клиентского сертификата, метод по имени без сервиса, например GetBuisness или CreatePromo
+type AllowList map[string]map[string]struct{}
+
+// NewAllowList строит список из конфигурации вида peer -> "Method1|Method2" или peer -> "*"
+func NewAllowList(peers map[string]string) AllowList {
+	list := make(AllowList, len(peers))
+	for name, methods := range peers {
+		allowed := make(map[string]struct{})
+		for _, method := range strings.Split(methods, "|") {
+			if method = strings.TrimSpace(method); method != "" {
+				allowed[method] = struct{}{}
+			}
+		}
+		list[strings.TrimSpace(name)] = allowed
+	}
+
+	return list
+}
+
+func (a AllowList) Allowed(peerName string, fullMethod string) bool {
+	methods, ok := a[peerName]
+	if !ok {
+		return false
+	}
+	if _, ok := methods[AnyMethod]; ok {
+		return true
+	}
+
+	_, ok = methods[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]
+
+	return ok
+}
+
+// PeerIdentity возвращает CommonName клиентского сертификата, проверенного при рукопожатии
+func PeerIdentity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+func (a AllowList) authorize(ctx context.Context, fullMethod string) error {
+	name, ok := PeerIdentity(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "client certificate required")
+	}
+	if strings.HasPrefix(fullMethod, healthService) {
+		return nil
+	}
+	if !a.Allowed(name, fullMethod) {
+		return status.Errorf(codes.PermissionDenied, "peer %q is not allowed to call %s", name, fullMethod)
+	}
+
+	return nil
+}
+
+func (a AllowList) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	}
+}
+
+func (a AllowList) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return next(srv, ss)
+	}
+}
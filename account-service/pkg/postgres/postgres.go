@@ -7,10 +7,13 @@ import (
 
 	"gitlab.com/pisya-dev/account-service/internal/config"
 	"gitlab.com/pisya-dev/account-service/pkg/logger"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -26,7 +29,13 @@ func NewPostgres(ctx context.Context, config *config.Postgres) (*pgxpool.Pool, e
 		config.MinConn,
 	)
 
-	conn, err := pgxpool.New(ctx, connString)
+	poolConfig, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse database config: %w", err)
+	}
+	poolConfig.ConnConfig.Tracer = multitracer.New(tracing.QueryTracer{}, metrics.QueryTracer{})
+
+	conn, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to database: %w", err)
 	}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"gitlab.com/pisya-dev/account-service/internal/config"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -15,6 +17,8 @@ func NewRedis(config *config.Redis, ctx context.Context) (*redis.Client, error)
 		Password: config.Password,
 		DB:       0,
 	})
+	client.AddHook(tracing.RedisHook{})
+	client.AddHook(metrics.RedisHook{})
 
 	pong := client.Ping(ctx)
 	if pong.Err() != nil {
@@ -0,0 +1,71 @@
+package reqlog
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// payloadMaxLen тела больше этого размера в лог не пишутся
+const payloadMaxLen = 64 << 10
+
+// EchoMiddleware пишет одну строку о HTTP-запросе: метод, маршрут, статус, длительность и JSON-тело
+// со скрытыми полями. Уровень выбирается по ключу "МЕТОД маршрут", например POST /user/auth/sign-in.
+// Ошибку обработчика middleware передаёт в echo сам, чтобы в строку попал итоговый статус
+func (p *Policy) EchoMiddleware(log LogFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			payload := capturePayload(c.Request())
+
+			start := time.Now()
+
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			route := c.Request().Method + " " + c.Path()
+			status := c.Response().Status
+
+			if level, ok := p.Level(route, status >= http.StatusBadRequest); ok {
+				fields := []zap.Field{
+					zap.String("method", c.Request().Method), zap.String("route", c.Path()),
+					zap.Int("status", status), zap.Duration("duration", time.Since(start)),
+				}
+				if payload != nil {
+					fields = append(fields, p.PayloadJSON("request", payload))
+				}
+
+				log(ctx, level, "handled request", fields...)
+			}
+
+			return nil
+		}
+	}
+}
+
+// capturePayload читает JSON-тело запроса, не мешая обработчику прочитать его ещё раз.
+// Остальные тела, например загрузку файлов, в лог не пишем
+func capturePayload(req *http.Request) []byte {
+	if req.Body == nil || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return nil
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(req.Body, payloadMaxLen+1))
+	req.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(payload), req.Body), Closer: req.Body}
+	if err != nil || len(payload) > payloadMaxLen {
+		return nil
+	}
+
+	return payload
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,56 @@
+package reqlog
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// LogFunc пишет строку лога. Сервис добавляет в неё свои поля, например request_id
+type LogFunc func(ctx context.Context, level zapcore.Level, msg string, fields ...zap.Field)
+
+// UnaryServerInterceptor пишет одну строку о вызове: метод, код, длительность и тело запроса
+// со скрытыми полями. Идентификатор запроса и логгер в контекст кладёт сервис до этого перехватчика
+func (p *Policy) UnaryServerInterceptor(log LogFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		p.logCall(ctx, log, info.FullMethod, err, start, p.Payload("request", req))
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor то же для потоковых методов, без тела запроса
+func (p *Policy) StreamServerInterceptor(log LogFunc) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		p.logCall(ss.Context(), log, info.FullMethod, err, start)
+
+		return err
+	}
+}
+
+func (p *Policy) logCall(ctx context.Context, log LogFunc, method string, err error, start time.Time, extra ...zap.Field) {
+	level, ok := p.Level(method, err != nil)
+	if !ok {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("duration", time.Since(start)),
+	}
+
+	log(ctx, level, "handled request", append(fields, extra...)...)
+}
@@ -0,0 +1,86 @@
+package reqlog
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// LevelOff отключает строку лога для метода. Ошибки всё равно пишутся
+const LevelOff = "off"
+
+type Config struct {
+	// Levels уровни строки лога в виде метод:уровень, например /api.Account_Service/GetUser:debug
+	// для gRPC или GET /user/promo/:id:debug для HTTP. Имя отделяется по последнему двоеточию
+	Levels []string
+	// SuccessSampleRate доля успешных вызовов, попадающих в лог. Ошибки пишутся всегда
+	SuccessSampleRate float64
+	// Redact поля, которые маскируются в дополнение к DefaultRedact
+	Redact []string
+}
+
+// Policy решает, писать ли строку лога о вызове, с каким уровнем и что скрыть в теле запроса
+type Policy struct {
+	levels     map[string]zapcore.Level
+	off        map[string]bool
+	sampleRate float64
+	redactor   redactor
+
+	random func() float64
+}
+
+func NewPolicy(cfg Config) (*Policy, error) {
+	if cfg.SuccessSampleRate < 0 || cfg.SuccessSampleRate > 1 {
+		return nil, fmt.Errorf("success sample rate %v is out of [0, 1]", cfg.SuccessSampleRate)
+	}
+
+	p := &Policy{
+		levels:     make(map[string]zapcore.Level, len(cfg.Levels)),
+		off:        make(map[string]bool),
+		sampleRate: cfg.SuccessSampleRate,
+		redactor:   newRedactor(append(append([]string{}, DefaultRedact...), cfg.Redact...)),
+		random:     rand.Float64,
+	}
+
+	for _, item := range cfg.Levels {
+		i := strings.LastIndex(item, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid level %q, expected method:level", item)
+		}
+		method, level := strings.TrimSpace(item[:i]), strings.TrimSpace(item[i+1:])
+
+		if strings.EqualFold(level, LevelOff) {
+			p.off[method] = true
+			continue
+		}
+
+		parsed, err := zapcore.ParseLevel(level)
+		if err != nil {
+			return nil, fmt.Errorf("level of %s: %w", method, err)
+		}
+		p.levels[method] = parsed
+	}
+
+	return p, nil
+}
+
+// Level уровень строки лога о вызове method. false означает, что строку писать не нужно.
+// Ошибки пишутся всегда и не ниже warn
+func (p *Policy) Level(method string, failed bool) (zapcore.Level, bool) {
+	level, ok := p.levels[method]
+	if !ok {
+		level = zapcore.InfoLevel
+	}
+
+	if failed {
+		return max(level, zapcore.WarnLevel), true
+	}
+
+	if p.off[method] || p.random() >= p.sampleRate {
+		return level, false
+	}
+
+	return level, true
+}
@@ -0,0 +1,92 @@
+package reqlog
+
+import (
+	"encoding/json"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Redacted значение, которое пишется в лог вместо скрытого поля
+const Redacted = "[REDACTED]"
+
+// DefaultRedact поля с персональными данными и секретами, которые скрываются всегда
+var DefaultRedact = []string{
+	"password", "email", "contact_email", "surname", "token", "access_token", "refresh_token", "authorization",
+	"secret", "code", "codes", "promo_unique", "referral_code", "referrer_code",
+}
+
+// redactor маскирует поля по правилам. Правило без точки совпадает с полем с таким именем
+// на любой глубине, правило с точками — только с полем по этому пути от корня
+type redactor struct {
+	names map[string]bool
+	paths map[string]bool
+}
+
+func newRedactor(rules []string) redactor {
+	r := redactor{names: map[string]bool{}, paths: map[string]bool{}}
+	for _, rule := range rules {
+		rule = strings.ToLower(strings.TrimSpace(rule))
+		switch {
+		case rule == "":
+		case strings.Contains(rule, "."):
+			r.paths[rule] = true
+		default:
+			r.names[rule] = true
+		}
+	}
+
+	return r
+}
+
+// Payload поле лога с телом сообщения, в котором значения скрытых полей заменены на Redacted.
+// Сообщение, которое не удалось разобрать, в лог не пишется
+func (p *Policy) Payload(key string, msg any) zap.Field {
+	message, ok := msg.(proto.Message)
+	if !ok {
+		return zap.Skip()
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return zap.Skip()
+	}
+
+	return p.PayloadJSON(key, data)
+}
+
+// PayloadJSON то же для тела в JSON
+func (p *Policy) PayloadJSON(key string, data []byte) zap.Field {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return zap.Skip()
+	}
+
+	return zap.Any(key, p.redactor.redact("", value))
+}
+
+func (r redactor) redact(path string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			fieldPath := strings.ToLower(key)
+			if path != "" {
+				fieldPath = path + "." + fieldPath
+			}
+
+			if r.names[strings.ToLower(key)] || r.paths[fieldPath] {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = r.redact(fieldPath, field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = r.redact(path, item)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,176 @@
+package reqlog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "gitlab.com/pisya-dev/account-service/pkg/api/account_service"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func payload(t *testing.T, field zap.Field) map[string]any {
+	t.Helper()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	zap.New(core).Info("test", field)
+
+	value, ok := logs.All()[0].ContextMap()["request"].(map[string]any)
+	require.True(t, ok, "payload must be logged")
+	return value
+}
+
+// Тест: персональные данные скрываются по имени поля на любой глубине.
+func TestPayload_RedactsDefaultFields(t *testing.T) {
+	policy, err := NewPolicy(Config{SuccessSampleRate: 1})
+	require.NoError(t, err)
+
+	got := payload(t, policy.Payload("request", &pb.GetBuisnessResponse{
+		Name: "Acme",
+		Profile: &pb.BusinessProfile{
+			Name:         "Acme",
+			ContactEmail: "owner@acme.test",
+		},
+	}))
+
+	assert.Equal(t, "Acme", got["name"])
+	assert.Equal(t, map[string]any{"name": "Acme", "contact_email": Redacted}, got["profile"])
+}
+
+// Тест: правило с путём скрывает только поле по этому пути.
+func TestPayloadJSON_RedactsByPath(t *testing.T) {
+	policy, err := NewPolicy(Config{SuccessSampleRate: 1, Redact: []string{"user.name"}})
+	require.NoError(t, err)
+
+	got := payload(t, policy.PayloadJSON("request",
+		[]byte(`{"name":"Acme","user":{"name":"Ivan","age":30},"items":[{"password":"p1"}]}`)))
+
+	assert.Equal(t, "Acme", got["name"])
+	assert.Equal(t, map[string]any{"name": Redacted, "age": float64(30)}, got["user"])
+	assert.Equal(t, []any{map[string]any{"password": Redacted}}, got["items"])
+}
+
+func TestPayload_SkipsUnknownValues(t *testing.T) {
+	policy, err := NewPolicy(Config{SuccessSampleRate: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, zap.Skip(), policy.Payload("request", "raw string"))
+	assert.Equal(t, zap.Skip(), policy.PayloadJSON("request", []byte("not json")))
+}
+
+func TestPolicy_Level(t *testing.T) {
+	policy, err := NewPolicy(Config{
+		SuccessSampleRate: 0.5,
+		Levels: []string{
+			"/api.Account_Service/GetUser:debug",
+			"/grpc.health.v1.Health/Check:" + LevelOff,
+		},
+	})
+	require.NoError(t, err)
+
+	policy.random = func() float64 { return 0.1 }
+
+	level, ok := policy.Level("/api.Account_Service/GetUser", false)
+	assert.True(t, ok)
+	assert.Equal(t, zapcore.DebugLevel, level)
+
+	level, ok = policy.Level("/api.Account_Service/CreateUser", false)
+	assert.True(t, ok)
+	assert.Equal(t, zapcore.InfoLevel, level)
+
+	_, ok = policy.Level("/grpc.health.v1.Health/Check", false)
+	assert.False(t, ok)
+
+	// ошибки пишутся всегда и не ниже warn
+	level, ok = policy.Level("/grpc.health.v1.Health/Check", true)
+	assert.True(t, ok)
+	assert.Equal(t, zapcore.WarnLevel, level)
+
+	// успешный вызов вне доли семплирования не пишется
+	policy.random = func() float64 { return 0.9 }
+	_, ok = policy.Level("/api.Account_Service/CreateUser", false)
+	assert.False(t, ok)
+}
+
+func TestNewPolicy_InvalidConfig(t *testing.T) {
+	_, err := NewPolicy(Config{SuccessSampleRate: 2})
+	assert.Error(t, err)
+
+	_, err = NewPolicy(Config{SuccessSampleRate: 1, Levels: []string{"/m:loud"}})
+	assert.Error(t, err)
+
+	_, err = NewPolicy(Config{SuccessSampleRate: 1, Levels: []string{"debug"}})
+	assert.Error(t, err)
+}
+
+// observe LogFunc, которая пишет строки в наблюдаемый логгер
+func observe() (LogFunc, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	log := zap.New(core)
+
+	return func(_ context.Context, level zapcore.Level, msg string, fields ...zap.Field) {
+		log.Log(level, msg, fields...)
+	}, logs
+}
+
+// Тест: gRPC-перехватчик пишет метод, код и тело запроса со скрытыми полями.
+func TestPolicy_UnaryServerInterceptor(t *testing.T) {
+	policy, err := NewPolicy(Config{SuccessSampleRate: 1})
+	require.NoError(t, err)
+	log, logs := observe()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Account_Service/GetUser"}
+	_, err = policy.UnaryServerInterceptor(log)(context.Background(), &pb.BusinessProfile{ContactEmail: "owner@acme.test"}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		})
+	require.Error(t, err)
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, zapcore.WarnLevel, entry.Level)
+	assert.Equal(t, "/api.Account_Service/GetUser", entry.ContextMap()["method"])
+	assert.Equal(t, codes.NotFound.String(), entry.ContextMap()["code"])
+	assert.Equal(t, map[string]any{"contact_email": Redacted}, entry.ContextMap()["request"])
+}
+
+// Тест: echo middleware пишет итоговый статус, а обработчик читает тело запроса целиком.
+func TestPolicy_EchoMiddleware(t *testing.T) {
+	policy, err := NewPolicy(Config{SuccessSampleRate: 1, Levels: []string{"POST /user/auth/sign-in:debug"}})
+	require.NoError(t, err)
+	log, logs := observe()
+
+	req := httptest.NewRequest(http.MethodPost, "/user/auth/sign-in", strings.NewReader(`{"email":"a@b.c","password":"secret"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetPath("/user/auth/sign-in")
+
+	var body []byte
+	err = policy.EchoMiddleware(log)(func(c echo.Context) error {
+		body, _ = io.ReadAll(c.Request().Body)
+		return echo.NewHTTPError(http.StatusUnauthorized, "bad credentials")
+	})(c)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"email":"a@b.c","password":"secret"}`, string(body))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, zapcore.WarnLevel, entry.Level)
+	assert.Equal(t, "/user/auth/sign-in", entry.ContextMap()["route"])
+	assert.Equal(t, int64(http.StatusUnauthorized), entry.ContextMap()["status"])
+	assert.Equal(t, map[string]any{"email": Redacted, "password": Redacted}, entry.ContextMap()["request"])
+}
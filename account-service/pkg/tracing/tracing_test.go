@@ -0,0 +1,134 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return recorder
+}
+
+// Тест: без экспортера спаны не пишутся, но W3C trace-context настроен.
+func TestInit_None(t *testing.T) {
+	shutdown, err := tracing.Init(context.Background(), tracing.Config{ServiceName: "test", Exporter: tracing.ExporterNone})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent")
+}
+
+// Тест: неизвестный экспортер — ошибка конфигурации.
+func TestInit_UnknownExporter(t *testing.T) {
+	_, err := tracing.Init(context.Background(), tracing.Config{ServiceName: "test", Exporter: "zipkin"})
+	require.Error(t, err)
+}
+
+// Тест: входящий traceparent продолжает трассу, trace_id попадает в поля лога.
+func TestLogFields(t *testing.T) {
+	setupRecorder(t)
+
+	assert.Empty(t, tracing.LogFields(context.Background()))
+
+	carrier := propagation.MapCarrier{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+	ctx := propagation.TraceContext{}.Extract(context.Background(), carrier)
+
+	ctx, span := otel.Tracer("test").Start(ctx, "handler")
+	defer span.End()
+
+	fields := tracing.LogFields(ctx)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "trace_id", fields[0].Key)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", fields[0].String)
+	assert.Equal(t, span.SpanContext().SpanID().String(), fields[1].String)
+}
+
+// Тест: команды redis пишутся дочерними спанами, отсутствие ключа не ошибка.
+func TestRedisHook(t *testing.T) {
+	recorder := setupRecorder(t)
+	mr := miniredis.RunT(t)
+
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	client.AddHook(tracing.RedisHook{})
+	defer client.Close()
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	require.ErrorIs(t, client.Get(ctx, "missing").Err(), redis.Nil)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "redis get", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+}
+
+// Тест: запросы к postgres пишутся спанами по типу запроса, ошибка отмечается в статусе.
+func TestQueryTracer(t *testing.T) {
+	recorder := setupRecorder(t)
+	queryTracer := tracing.QueryTracer{}
+
+	ctx := queryTracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "select id from promo"})
+	queryTracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+
+	ctx = queryTracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "update promo set status = $1"})
+	queryTracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: errors.New("deadlock detected")})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "postgres SELECT", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "postgres UPDATE", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
+
+// Тест: HTTP-запрос продолжает трассу из traceparent, спан называется по шаблону маршрута.
+func TestMiddleware(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	req := httptest.NewRequest(http.MethodGet, "/user/promo/p1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.SetPath("/user/promo/:id")
+
+	err := tracing.Middleware(func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusBadGateway)
+	})(c)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /user/promo/:id", spans[0].Name())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+}
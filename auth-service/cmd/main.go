@@ -2,7 +2,16 @@ package main
 
 import (
 	"context"
-
+	"errors"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"gitlab.com/pisya-dev/account-service/pkg/health"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/reqlog"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/auth-service/internal/config"
 	"gitlab.com/pisya-dev/auth-service/internal/dto"
 	"gitlab.com/pisya-dev/auth-service/internal/repository"
@@ -13,8 +22,11 @@ import (
 	"gitlab.com/pisya-dev/auth-service/pkg/jwt"
 	"gitlab.com/pisya-dev/auth-service/pkg/logger"
 	"gitlab.com/pisya-dev/auth-service/pkg/postgres"
+	"gitlab.com/pisya-dev/auth-service/pkg/ratelimit"
 	"gitlab.com/pisya-dev/auth-service/pkg/redis"
+	"gitlab.com/pisya-dev/auth-service/pkg/storage"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 func main() {
@@ -43,7 +55,17 @@ func main() {
 
 	jwtService := jwt.NewServiceJWT(privateKey, publicKey, dto.RefreshTimeExpr, dto.AccesTimeExpr)
 
-	middlware := middleware.NewMiddlware(jwtService)
+	metrics.Register("auth")
+
+	shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+		ServiceName:  "auth-service",
+		Exporter:     config.Tracing.Exporter,
+		OTLPEndpoint: config.Tracing.OTLPEndpoint,
+		SampleRatio:  config.Tracing.SampleRatio,
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed init tracing", zap.Error(err))
+	}
 
 	db, err := postgres.NewPostgres(ctx, &config.Postgres)
 	if err != nil {
@@ -55,8 +77,34 @@ func main() {
 		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed to connect redis", zap.Error(err))
 	}
 
+	logPolicy, err := reqlog.NewPolicy(reqlog.Config{
+		Levels:            config.Log.RouteLevels,
+		SuccessSampleRate: config.Log.SuccessSampleRate,
+		Redact:            config.Log.RedactFields,
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed configure request logging", zap.Error(err))
+	}
+
+	rateLimits := ratelimit.Rules{FailOpen: config.RateLimit.FailOpen}
+	if rateLimits.Routes, err = ratelimit.ParseRoutes(config.RateLimit.Routes); err != nil {
+		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed configure rate limits", zap.Error(err))
+	}
+	if config.RateLimit.Default != "" {
+		if rateLimits.Default, err = ratelimit.ParseLimit(config.RateLimit.Default); err != nil {
+			logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed configure rate limits", zap.Error(err))
+		}
+	}
+
+	middlware := middleware.NewMiddlware(jwtService, authRedis, logPolicy, rateLimits)
+
 	authRepository := repository.NewRepository(db)
 
+	if !config.GrpcConfig.TLS.Enabled {
+		// без mTLS сервисные токены уходят в account- и promocode-service открытым текстом
+		logger.GetLoggerFromCtx(ctx).Log(ctx, zapcore.WarnLevel, "grpc tls disabled: service calls are not encrypted")
+	}
+
 	accountClient, err := grpc_client.NewAccountServiceClient(config.GrpcConfig)
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "FILED TO CONNECT ACCOUNT CLIENT STUPID NIGGA SON OF A BITch")
@@ -67,12 +115,71 @@ func main() {
 		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "FILED TO CONNECT PROMO CLIENT STUPID NIGGA SON OF A BITch")
 	}
 
-	authService := service.NewService(authRepository, authRedis, accountClient, promoService)
+	imageStorage, err := storage.NewStorage(&config.Storage)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Fatal(ctx, "filed to init image storage", zap.Error(err))
+	}
+
+	authService := service.NewService(authRepository, authRedis, accountClient, promoService, imageStorage)
+
+	checker := health.NewChecker(nil, config.Health.CheckInterval, config.Health.CheckTimeout, func(name string, err error) {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "dependency status changed", zap.String("dependency", name), zap.Error(err))
+	})
+	checker.Add("postgres", db.Ping)
+	checker.Add("redis", func(ctx context.Context) error {
+		return authRedis.Ping(ctx).Err()
+	})
+	checker.Add("account-service", accountClient.CheckHealth)
+	checker.Add("promo-service", promoService.CheckHealth)
 
-	authHandlers := rest.NewHandlers(authService, jwtService)
+	authHandlers := rest.NewHandlers(authService, jwtService, checker)
 
-	authRouter := rest.NewRouter(config.Rest, authHandlers, ctx, middlware)
+	authRouter := rest.NewRouter(config.Rest, config.Storage, authHandlers, ctx, middlware)
+
+	checkerCtx, stopChecker := context.WithCancel(ctx)
+	defer stopChecker()
+
+	go checker.Run(checkerCtx)
+
+	go authRouter.Run(ctx)
+
+	metricsServer := metrics.NewServer(config.Metrics.Port)
+
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, "filed to serve metrics", zap.Error(err))
+		}
+	}()
+
+	stopCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	<-stopCtx.Done()
+	logger.GetLoggerFromCtx(ctx).Info(ctx, "shutting down server")
+
+	// сначала /readyz начинает отвечать 503, чтобы балансировщик успел убрать инстанс
+	checker.Shutdown()
+	stopChecker()
+	time.Sleep(config.Health.ShutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, config.Health.ShutdownTimeout)
+	defer cancel()
+
+	if err := authRouter.Shutdown(shutdownCtx); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed graceful shutdown", zap.Error(err))
+	}
+
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed shutdown metrics server", zap.Error(err))
+	}
+
+	db.Close()
+	authRedis.Close()
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed flush traces", zap.Error(err))
+	}
 
-	authRouter.Run(ctx)
+	logger.GetLoggerFromCtx(ctx).Info(ctx, "server stopped")
 
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -11,6 +12,12 @@ type Config struct {
 	Rest
 	Redis
 	GrpcConfig
+	Storage
+	Health
+	Metrics
+	Tracing
+	Log
+	RateLimit
 }
 
 type Postgres struct {
@@ -36,6 +43,73 @@ type Rest struct {
 type GrpcConfig struct {
 	AccountClientAddr string `env:"ACCOUNT_CLIENTGRPCADDR"`
 	PromoClientAddr   string `env:"PROMO_CLIENTGRPCADDR"`
+	// AccountServiceToken сервисный токен auth-service из SERVICE_TOKENS account-service
+	AccountServiceToken string `env:"ACCOUNT_SERVICE_TOKEN"`
+	// PromoServiceToken сервисный токен auth-service из SERVICE_TOKENS promocode-service
+	PromoServiceToken string `env:"PROMO_SERVICE_TOKEN"`
+
+	TLS TLS
+}
+
+// Health интервал проверки зависимостей для /readyz. ShutdownDelay сколько сервис после сигнала
+// остановки отвечает на /readyz 503, прежде чем перестать принимать соединения
+type Health struct {
+	CheckInterval   time.Duration `env:"HEALTH_CHECK_INTERVAL" env-default:"5s"`
+	CheckTimeout    time.Duration `env:"HEALTH_CHECK_TIMEOUT" env-default:"2s"`
+	ShutdownDelay   time.Duration `env:"SHUTDOWN_DELAY" env-default:"5s"`
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" env-default:"10s"`
+}
+
+// Metrics admin-порт, на котором отдаётся /metrics
+type Metrics struct {
+	Port int `env:"METRICS_PORT" env-default:"9090"`
+}
+
+// RateLimit лимиты запросов в виде METHOD /route=запросы/период. Default применяется к остальным
+// маршрутам, пустой Default их не ограничивает. FailOpen пропускает запросы при недоступном redis,
+// иначе ограничиваемые маршруты отвечают 503
+type RateLimit struct {
+	Routes   []string `env:"RATE_LIMITS" env-default:"POST /user/auth/sign-in=10/1m,POST /business/auth/sign-in=10/1m,POST /user/auth/sign-up=5/1m,POST /business/auth/sign-up=5/1m,GET /user/promo/:id/code=30/1m,POST /user/promo/:id/activate=10/1m"`
+	Default  string   `env:"RATE_LIMIT_DEFAULT"`
+	FailOpen bool     `env:"RATE_LIMIT_FAIL_OPEN" env-default:"true"`
+}
+
+// Log логирование запросов: уровень по маршруту (GET /healthz:off, off отключает), доля успешных
+// запросов в логе и поля тела запроса, скрываемые в дополнение к стандартным
+type Log struct {
+	RouteLevels       []string `env:"LOG_ROUTE_LEVELS"`
+	SuccessSampleRate float64  `env:"LOG_SUCCESS_SAMPLE_RATE" env-default:"1"`
+	RedactFields      []string `env:"LOG_REDACT_FIELDS"`
+}
+
+// Tracing экспорт трасс OpenTelemetry: none, stdout или otlp
+type Tracing struct {
+	Exporter     string  `env:"TRACING_EXPORTER" env-default:"none"`
+	OTLPEndpoint string  `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	SampleRatio  float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
+// TLS настройки mTLS для gRPC-клиентов. Сертификат предъявляется account- и promocode-service,
+// их сертификаты проверяются по CAFile
+type TLS struct {
+	Enabled        bool          `env:"TLS_ENABLED"`
+	CertFile       string        `env:"TLS_CERT_FILE" env-default:"./certs/mtls/tls.pem"`
+	KeyFile        string        `env:"TLS_KEY_FILE" env-default:"./certs/mtls/tls-key.pem"`
+	CAFile         string        `env:"TLS_CA_FILE" env-default:"./certs/mtls/ca.pem"`
+	ReloadInterval time.Duration `env:"TLS_RELOAD_INTERVAL" env-default:"1m"`
+}
+
+// Storage описывает хранилище загружаемых изображений промокодов.
+// Для локального хранилища PublicURL должен указывать на путь /media этого сервиса
+type Storage struct {
+	Backend     string `env:"STORAGE_BACKEND" env-default:"local"`
+	PublicURL   string `env:"STORAGE_PUBLIC_URL"`
+	LocalDir    string `env:"STORAGE_LOCAL_DIR" env-default:"./media"`
+	S3Endpoint  string `env:"S3_ENDPOINT"`
+	S3Region    string `env:"S3_REGION" env-default:"us-east-1"`
+	S3Bucket    string `env:"S3_BUCKET"`
+	S3AccessKey string `env:"S3_ACCESS_KEY"`
+	S3SecretKey string `env:"S3_SECRET_KEY"`
 }
 
 func NewConfig() (*Config, error) {
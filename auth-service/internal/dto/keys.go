@@ -7,4 +7,8 @@ const (
 
 	RequestID Key = "request_id"
 	Uuid      Key = "uuid"
+	// AccessToken проверенный access token пользователя, передаётся в account-service
+	AccessToken Key = "access_token"
+	// UserID идентификатор пользователя из проверенного access token
+	UserID Key = "user_id"
 )
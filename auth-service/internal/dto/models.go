@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type AccountReqs struct {
 	Name string `json:"name"`
@@ -14,6 +17,9 @@ type AccountReqs struct {
 	Email string `json:"email"`
 
 	Password string `json:"password"`
+
+	// ReferralCode код пригласившего пользователя, передаётся при регистрации
+	ReferralCode string `json:"referral_code,omitempty"`
 }
 
 const (
@@ -22,6 +28,25 @@ const (
 	RedisTTL        = 15 * time.Minute
 )
 
+// RevokedUserKey ключ redis со временем отзыва токенов пользователя (unix). Токены, выданные
+// не позже этого времени, отклоняются. Ключ живёт RefreshTimeExpr: к этому времени истекают все выданные ранее токены
+func RevokedUserKey(id string) string {
+	return "revoked_user:" + id
+}
+
+// UserPatchReq частичное обновление профиля: поле, которое не передано или равно null, не меняется
+type UserPatchReq struct {
+	Name       *string `json:"name"`
+	Surname    *string `json:"surname"`
+	Avatar_url *string `json:"avatar_url"`
+	Password   *string `json:"password"`
+
+	Other *struct {
+		Age     *int32  `json:"age"`
+		Country *string `json:"country"`
+	} `json:"other"`
+}
+
 type AuthWithAccountReq struct {
 	ID    string
 	Email string `json:"email"`
@@ -53,4 +78,344 @@ type PromoReq struct {
 
 	Promo_common string   `json:"promo_common"`
 	Promo_unique []string `json:"promo_unique"`
+
+	Draft bool `json:"draft"`
+
+	Reward           *Reward           `json:"reward,omitempty"`
+	Stacking         *Stacking         `json:"stacking,omitempty"`
+	ActivationLimits []ActivationLimit `json:"activation_limits,omitempty"`
+	ReferralReward   *ReferralReward   `json:"referral_reward,omitempty"`
+
+	// DefaultLocale язык description, DescriptionTranslations описания на других языках с ключами BCP-47
+	DefaultLocale           string            `json:"default_locale,omitempty"`
+	DescriptionTranslations map[string]string `json:"description_translations,omitempty"`
+}
+
+// PromoPatchReq частичное изменение промокода: непереданные поля остаются прежними.
+// target заменяется целиком, пустые activation_limits и description_translations очищают настройки
+type PromoPatchReq struct {
+	Description *string `json:"description"`
+
+	ImageUrl *string `json:"image_url"`
+
+	Target *struct {
+		Age_from   int64    `json:"age_from"`
+		Age_until  int64    `json:"age_until"`
+		Country    string   `json:"country"`
+		Categories []string `json:"categories"`
+	} `json:"target"`
+
+	Active_from  *time.Time `json:"active_from"`
+	Active_until *time.Time `json:"active_until"`
+
+	Reward           *Reward           `json:"reward"`
+	Stacking         *Stacking         `json:"stacking"`
+	ActivationLimits []ActivationLimit `json:"activation_limits"`
+	ReferralReward   *ReferralReward   `json:"referral_reward"`
+
+	DefaultLocale           *string           `json:"default_locale"`
+	DescriptionTranslations map[string]string `json:"description_translations"`
+}
+
+// ReferralReward начисление пригласившему за первую активацию промокода компании приглашённым пользователем.
+// Сумма указывается в минимальных единицах валюты
+type ReferralReward struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// ActivationLimit ограничивает число активаций в календарном окне (UTC): всего (scope global) или на пользователя (scope user)
+type ActivationLimit struct {
+	Scope  string `json:"scope"`
+	Window string `json:"window"`
+	Max    int64  `json:"max"`
+}
+
+// Stacking правила совмещения с другими промокодами компании
+type Stacking struct {
+	Group     string `json:"group,omitempty"`
+	Exclusive bool   `json:"exclusive"`
+	Priority  int64  `json:"priority"`
+}
+
+// Reward суммы указываются в минимальных единицах валюты (копейки, центы)
+type Reward struct {
+	Type     string  `json:"type"`
+	Percent  float64 `json:"percent,omitempty"`
+	Amount   int64   `json:"amount,omitempty"`
+	Currency string  `json:"currency,omitempty"`
+	ItemSku  string  `json:"item_sku,omitempty"`
+
+	Conditions struct {
+		MinOrderAmount     int64    `json:"min_order_amount,omitempty"`
+		EligibleCategories []string `json:"eligible_categories,omitempty"`
+		MaxDiscountAmount  int64    `json:"max_discount_amount,omitempty"`
+	} `json:"conditions"`
+}
+
+type ListPromoReq struct {
+	Limit     int64    `query:"limit"`
+	Offset    int64    `query:"offset"`
+	SortBy    string   `query:"sort_by"`
+	Countries []string `query:"country"`
+	Query     string   `query:"query"`
+}
+
+type FeedReq struct {
+	Limit    int64  `query:"limit"`
+	Offset   int64  `query:"offset"`
+	Category string `query:"category"`
+	Query    string `query:"query"`
+}
+
+type PromoCodeResp struct {
+	Code        string `json:"code"`
+	Activations int64  `json:"activations"`
+	MaxCount    int64  `json:"max_count"`
+}
+
+type PromoResp struct {
+	PromoId     string          `json:"promo_id"`
+	CompanyId   string          `json:"company_id"`
+	CompanyName string          `json:"company_name"`
+	Mode        string          `json:"mode"`
+	Codes       []PromoCodeResp `json:"codes"`
+	Description string          `json:"description"`
+	ImageUrl    string          `json:"image_url,omitempty"`
+
+	Target struct {
+		Age_from   int64    `json:"age_from,omitempty"`
+		Age_until  int64    `json:"age_until,omitempty"`
+		Country    string   `json:"country,omitempty"`
+		Categories []string `json:"categories,omitempty"`
+	} `json:"target"`
+
+	Active_from  *time.Time `json:"active_from,omitempty"`
+	Active_until *time.Time `json:"active_until,omitempty"`
+
+	Status string `json:"status"`
+	Active bool   `json:"active"`
+
+	Highlight  string  `json:"highlight,omitempty"`
+	SearchRank float64 `json:"search_rank,omitempty"`
+
+	Reward           *Reward           `json:"reward,omitempty"`
+	Stacking         *Stacking         `json:"stacking,omitempty"`
+	ActivationLimits []ActivationLimit `json:"activation_limits,omitempty"`
+	ReferralReward   *ReferralReward   `json:"referral_reward,omitempty"`
+
+	// Locale язык description, выбранный по Accept-Language
+	Locale                  string            `json:"locale,omitempty"`
+	DefaultLocale           string            `json:"default_locale,omitempty"`
+	DescriptionTranslations map[string]string `json:"description_translations,omitempty"`
+}
+
+type PromoAction string
+
+const (
+	PromoActionPublish PromoAction = "publish"
+	PromoActionPause   PromoAction = "pause"
+	PromoActionResume  PromoAction = "resume"
+	PromoActionArchive PromoAction = "archive"
+)
+
+type AuditLogReq struct {
+	Limit     int64  `query:"limit"`
+	Offset    int64  `query:"offset"`
+	Operation string `query:"operation"`
+	From      string `query:"from"`
+	Until     string `query:"until"`
+}
+
+type AuditLogEntryResp struct {
+	Id          string          `json:"id"`
+	PromoId     string          `json:"promo_id"`
+	ActorId     string          `json:"actor_id"`
+	ActorUserId string          `json:"actor_user_id"`
+	Operation   string          `json:"operation"`
+	Changes     json.RawMessage `json:"changes"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// ActivateResp код, выданный пользователю при активации промокода
+type ActivateResp struct {
+	Promo string `json:"promo"`
+}
+
+type CartItem struct {
+	Sku       string `json:"sku"`
+	Category  string `json:"category"`
+	UnitPrice int64  `json:"unit_price"`
+	Quantity  int64  `json:"quantity"`
+}
+
+type RenderCodeReq struct {
+	Code            string `query:"code"`
+	Symbology       string `query:"symbology"`
+	Format          string `query:"format"`
+	Size            int64  `query:"size"`
+	ErrorCorrection string `query:"error_correction"`
+}
+
+type ExportReq struct {
+	Dataset string `query:"dataset"`
+	Format  string `query:"format"`
+}
+
+type QuoteReq struct {
+	Code string `json:"code"`
+
+	Cart struct {
+		Currency       string     `json:"currency"`
+		Items          []CartItem `json:"items"`
+		ShippingAmount int64      `json:"shipping_amount"`
+	} `json:"cart"`
+}
+
+type QuoteResp struct {
+	Applicable     bool   `json:"applicable"`
+	DiscountAmount int64  `json:"discount_amount"`
+	Currency       string `json:"currency"`
+	PromoId        string `json:"promo_id,omitempty"`
+	RewardType     string `json:"reward_type,omitempty"`
+	RejectReason   string `json:"reject_reason,omitempty"`
+}
+
+type ResolveReq struct {
+	Codes []string `json:"codes"`
+}
+
+type AppliedPromoResp struct {
+	Code      string   `json:"code"`
+	PromoId   string   `json:"promo_id"`
+	CompanyId string   `json:"company_id"`
+	Stacking  Stacking `json:"stacking"`
+}
+
+type RejectedCodeResp struct {
+	Code               string `json:"code"`
+	PromoId            string `json:"promo_id,omitempty"`
+	Reason             string `json:"reason"`
+	ConflictingPromoId string `json:"conflicting_promo_id,omitempty"`
+	Explanation        string `json:"explanation"`
+}
+
+type ResolveResp struct {
+	Applied  []AppliedPromoResp `json:"applied"`
+	Rejected []RejectedCodeResp `json:"rejected"`
+}
+
+type ReferralStatsResp struct {
+	ReferralCode string           `json:"referral_code"`
+	Invited      int64            `json:"invited"`
+	Converted    int64            `json:"converted"`
+	Rewards      []ReferralReward `json:"rewards"`
+}
+
+type WebhookEndpointReq struct {
+	Url    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+type WebhookEndpointResp struct {
+	Id        string    `json:"id"`
+	Url       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WebhookDeliveriesReq struct {
+	Limit      int64  `query:"limit"`
+	Offset     int64  `query:"offset"`
+	EndpointId string `query:"endpoint_id"`
+	Status     string `query:"status"`
+}
+
+type WebhookDeliveryResp struct {
+	Id             string          `json:"id"`
+	EndpointId     string          `json:"endpoint_id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int64           `json:"attempts"`
+	LastStatusCode int64           `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+type PromoThumbnail struct {
+	Size int    `json:"size"`
+	Url  string `json:"url"`
+}
+
+type PromoImageResp struct {
+	ImageUrl   string           `json:"image_url"`
+	Thumbnails []PromoThumbnail `json:"thumbnails"`
+}
+
+type BusinessProfileReq struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	LogoUrl      string   `json:"logo_url"`
+	Website      string   `json:"website"`
+	ContactEmail string   `json:"contact_email"`
+	Categories   []string `json:"categories"`
+	Country      string   `json:"country"`
+}
+
+type BusinessProfileResp struct {
+	Id           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	LogoUrl      string   `json:"logo_url"`
+	Website      string   `json:"website"`
+	ContactEmail string   `json:"contact_email"`
+	Categories   []string `json:"categories"`
+	Country      string   `json:"country"`
+}
+
+type ListBusinessesReq struct {
+	Limit    int64  `query:"limit"`
+	Offset   int64  `query:"offset"`
+	Country  string `query:"country"`
+	Category string `query:"category"`
+}
+
+type ReadinessResp struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// UserDataExport архив персональных данных пользователя, собранный со всех сервисов
+type UserDataExport struct {
+	ExportedAt       time.Time            `json:"exported_at"`
+	Account          UserDataAccount      `json:"account"`
+	Profile          UserDataProfile      `json:"profile"`
+	Referrals        *ReferralStatsResp   `json:"referrals"`
+	PromoActivations []UserDataActivation `json:"promo_activations"`
+}
+
+type UserDataAccount struct {
+	Id    string `json:"id"`
+	Email string `json:"email"`
+}
+
+type UserDataProfile struct {
+	Name         string `json:"name"`
+	Surname      string `json:"surname"`
+	AvatarUrl    string `json:"avatar_url"`
+	Age          int32  `json:"age"`
+	Country      string `json:"country"`
+	ReferralCode string `json:"referral_code"`
+}
+
+type UserDataActivation struct {
+	PromoId     string    `json:"promo_id"`
+	CompanyId   string    `json:"company_id"`
+	Code        string    `json:"code"`
+	Description string    `json:"description"`
+	ActivatedAt time.Time `json:"activated_at"`
 }
@@ -86,6 +86,31 @@ func (r *Repository) GetAccount(ctx context.Context, req *dto.AuthWithAccountReq
 
 }
 
+func (r *Repository) UpdatePassword(ctx context.Context, id string, passwordHash string) error {
+	const op = "repository.UpdatePassword"
+
+	query := sq.Update("platform_user").
+		Set("password", passwordHash).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : Failed to build SQL:", op), zap.Error(err))
+
+		return err
+	}
+
+	_, err = r.pg.Exec(ctx, sql, args...)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : UPDATE failed:", op), zap.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
 func (r *Repository) GetProfile(ctx context.Context, id string) (*dto.AccountReqs, error) {
 	const op = "repository.GetProfile"
 
@@ -114,3 +139,27 @@ func (r *Repository) GetProfile(ctx context.Context, id string) (*dto.AccountReq
 	return &user, nil
 
 }
+
+func (r *Repository) DeleteAccount(ctx context.Context, id string) error {
+	const op = "repository.DeleteAccount"
+
+	query := sq.Delete("platform_user").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : Failed to build SQL:", op), zap.Error(err))
+
+		return err
+	}
+
+	_, err = r.pg.Exec(ctx, sql, args...)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : DELETE failed:", op), zap.Error(err))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/pisya-dev/auth-service/pkg/api/account_service"
+	"gitlab.com/pisya-dev/auth-service/pkg/api/promopb"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrNotUser            = errors.New("subject is not a user account")
+	ErrActivationFraud    = errors.New("activation rejected by antifraud")
+	ErrNoActivationsLeft  = errors.New("no activations left")
+	ErrPromoNotActive     = errors.New("promo is not active")
+	errUnknownRejectCause = errors.New("activation rejected")
+)
+
+// ActivatePromo выдаёт пользователю код промокода. Бизнес-аккаунт активировать промокод не может
+func (s *Service) ActivatePromo(ctx context.Context, promoId string, id string) (string, error) {
+	const op = "service.ActivatePromo"
+
+	// пользователи и компании входят через один sign-in, тип аккаунта знает только account-service
+	if _, err := s.account.GetUserAccount(ctx, &account_service.GetUserProfileRequest{Uuid: id}); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		if status.Code(err) == codes.NotFound {
+			return "", ErrNotUser
+		}
+		return "", err
+	}
+
+	resp, err := s.promo.ActivatePromo(ctx, &promopb.ActivatePromoRequest{PromoId: promoId, UserId: &id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return "", err
+	}
+
+	if resp.GetSuccessActivation() {
+		return resp.GetCode(), nil
+	}
+
+	switch resp.GetReason() {
+	case promopb.Reason_ANTIFRAUD:
+		return "", ErrActivationFraud
+	case promopb.Reason_NO_ACTIVATIONS_LEFT:
+		return "", ErrNoActivationsLeft
+	case promopb.Reason_PROMO_NOT_ACTIVE:
+		return "", ErrPromoNotActive
+	}
+
+	return "", fmt.Errorf("%w: %s", errUnknownRejectCause, resp.GetReason())
+}
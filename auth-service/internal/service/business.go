@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/pkg/api/account_service"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"go.uber.org/zap"
+)
+
+func (s *Service) GetBusinessProfile(ctx context.Context, id string) (*dto.BusinessProfileResp, error) {
+	const op = "service.GetBusinessProfile"
+
+	resp, err := s.account.GetBuisnessAccount(ctx, &account_service.GetBuisnessRequest{Id: id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	return businessProfileFromPb(resp.GetProfile()), nil
+}
+
+func (s *Service) UpdateBusinessProfile(ctx context.Context, req *dto.BusinessProfileReq, id string) (*dto.BusinessProfileResp, error) {
+	const op = "service.UpdateBusinessProfile"
+
+	resp, err := s.account.UpdateBusiness(ctx, &account_service.UpdateBusinessRequest{
+		Id:           id,
+		Name:         req.Name,
+		Description:  req.Description,
+		LogoUrl:      req.LogoUrl,
+		Website:      req.Website,
+		ContactEmail: req.ContactEmail,
+		Categories:   req.Categories,
+		Country:      req.Country,
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	return businessProfileFromPb(resp.GetProfile()), nil
+}
+
+func (s *Service) DeleteBusinessProfile(ctx context.Context, id string) error {
+	const op = "service.DeleteBusinessProfile"
+
+	if _, err := s.account.DeleteBusiness(ctx, &account_service.DeleteBusinessRequest{Id: id}); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ListBusinessProfiles(ctx context.Context, req *dto.ListBusinessesReq) ([]dto.BusinessProfileResp, int64, error) {
+	const op = "service.ListBusinessProfiles"
+
+	list := &account_service.ListBusinessesRequest{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+	if req.Country != "" {
+		list.Country = &req.Country
+	}
+	if req.Category != "" {
+		list.Category = &req.Category
+	}
+
+	resp, err := s.account.ListBusinesses(ctx, list)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, 0, err
+	}
+
+	profiles := make([]dto.BusinessProfileResp, 0, len(resp.GetBusinesses()))
+	for _, profile := range resp.GetBusinesses() {
+		profiles = append(profiles, *businessProfileFromPb(profile))
+	}
+
+	return profiles, resp.GetTotal(), nil
+}
+
+func businessProfileFromPb(profile *account_service.BusinessProfile) *dto.BusinessProfileResp {
+	categories := profile.GetCategories()
+	if categories == nil {
+		categories = []string{}
+	}
+
+	return &dto.BusinessProfileResp{
+		Id:           profile.GetId(),
+		Name:         profile.GetName(),
+		Description:  profile.GetDescription(),
+		LogoUrl:      profile.GetLogoUrl(),
+		Website:      profile.GetWebsite(),
+		ContactEmail: profile.GetContactEmail(),
+		Categories:   categories,
+		Country:      profile.GetCountry(),
+	}
+}
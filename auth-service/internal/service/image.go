@@ -0,0 +1,127 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/google/uuid"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/pkg/api/promopb"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"gitlab.com/pisya-dev/auth-service/pkg/thumbnail"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxImageSize ограничивает размер загружаемого изображения в байтах
+	MaxImageSize = 5 << 20
+	// maxImagePixels защищает от изображений, которые занимают много памяти после декодирования
+	maxImagePixels = 40_000_000
+
+	thumbnailJpegQuality = 85
+)
+
+// thumbnailSizes задаёт размеры большей стороны миниатюр в пикселях
+var thumbnailSizes = []int{160, 480}
+
+var (
+	ErrImageTooLarge    = errors.New("image is too large")
+	ErrUnsupportedImage = errors.New("unsupported image type")
+)
+
+// imageFormats сопоставляет поддерживаемые типы изображений с расширением файла
+var imageFormats = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+// UploadPromoImage проверяет изображение, сохраняет его вместе с миниатюрами и прикрепляет к промокоду
+func (s *Service) UploadPromoImage(ctx context.Context, promoId string, id string, data []byte) (*dto.PromoImageResp, error) {
+	const op = "service.UploadPromoImage"
+
+	if len(data) > MaxImageSize {
+		return nil, ErrImageTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := imageFormats[contentType]
+	if !ok {
+		return nil, ErrUnsupportedImage
+	}
+
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", op, ErrUnsupportedImage, err)
+	}
+	if imageConfig.Width*imageConfig.Height > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", op, ErrUnsupportedImage, err)
+	}
+
+	keyPrefix := fmt.Sprintf("promo/%s/%s", promoId, uuid.NewString())
+	uploaded := make([]string, 0, len(thumbnailSizes)+1)
+
+	imageUrl, err := s.images.Put(ctx, keyPrefix+"."+ext, contentType, data)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+	uploaded = append(uploaded, keyPrefix+"."+ext)
+
+	resp := &dto.PromoImageResp{
+		ImageUrl:   imageUrl,
+		Thumbnails: make([]dto.PromoThumbnail, 0, len(thumbnailSizes)),
+	}
+
+	for _, size := range thumbnailSizes {
+		var thumb bytes.Buffer
+		if contentType == "image/png" {
+			err = png.Encode(&thumb, thumbnail.Fit(img, size))
+		} else {
+			err = jpeg.Encode(&thumb, thumbnail.Fit(img, size), &jpeg.Options{Quality: thumbnailJpegQuality})
+		}
+		if err != nil {
+			s.deleteImages(ctx, uploaded)
+			return nil, fmt.Errorf("%s: encode thumbnail: %w", op, err)
+		}
+
+		key := fmt.Sprintf("%s_%d.%s", keyPrefix, size, ext)
+		url, err := s.images.Put(ctx, key, contentType, thumb.Bytes())
+		if err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+			s.deleteImages(ctx, uploaded)
+			return nil, err
+		}
+		uploaded = append(uploaded, key)
+
+		resp.Thumbnails = append(resp.Thumbnails, dto.PromoThumbnail{Size: size, Url: url})
+	}
+
+	_, err = s.promo.SetPromoImage(ctx, &promopb.SetPromoImageRequest{CompanyId: &id, PromoId: promoId, ImageUrl: imageUrl})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		s.deleteImages(ctx, uploaded)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// deleteImages удаляет уже загруженные файлы, если изображение не удалось прикрепить к промокоду
+func (s *Service) deleteImages(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.images.Delete(ctx, key); err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, "service.deleteImages : error: ", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/pkg/api/account_service"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"gitlab.com/pisya-dev/auth-service/pkg/security"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	passwordMinLen   = 8
+	passwordMaxLen   = 60
+	passwordSpecials = "@$!%*?&"
+)
+
+var (
+	// ErrInvalidPassword пароль не соответствует требованиям спецификации
+	ErrInvalidPassword = errors.New("invalid password")
+	ErrUserNotFound    = errors.New("user not found")
+)
+
+// UpdateProfile частично обновляет профиль пользователя: в account-service уходят только
+// переданные поля, пароль хранится в auth-service. Возвращает профиль после обновления
+func (s *Service) UpdateProfile(ctx context.Context, req *dto.UserPatchReq, id string) (*dto.AccountReqs, error) {
+	const op = "service.UpdateProfile"
+
+	var passwordHash string
+	if req.Password != nil {
+		if !validPassword(*req.Password) {
+			return nil, ErrInvalidPassword
+		}
+
+		hash, err := security.Encode(*req.Password)
+		if err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+			return nil, err
+		}
+		passwordHash = hash
+	}
+
+	update := &account_service.UpdateUserRequest{Uuid: id, UpdateMask: &fieldmaskpb.FieldMask{}}
+	if req.Name != nil {
+		update.Name = *req.Name
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "name")
+	}
+	if req.Surname != nil {
+		update.Surname = *req.Surname
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "surname")
+	}
+	if req.Avatar_url != nil {
+		update.AvatarUrl = *req.Avatar_url
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "avatar_url")
+	}
+	if req.Other != nil && req.Other.Age != nil {
+		update.Age = *req.Other.Age
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "age")
+	}
+	if req.Other != nil && req.Other.Country != nil {
+		update.Country = *req.Other.Country
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "country")
+	}
+
+	// пустая маска перезаписала бы в account-service все поля, поэтому без изменений профиль только читаем
+	if len(update.UpdateMask.Paths) == 0 {
+		if passwordHash != "" {
+			if err := s.repo.UpdatePassword(ctx, id, passwordHash); err != nil {
+				logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		return s.GetProfileFromDb(ctx, &dto.GetProfileID{ID: id})
+	}
+
+	profile, err := s.repo.GetProfile(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := s.account.UpdateUserAccount(ctx, update)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	// пароль меняем после профиля, чтобы отклонённый account-service запрос не менял его частично
+	if passwordHash != "" {
+		if err := s.repo.UpdatePassword(ctx, id, passwordHash); err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	profile.Age = resp.GetAge()
+	profile.Avatar_url = resp.GetAvatarUrl()
+	profile.Name = resp.GetName()
+	profile.Surname = resp.GetSurname()
+	profile.Country = resp.GetCountry()
+
+	return profile, nil
+}
+
+// validPassword проверяет пароль по правилам схемы Password: латинские буквы обоих регистров,
+// цифра и спецсимвол из passwordSpecials, других символов быть не должно
+func validPassword(password string) bool {
+	if len(password) < passwordMinLen || len(password) > passwordMaxLen {
+		return false
+	}
+
+	var lower, upper, digit, special bool
+	for _, r := range password {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case strings.ContainsRune(passwordSpecials, r):
+			special = true
+		default:
+			return false
+		}
+	}
+
+	return lower && upper && digit && special
+}
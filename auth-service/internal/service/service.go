@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"gitlab.com/pisya-dev/auth-service/internal/dto"
@@ -12,7 +16,9 @@ import (
 	"gitlab.com/pisya-dev/auth-service/pkg/api/promopb"
 	"gitlab.com/pisya-dev/auth-service/pkg/logger"
 	"gitlab.com/pisya-dev/auth-service/pkg/security"
+	"gitlab.com/pisya-dev/auth-service/pkg/storage"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,6 +26,8 @@ type Repository interface {
 	CreateAccount(ctx context.Context, req *dto.AccountReqs, id string) error
 	GetAccount(ctx context.Context, req *dto.AuthWithAccountReq) (string, error)
 	GetProfile(ctx context.Context, id string) (*dto.AccountReqs, error)
+	UpdatePassword(ctx context.Context, id string, passwordHash string) error
+	DeleteAccount(ctx context.Context, id string) error
 }
 
 type Service struct {
@@ -29,10 +37,12 @@ type Service struct {
 	account *grpc_client.AccountServiceClient
 
 	promo *grpc_client.PromoSvcClient
+
+	images storage.Storage
 }
 
-func NewService(repo Repository, reds *redis.Client, accountService *grpc_client.AccountServiceClient, promoService *grpc_client.PromoSvcClient) *Service {
-	return &Service{repo: repo, redisClient: reds, account: accountService, promo: promoService}
+func NewService(repo Repository, reds *redis.Client, accountService *grpc_client.AccountServiceClient, promoService *grpc_client.PromoSvcClient, images storage.Storage) *Service {
+	return &Service{repo: repo, redisClient: reds, account: accountService, promo: promoService, images: images}
 }
 
 func (s *Service) CreateAccount(ctx context.Context, req *dto.AccountReqs, id string) error {
@@ -51,11 +61,12 @@ func (s *Service) CreateAccount(ctx context.Context, req *dto.AccountReqs, id st
 	}
 
 	profile := &account_service.CreateUserRequest{AvatarUrl: req.Avatar_url,
-		Age:     req.Age,
-		Name:    req.Name,
-		Surname: req.Surname,
-		Country: req.Country,
-		Id:      id,
+		Age:          req.Age,
+		Name:         req.Name,
+		Surname:      req.Surname,
+		Country:      req.Country,
+		Id:           id,
+		ReferrerCode: req.ReferralCode,
 	}
 
 	if _, err := s.account.CreateUserAccount(ctx, profile); err != nil {
@@ -140,11 +151,10 @@ func (s *Service) CreateBuisnessAccount(ctx context.Context, req *dto.AccountReq
 	}
 
 	profile := &account_service.CreateBuisnessRequest{
-		Uuid: id,
 		Name: req.Name,
 	}
 
-	if _, err := s.account.CreateBuisnessAccount(ctx, profile); err != nil {
+	if _, err := s.account.CreateBuisnessAccount(grpc_client.WithUserID(ctx, id), profile); err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
 		return err
 	}
@@ -170,9 +180,852 @@ func (s *Service) CreatePromo(ctx context.Context, req *dto.PromoReq, id string)
 		ActiveFrom:  timestamppb.New(req.Active_from),
 		ActiveUntil: timestamppb.New(req.Active_until),
 		CompanyId:   &id,
+		Draft:       &req.Draft,
+	}
+
+	reward, err := rewardToPb(req.Reward)
+	if err != nil {
+		return err
+	}
+	promo.Reward = reward
+
+	if req.Stacking != nil {
+		promo.Stacking = &promopb.Stacking{
+			Group:     &req.Stacking.Group,
+			Exclusive: req.Stacking.Exclusive,
+			Priority:  req.Stacking.Priority,
+		}
+	}
+
+	promo.ActivationLimits, err = activationLimitsToPb(req.ActivationLimits)
+	if err != nil {
+		return err
+	}
+
+	if req.ReferralReward != nil {
+		promo.ReferralReward = &promopb.ReferralReward{
+			Amount:   req.ReferralReward.Amount,
+			Currency: req.ReferralReward.Currency,
+		}
 	}
 
-	_, err := s.promo.CreatePromo(ctx, promo)
+	if req.DefaultLocale != "" || len(req.DescriptionTranslations) > 0 {
+		promo.Localization = &promopb.Localization{
+			DefaultLocale: req.DefaultLocale,
+			Translations:  req.DescriptionTranslations,
+		}
+	}
+
+	_, err = s.promo.CreatePromo(ctx, promo)
 
 	return err
 }
+
+// UpdatePromo применяет к промокоду частичное изменение и возвращает промокод после изменения.
+// Непереданные поля берутся из текущего промокода, потому что UpdatePromo в promo-service
+// перезаписывает описание, изображение, аудиторию и даты целиком
+func (s *Service) UpdatePromo(ctx context.Context, req *dto.PromoPatchReq, promoId string, id string) (*dto.PromoResp, error) {
+	const op = "service.UpdatePromo"
+
+	// описание нужно на языке по умолчанию, а не перевод, выбранный по Accept-Language
+	current, err := s.promo.GetPromo(withoutLocale(ctx), &promopb.GetPromoRequest{CompanyId: &id, PromoId: promoId})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	update, err := promoPatchToPb(current.GetPromo(), req)
+	if err != nil {
+		return nil, err
+	}
+	update.CompanyId = &id
+	update.PromoId = promoId
+
+	if _, err := s.promo.UpdatePromo(ctx, update); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	updated, err := s.promo.GetPromo(ctx, &promopb.GetPromoRequest{CompanyId: &id, PromoId: promoId})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	return &promosFromPb([]*promopb.Promo{updated.GetPromo()})[0], nil
+}
+
+// promoPatchToPb собирает запрос UpdatePromo из текущего промокода и переданных в req полей
+func promoPatchToPb(current *promopb.Promo, req *dto.PromoPatchReq) (*promopb.UpdatePromoRequest, error) {
+	update := &promopb.UpdatePromoRequest{
+		Description: current.GetDescription(),
+		ImageUrl:    current.GetImageUrl(),
+		Target:      current.GetTarget(),
+		ActiveFrom:  current.GetActiveFrom(),
+		ActiveUntil: current.GetActiveUntil(),
+	}
+
+	if req.Description != nil {
+		update.Description = *req.Description
+	}
+	if req.ImageUrl != nil {
+		update.ImageUrl = *req.ImageUrl
+	}
+	if req.Target != nil {
+		update.Target = &promopb.Target{
+			AgeFrom:    &req.Target.Age_from,
+			AgeUntil:   &req.Target.Age_until,
+			Country:    &req.Target.Country,
+			Categories: req.Target.Categories,
+		}
+	}
+	if req.Active_from != nil {
+		update.ActiveFrom = timestamppb.New(*req.Active_from)
+	}
+	if req.Active_until != nil {
+		update.ActiveUntil = timestamppb.New(*req.Active_until)
+	}
+
+	reward, err := rewardToPb(req.Reward)
+	if err != nil {
+		return nil, err
+	}
+	update.Reward = reward
+
+	if req.Stacking != nil {
+		update.Stacking = &promopb.Stacking{
+			Group:     &req.Stacking.Group,
+			Exclusive: req.Stacking.Exclusive,
+			Priority:  req.Stacking.Priority,
+		}
+	}
+
+	if req.ActivationLimits != nil {
+		limits, err := activationLimitsToPb(req.ActivationLimits)
+		if err != nil {
+			return nil, err
+		}
+		update.ActivationLimits = &promopb.ActivationLimits{Limits: limits}
+	}
+
+	if req.ReferralReward != nil {
+		update.ReferralReward = &promopb.ReferralReward{
+			Amount:   req.ReferralReward.Amount,
+			Currency: req.ReferralReward.Currency,
+		}
+	}
+
+	// promo-service заменяет язык и переводы вместе, поэтому непереданная часть берётся из текущего промокода
+	if req.DefaultLocale != nil || req.DescriptionTranslations != nil {
+		update.Localization = &promopb.Localization{
+			DefaultLocale: current.GetLocalization().GetDefaultLocale(),
+			Translations:  current.GetLocalization().GetTranslations(),
+		}
+		if req.DefaultLocale != nil {
+			update.Localization.DefaultLocale = *req.DefaultLocale
+		}
+		if req.DescriptionTranslations != nil {
+			update.Localization.Translations = req.DescriptionTranslations
+		}
+	}
+
+	return update, nil
+}
+
+// withoutLocale убирает из исходящих метаданных языки, которые Locale передаёт в promo-service
+func withoutLocale(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	md = md.Copy()
+	md.Delete("accept-language")
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func (s *Service) ListPromo(ctx context.Context, req *dto.ListPromoReq, id string) ([]dto.PromoResp, int64, error) {
+	const op = "service.ListPromo"
+
+	listReq := &promopb.ListPromoRequest{
+		CompanyId: &id,
+		Countries: req.Countries,
+	}
+	if req.Limit > 0 {
+		listReq.Limit = &req.Limit
+	}
+	if req.Offset > 0 {
+		listReq.Offset = &req.Offset
+	}
+	if req.Query != "" {
+		listReq.Query = &req.Query
+	}
+	if sortBy, ok := promopb.PromoSortBy_value[strings.ToUpper(req.SortBy)]; ok {
+		listReq.SortBy = promopb.PromoSortBy(sortBy).Enum()
+	}
+
+	resp, err := s.promo.ListPromo(ctx, listReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, 0, err
+	}
+
+	return promosFromPb(resp.GetPromo()), resp.GetXTotalCount(), nil
+}
+
+func (s *Service) Feed(ctx context.Context, req *dto.FeedReq) ([]dto.PromoResp, int64, error) {
+	const op = "service.Feed"
+
+	feedReq := &promopb.ListPromoFeedRequest{}
+	if req.Limit > 0 {
+		feedReq.Limit = &req.Limit
+	}
+	if req.Offset > 0 {
+		feedReq.Offset = &req.Offset
+	}
+	if req.Category != "" {
+		feedReq.Category = &req.Category
+	}
+	if req.Query != "" {
+		feedReq.Query = &req.Query
+	}
+
+	resp, err := s.promo.ListPromoFeed(ctx, feedReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, 0, err
+	}
+
+	return promosFromPb(resp.GetPromo()), resp.GetXTotalCount(), nil
+}
+
+func (s *Service) ChangePromoStatus(ctx context.Context, action dto.PromoAction, promoId string, id string) (string, error) {
+	const op = "service.ChangePromoStatus"
+
+	var (
+		promoStatus promopb.PromoStatus
+		err         error
+	)
+
+	switch action {
+	case dto.PromoActionPublish:
+		var resp *promopb.PublishPromoResponse
+		resp, err = s.promo.PublishPromo(ctx, &promopb.PublishPromoRequest{CompanyId: &id, PromoId: promoId})
+		promoStatus = resp.GetStatus()
+	case dto.PromoActionPause:
+		var resp *promopb.PausePromoResponse
+		resp, err = s.promo.PausePromo(ctx, &promopb.PausePromoRequest{CompanyId: &id, PromoId: promoId})
+		promoStatus = resp.GetStatus()
+	case dto.PromoActionResume:
+		var resp *promopb.ResumePromoResponse
+		resp, err = s.promo.ResumePromo(ctx, &promopb.ResumePromoRequest{CompanyId: &id, PromoId: promoId})
+		promoStatus = resp.GetStatus()
+	case dto.PromoActionArchive:
+		var resp *promopb.ArchivePromoResponse
+		resp, err = s.promo.ArchivePromo(ctx, &promopb.ArchivePromoRequest{CompanyId: &id, PromoId: promoId})
+		promoStatus = resp.GetStatus()
+	default:
+		return "", fmt.Errorf("%s: unknown action %q", op, action)
+	}
+
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return "", err
+	}
+
+	return strings.ToLower(promoStatus.String()), nil
+}
+
+func (s *Service) DeletePromo(ctx context.Context, promoId string, id string) error {
+	const op = "service.DeletePromo"
+
+	_, err := s.promo.DeletePromo(ctx, &promopb.DeletePromoRequest{CompanyId: &id, PromoId: promoId})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) RestorePromo(ctx context.Context, promoId string, id string) error {
+	const op = "service.RestorePromo"
+
+	_, err := s.promo.RestorePromo(ctx, &promopb.RestorePromoRequest{CompanyId: &id, PromoId: promoId})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ListPromoAuditLog(ctx context.Context, req *dto.AuditLogReq, promoId string, id string) ([]dto.AuditLogEntryResp, int64, error) {
+	const op = "service.ListPromoAuditLog"
+
+	auditReq := &promopb.ListPromoAuditLogRequest{
+		CompanyId: &id,
+		PromoId:   promoId,
+	}
+	if req.Limit > 0 {
+		auditReq.Limit = &req.Limit
+	}
+	if req.Offset > 0 {
+		auditReq.Offset = &req.Offset
+	}
+	if req.Operation != "" {
+		operation, ok := promopb.AuditOperation_value[strings.ToUpper(req.Operation)]
+		if !ok {
+			return nil, 0, fmt.Errorf("%s: unknown operation %q", op, req.Operation)
+		}
+		auditReq.Operation = promopb.AuditOperation(operation).Enum()
+	}
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: invalid from: %w", op, err)
+		}
+		auditReq.From = timestamppb.New(from)
+	}
+	if req.Until != "" {
+		until, err := time.Parse(time.RFC3339, req.Until)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: invalid until: %w", op, err)
+		}
+		auditReq.Until = timestamppb.New(until)
+	}
+
+	resp, err := s.promo.ListPromoAuditLog(ctx, auditReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, 0, err
+	}
+
+	entries := make([]dto.AuditLogEntryResp, 0, len(resp.GetEntries()))
+	for _, entry := range resp.GetEntries() {
+		entries = append(entries, dto.AuditLogEntryResp{
+			Id:          entry.GetId(),
+			PromoId:     entry.GetPromoId(),
+			ActorId:     entry.GetActorId(),
+			ActorUserId: entry.GetActorUserId(),
+			Operation:   strings.ToLower(entry.GetOperation().String()),
+			Changes:     json.RawMessage(entry.GetChanges()),
+			CreatedAt:   entry.GetCreatedAt().AsTime(),
+		})
+	}
+
+	return entries, resp.GetXTotalCount(), nil
+}
+
+// ExportPromoData передаёт выгрузку промокода из promo-service в writer, полученный от start.
+// start вызывается после первого чанка, поэтому ошибки прав и валидации возвращаются до начала ответа
+func (s *Service) ExportPromoData(ctx context.Context, req *dto.ExportReq, promoId string, id string, start func(contentType string, filename string) io.Writer) error {
+	const op = "service.ExportPromoData"
+
+	exportReq := &promopb.ExportPromoDataRequest{
+		CompanyId: &id,
+		PromoId:   promoId,
+	}
+	if req.Dataset != "" {
+		dataset, ok := promopb.ExportDataset_value["EXPORT_"+strings.ToUpper(req.Dataset)]
+		if !ok {
+			return fmt.Errorf("%s: unknown dataset %q", op, req.Dataset)
+		}
+		exportReq.Dataset = promopb.ExportDataset(dataset)
+	}
+	if req.Format != "" {
+		format, ok := promopb.ExportFormat_value["EXPORT_"+strings.ToUpper(req.Format)]
+		if !ok {
+			return fmt.Errorf("%s: unknown format %q", op, req.Format)
+		}
+		exportReq.Format = promopb.ExportFormat(format)
+	}
+
+	stream, err := s.promo.ExportPromoData(ctx, exportReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	chunk, err := stream.Recv()
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	w := start(chunk.GetContentType(), chunk.GetFilename())
+
+	for {
+		if _, err = w.Write(chunk.GetData()); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+}
+
+func (s *Service) RenderPromoCode(ctx context.Context, req *dto.RenderCodeReq, promoId string, id string) ([]byte, string, error) {
+	const op = "service.RenderPromoCode"
+
+	renderReq := &promopb.RenderPromoCodeRequest{
+		PromoId: promoId,
+		UserId:  id,
+	}
+	if req.Code != "" {
+		renderReq.Code = &req.Code
+	}
+	if req.Symbology != "" {
+		symbology, ok := promopb.CodeSymbology_value["SYMBOLOGY_"+strings.ToUpper(req.Symbology)]
+		if !ok {
+			return nil, "", fmt.Errorf("%s: unknown symbology %q", op, req.Symbology)
+		}
+		renderReq.Symbology = promopb.CodeSymbology(symbology)
+	}
+	if req.Format != "" {
+		format, ok := promopb.CodeImageFormat_value["IMAGE_"+strings.ToUpper(req.Format)]
+		if !ok {
+			return nil, "", fmt.Errorf("%s: unknown format %q", op, req.Format)
+		}
+		renderReq.Format = promopb.CodeImageFormat(format)
+	}
+	if req.Size > 0 {
+		renderReq.Size = &req.Size
+	}
+	if req.ErrorCorrection != "" {
+		errorCorrection, ok := promopb.QrErrorCorrection_value["QR_EC_"+strings.ToUpper(req.ErrorCorrection)]
+		if !ok {
+			return nil, "", fmt.Errorf("%s: unknown error correction %q", op, req.ErrorCorrection)
+		}
+		renderReq.ErrorCorrection = promopb.QrErrorCorrection(errorCorrection).Enum()
+	}
+
+	resp, err := s.promo.RenderPromoCode(ctx, renderReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, "", err
+	}
+
+	return resp.GetImage(), resp.GetContentType(), nil
+}
+
+func (s *Service) QuoteDiscount(ctx context.Context, req *dto.QuoteReq) (*dto.QuoteResp, error) {
+	const op = "service.QuoteDiscount"
+
+	quoteReq := &promopb.QuoteDiscountRequest{
+		Code: req.Code,
+		Cart: &promopb.Cart{
+			Currency:       req.Cart.Currency,
+			ShippingAmount: req.Cart.ShippingAmount,
+		},
+	}
+	for _, item := range req.Cart.Items {
+		quoteReq.Cart.Items = append(quoteReq.Cart.Items, &promopb.CartItem{
+			Sku:       item.Sku,
+			Category:  &item.Category,
+			UnitPrice: item.UnitPrice,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	resp, err := s.promo.QuoteDiscount(ctx, quoteReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	quote := &dto.QuoteResp{
+		Applicable:     resp.GetApplicable(),
+		DiscountAmount: resp.GetDiscountAmount(),
+		Currency:       resp.GetCurrency(),
+		PromoId:        resp.GetPromoId(),
+	}
+	if resp.RewardType != nil {
+		quote.RewardType = strings.ToLower(resp.GetRewardType().String())
+	}
+	if resp.GetRejectReason() != promopb.QuoteRejectReason_REJECT_NONE {
+		quote.RejectReason = rejectReasonFromPb(resp.GetRejectReason())
+	}
+
+	return quote, nil
+}
+
+func (s *Service) CreateWebhookEndpoint(ctx context.Context, req *dto.WebhookEndpointReq, id string) (*dto.WebhookEndpointResp, error) {
+	const op = "service.CreateWebhookEndpoint"
+
+	createReq := &promopb.CreateWebhookEndpointRequest{
+		CompanyId: &id,
+		Url:       req.Url,
+	}
+	for _, event := range req.Events {
+		pbEvent, err := webhookEventToPb(event)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		createReq.Events = append(createReq.Events, pbEvent)
+	}
+
+	resp, err := s.promo.CreateWebhookEndpoint(ctx, createReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	endpoint := webhookEndpointFromPb(resp.GetEndpoint())
+	endpoint.Secret = resp.GetSecret()
+
+	return &endpoint, nil
+}
+
+func (s *Service) ListWebhookEndpoints(ctx context.Context, id string) ([]dto.WebhookEndpointResp, error) {
+	const op = "service.ListWebhookEndpoints"
+
+	resp, err := s.promo.ListWebhookEndpoints(ctx, &promopb.ListWebhookEndpointsRequest{CompanyId: &id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	endpoints := make([]dto.WebhookEndpointResp, 0, len(resp.GetEndpoints()))
+	for _, endpoint := range resp.GetEndpoints() {
+		endpoints = append(endpoints, webhookEndpointFromPb(endpoint))
+	}
+
+	return endpoints, nil
+}
+
+func (s *Service) DeleteWebhookEndpoint(ctx context.Context, endpointId string, id string) error {
+	const op = "service.DeleteWebhookEndpoint"
+
+	_, err := s.promo.DeleteWebhookEndpoint(ctx, &promopb.DeleteWebhookEndpointRequest{CompanyId: &id, EndpointId: endpointId})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req *dto.WebhookDeliveriesReq, id string) ([]dto.WebhookDeliveryResp, int64, error) {
+	const op = "service.ListWebhookDeliveries"
+
+	listReq := &promopb.ListWebhookDeliveriesRequest{
+		CompanyId: &id,
+	}
+	if req.Limit > 0 {
+		listReq.Limit = &req.Limit
+	}
+	if req.Offset > 0 {
+		listReq.Offset = &req.Offset
+	}
+	if req.EndpointId != "" {
+		listReq.EndpointId = &req.EndpointId
+	}
+	if req.Status != "" {
+		deliveryStatus, ok := promopb.WebhookDeliveryStatus_value["DELIVERY_"+strings.ToUpper(req.Status)]
+		if !ok {
+			return nil, 0, fmt.Errorf("%s: unknown status %q", op, req.Status)
+		}
+		listReq.Status = promopb.WebhookDeliveryStatus(deliveryStatus).Enum()
+	}
+
+	resp, err := s.promo.ListWebhookDeliveries(ctx, listReq)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, 0, err
+	}
+
+	deliveries := make([]dto.WebhookDeliveryResp, 0, len(resp.GetDeliveries()))
+	for _, delivery := range resp.GetDeliveries() {
+		deliveryResp := dto.WebhookDeliveryResp{
+			Id:             delivery.GetId(),
+			EndpointId:     delivery.GetEndpointId(),
+			Event:          webhookEventFromPb(delivery.GetEvent()),
+			Status:         strings.ToLower(strings.TrimPrefix(delivery.GetStatus().String(), "DELIVERY_")),
+			Attempts:       delivery.GetAttempts(),
+			LastStatusCode: delivery.GetLastStatusCode(),
+			LastError:      delivery.GetLastError(),
+			NextAttemptAt:  delivery.GetNextAttemptAt().AsTime(),
+			CreatedAt:      delivery.GetCreatedAt().AsTime(),
+			Payload:        json.RawMessage(delivery.GetPayload()),
+		}
+		if delivery.DeliveredAt != nil {
+			deliveredAt := delivery.GetDeliveredAt().AsTime()
+			deliveryResp.DeliveredAt = &deliveredAt
+		}
+		deliveries = append(deliveries, deliveryResp)
+	}
+
+	return deliveries, resp.GetXTotalCount(), nil
+}
+
+func (s *Service) GetReferralStats(ctx context.Context, id string) (*dto.ReferralStatsResp, error) {
+	const op = "service.GetReferralStats"
+
+	resp, err := s.account.GetReferralStats(ctx, &account_service.GetReferralStatsRequest{UserId: id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	stats := &dto.ReferralStatsResp{
+		ReferralCode: resp.GetReferralCode(),
+		Invited:      resp.GetInvited(),
+		Converted:    resp.GetConverted(),
+		Rewards:      make([]dto.ReferralReward, 0, len(resp.GetRewards())),
+	}
+	for _, reward := range resp.GetRewards() {
+		stats.Rewards = append(stats.Rewards, dto.ReferralReward{
+			Amount:   reward.GetAmount(),
+			Currency: reward.GetCurrency(),
+		})
+	}
+
+	return stats, nil
+}
+
+func (s *Service) ResolveApplicablePromos(ctx context.Context, req *dto.ResolveReq) (*dto.ResolveResp, error) {
+	const op = "service.ResolveApplicablePromos"
+
+	resp, err := s.promo.ResolveApplicablePromos(ctx, &promopb.ResolveApplicablePromosRequest{Codes: req.Codes})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	result := &dto.ResolveResp{
+		Applied:  make([]dto.AppliedPromoResp, 0, len(resp.GetApplied())),
+		Rejected: make([]dto.RejectedCodeResp, 0, len(resp.GetRejected())),
+	}
+	for _, applied := range resp.GetApplied() {
+		result.Applied = append(result.Applied, dto.AppliedPromoResp{
+			Code:      applied.GetCode(),
+			PromoId:   applied.GetPromoId(),
+			CompanyId: applied.GetCompanyId(),
+			Stacking:  stackingFromPb(applied.GetStacking()),
+		})
+	}
+	for _, rejected := range resp.GetRejected() {
+		result.Rejected = append(result.Rejected, dto.RejectedCodeResp{
+			Code:               rejected.GetCode(),
+			PromoId:            rejected.GetPromoId(),
+			Reason:             rejectReasonFromPb(rejected.GetReason()),
+			ConflictingPromoId: rejected.GetConflictingPromoId(),
+			Explanation:        rejected.GetExplanation(),
+		})
+	}
+
+	return result, nil
+}
+
+func rejectReasonFromPb(reason promopb.QuoteRejectReason) string {
+	return strings.ToLower(strings.TrimPrefix(reason.String(), "REJECT_"))
+}
+
+// webhookEventToPb переводит имя события вида promo.activated в enum promocode-service
+func webhookEventToPb(event string) (promopb.WebhookEvent, error) {
+	value, ok := promopb.WebhookEvent_value["WEBHOOK_"+strings.ToUpper(strings.ReplaceAll(event, ".", "_"))]
+	if !ok {
+		return 0, fmt.Errorf("unknown webhook event %q", event)
+	}
+	return promopb.WebhookEvent(value), nil
+}
+
+func webhookEventFromPb(event promopb.WebhookEvent) string {
+	return strings.ToLower(strings.Replace(strings.TrimPrefix(event.String(), "WEBHOOK_"), "_", ".", 1))
+}
+
+func webhookEndpointFromPb(endpoint *promopb.WebhookEndpoint) dto.WebhookEndpointResp {
+	events := make([]string, 0, len(endpoint.GetEvents()))
+	for _, event := range endpoint.GetEvents() {
+		events = append(events, webhookEventFromPb(event))
+	}
+	return dto.WebhookEndpointResp{
+		Id:        endpoint.GetId(),
+		Url:       endpoint.GetUrl(),
+		Events:    events,
+		CreatedAt: endpoint.GetCreatedAt().AsTime(),
+	}
+}
+
+func stackingFromPb(pbStacking *promopb.Stacking) dto.Stacking {
+	return dto.Stacking{
+		Group:     pbStacking.GetGroup(),
+		Exclusive: pbStacking.GetExclusive(),
+		Priority:  pbStacking.GetPriority(),
+	}
+}
+
+func rewardToPb(reward *dto.Reward) (*promopb.Reward, error) {
+	if reward == nil {
+		return nil, nil
+	}
+
+	rewardType, ok := promopb.RewardType_value[strings.ToUpper(reward.Type)]
+	if !ok {
+		return nil, fmt.Errorf("unknown reward type %q", reward.Type)
+	}
+
+	pbReward := &promopb.Reward{
+		Type: promopb.RewardType(rewardType),
+		Conditions: &promopb.RewardConditions{
+			EligibleCategories: reward.Conditions.EligibleCategories,
+		},
+	}
+	if reward.Percent > 0 {
+		pbReward.Percent = &reward.Percent
+	}
+	if reward.Amount > 0 {
+		pbReward.Amount = &reward.Amount
+	}
+	if reward.Currency != "" {
+		pbReward.Currency = &reward.Currency
+	}
+	if reward.ItemSku != "" {
+		pbReward.ItemSku = &reward.ItemSku
+	}
+	if reward.Conditions.MinOrderAmount > 0 {
+		pbReward.Conditions.MinOrderAmount = &reward.Conditions.MinOrderAmount
+	}
+	if reward.Conditions.MaxDiscountAmount > 0 {
+		pbReward.Conditions.MaxDiscountAmount = &reward.Conditions.MaxDiscountAmount
+	}
+
+	return pbReward, nil
+}
+
+var (
+	limitScopes = map[string]promopb.LimitScope{
+		"global": promopb.LimitScope_LIMIT_GLOBAL,
+		"user":   promopb.LimitScope_LIMIT_PER_USER,
+	}
+	limitWindows = map[string]promopb.LimitWindow{
+		"lifetime": promopb.LimitWindow_WINDOW_LIFETIME,
+		"day":      promopb.LimitWindow_WINDOW_DAY,
+		"week":     promopb.LimitWindow_WINDOW_WEEK,
+		"month":    promopb.LimitWindow_WINDOW_MONTH,
+	}
+)
+
+func activationLimitsToPb(limits []dto.ActivationLimit) ([]*promopb.ActivationLimit, error) {
+	pbLimits := make([]*promopb.ActivationLimit, 0, len(limits))
+	for _, limit := range limits {
+		scope, ok := limitScopes[limit.Scope]
+		if !ok {
+			return nil, fmt.Errorf("unknown activation limit scope %q", limit.Scope)
+		}
+		window, ok := limitWindows[limit.Window]
+		if !ok {
+			return nil, fmt.Errorf("unknown activation limit window %q", limit.Window)
+		}
+		pbLimits = append(pbLimits, &promopb.ActivationLimit{Scope: scope, Window: window, Max: limit.Max})
+	}
+	return pbLimits, nil
+}
+
+func activationLimitsFromPb(pbLimits []*promopb.ActivationLimit) []dto.ActivationLimit {
+	limits := make([]dto.ActivationLimit, 0, len(pbLimits))
+	for _, pbLimit := range pbLimits {
+		limit := dto.ActivationLimit{Max: pbLimit.GetMax()}
+		for scope, pbScope := range limitScopes {
+			if pbScope == pbLimit.GetScope() {
+				limit.Scope = scope
+			}
+		}
+		for window, pbWindow := range limitWindows {
+			if pbWindow == pbLimit.GetWindow() {
+				limit.Window = window
+			}
+		}
+		limits = append(limits, limit)
+	}
+	return limits
+}
+
+func rewardFromPb(pbReward *promopb.Reward) *dto.Reward {
+	if pbReward == nil {
+		return nil
+	}
+
+	reward := &dto.Reward{
+		Type:     strings.ToLower(pbReward.GetType().String()),
+		Percent:  pbReward.GetPercent(),
+		Amount:   pbReward.GetAmount(),
+		Currency: pbReward.GetCurrency(),
+		ItemSku:  pbReward.GetItemSku(),
+	}
+	reward.Conditions.MinOrderAmount = pbReward.GetConditions().GetMinOrderAmount()
+	reward.Conditions.EligibleCategories = pbReward.GetConditions().GetEligibleCategories()
+	reward.Conditions.MaxDiscountAmount = pbReward.GetConditions().GetMaxDiscountAmount()
+
+	return reward
+}
+
+func promosFromPb(promos []*promopb.Promo) []dto.PromoResp {
+	result := make([]dto.PromoResp, 0, len(promos))
+
+	for _, p := range promos {
+		promo := dto.PromoResp{
+			PromoId:     p.GetPromoId(),
+			CompanyId:   p.GetCompanyId(),
+			CompanyName: p.GetCompanyName(),
+			Mode:        p.GetMode().String(),
+			Description: p.GetDescription(),
+			ImageUrl:    p.GetImageUrl(),
+			Status:      strings.ToLower(p.GetStatus().String()),
+			Active:      p.GetActive(),
+			Highlight:   p.GetHighlight(),
+			SearchRank:  p.GetSearchRank(),
+			Reward:      rewardFromPb(p.GetReward()),
+
+			Locale:                  p.GetLocale(),
+			DefaultLocale:           p.GetLocalization().GetDefaultLocale(),
+			DescriptionTranslations: p.GetLocalization().GetTranslations(),
+		}
+
+		if p.Stacking != nil {
+			stacking := stackingFromPb(p.GetStacking())
+			promo.Stacking = &stacking
+		}
+		if len(p.GetActivationLimits()) > 0 {
+			promo.ActivationLimits = activationLimitsFromPb(p.GetActivationLimits())
+		}
+		if p.ReferralReward != nil {
+			promo.ReferralReward = &dto.ReferralReward{
+				Amount:   p.GetReferralReward().GetAmount(),
+				Currency: p.GetReferralReward().GetCurrency(),
+			}
+		}
+
+		promo.Target.Age_from = p.GetTarget().GetAgeFrom()
+		promo.Target.Age_until = p.GetTarget().GetAgeUntil()
+		promo.Target.Country = p.GetTarget().GetCountry()
+		promo.Target.Categories = p.GetTarget().GetCategories()
+
+		if p.ActiveFrom != nil {
+			activeFrom := p.GetActiveFrom().AsTime()
+			promo.Active_from = &activeFrom
+		}
+		if p.ActiveUntil != nil {
+			activeUntil := p.GetActiveUntil().AsTime()
+			promo.Active_until = &activeUntil
+		}
+
+		for _, code := range p.GetCodes() {
+			promo.Codes = append(promo.Codes, dto.PromoCodeResp{
+				Code:        code.GetCode(),
+				Activations: code.GetActivations(),
+				MaxCount:    code.GetMaxCount(),
+			})
+		}
+
+		result = append(result, promo)
+	}
+
+	return result
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/pkg/api/promopb"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func currentPromo() *promopb.Promo {
+	country := "ru"
+	return &promopb.Promo{
+		PromoId:     "promo-1",
+		Description: "Скидка 10%",
+		ImageUrl:    pointerTo("https://cdn.example.com/promo.png"),
+		Target:      &promopb.Target{Country: &country, Categories: []string{"food"}},
+		ActiveFrom:  timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		ActiveUntil: timestamppb.New(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)),
+		Localization: &promopb.Localization{
+			DefaultLocale: "ru",
+			Translations:  map[string]string{"en": "10% off"},
+		},
+	}
+}
+
+func pointerTo[T any](v T) *T {
+	return &v
+}
+
+// Тест: непереданные поля берутся из текущего промокода, переводы заменяются без потери языка по умолчанию
+func TestPromoPatchToPb_Translations(t *testing.T) {
+	update, err := promoPatchToPb(currentPromo(), &dto.PromoPatchReq{
+		DescriptionTranslations: map[string]string{"de": "10 % Rabatt"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Скидка 10%", update.GetDescription())
+	assert.Equal(t, "https://cdn.example.com/promo.png", update.GetImageUrl())
+	assert.Equal(t, "ru", update.GetTarget().GetCountry())
+	assert.Equal(t, currentPromo().GetActiveUntil().AsTime(), update.GetActiveUntil().AsTime())
+	assert.Equal(t, "ru", update.GetLocalization().GetDefaultLocale())
+	assert.Equal(t, map[string]string{"de": "10 % Rabatt"}, update.GetLocalization().GetTranslations())
+	assert.Nil(t, update.Reward)
+	assert.Nil(t, update.ActivationLimits)
+}
+
+// Тест: без полей локализации promo-service получает запрос без Localization и оставляет переводы прежними
+func TestPromoPatchToPb_KeepsLocalization(t *testing.T) {
+	until := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	update, err := promoPatchToPb(currentPromo(), &dto.PromoPatchReq{
+		Description:      pointerTo("Скидка 15%"),
+		Active_until:     &until,
+		ActivationLimits: []dto.ActivationLimit{},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Скидка 15%", update.GetDescription())
+	assert.Equal(t, until, update.GetActiveUntil().AsTime())
+	assert.Equal(t, currentPromo().GetActiveFrom().AsTime(), update.GetActiveFrom().AsTime())
+	assert.Nil(t, update.Localization)
+	require.NotNil(t, update.ActivationLimits)
+	assert.Empty(t, update.GetActivationLimits().GetLimits())
+}
+
+// Тест: текущий промокод запрашивается без accept-language, остальные метаданные сохраняются
+func TestWithoutLocale(t *testing.T) {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "de", "x-request-id", "req-1")
+
+	md, ok := metadata.FromOutgoingContext(withoutLocale(ctx))
+	require.True(t, ok)
+	assert.Empty(t, md.Get("accept-language"))
+	assert.Equal(t, []string{"req-1"}, md.Get("x-request-id"))
+
+	original, _ := metadata.FromOutgoingContext(ctx)
+	assert.Equal(t, []string{"de"}, original.Get("accept-language"))
+}
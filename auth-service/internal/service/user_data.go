@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/pkg/api/account_service"
+	"gitlab.com/pisya-dev/auth-service/pkg/api/promopb"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// DeleteAccount удаляет пользователя со всей платформы: обезличивает активации и webhook-доставки
+// в promo-service, удаляет профиль в account-service и учётную запись здесь, после чего отзывает токены.
+// Шаги идут от внешних сервисов к локальной учётной записи, поэтому прерванное удаление можно повторить
+func (s *Service) DeleteAccount(ctx context.Context, id string) error {
+	const op = "service.DeleteAccount"
+
+	account, err := s.repo.GetProfile(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	// токены отзываются до удаления данных: middleware пропускает запросы при недоступном redis,
+	// поэтому без записанного ключа удаление не начинается. Токены, выданные после отзыва, действуют,
+	// и пользователь может повторить удаление, если следующий шаг не удался
+	if err := s.redisClient.Set(ctx, dto.RevokedUserKey(id), time.Now().Unix(), dto.RefreshTimeExpr).Err(); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : revoke tokens: ", op), zap.Error(err))
+		return err
+	}
+
+	erasure, err := s.promo.ForgetUser(ctx, &promopb.ForgetUserRequest{UserId: id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	if _, err := s.account.DeleteUserAccount(ctx, &account_service.DeleteUserRequest{Id: id}); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	if err := s.repo.DeleteAccount(ctx, id); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return err
+	}
+
+	// учётная запись уже удалена, поэтому ошибку redis только логируем: кэш email истечёт через dto.RedisTTL
+	if err := s.redisClient.Del(ctx, account.Email).Err(); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : drop email cache: ", op), zap.Error(err))
+	}
+
+	logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : user deleted", op),
+		zap.String("user_id", id),
+		zap.Int64("anonymized_activations", erasure.GetAnonymizedActivations()),
+		zap.Int64("scrubbed_webhook_deliveries", erasure.GetScrubbedWebhookDeliveries()),
+	)
+
+	return nil
+}
+
+// ExportUserData собирает всё, что платформа хранит о пользователе: учётную запись,
+// профиль и рефералы из account-service и активации промокодов из promo-service
+func (s *Service) ExportUserData(ctx context.Context, id string) (*dto.UserDataExport, error) {
+	const op = "service.ExportUserData"
+
+	account, err := s.repo.GetProfile(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	profile, err := s.account.GetUserAccount(ctx, &account_service.GetUserProfileRequest{Uuid: id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	referrals, err := s.GetReferralStats(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.promo.ExportUserData(ctx, &promopb.ExportUserDataRequest{UserId: id})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s : error: ", op), zap.Error(err))
+		return nil, err
+	}
+
+	activations := make([]dto.UserDataActivation, 0, len(resp.GetActivations()))
+	for _, activation := range resp.GetActivations() {
+		activations = append(activations, dto.UserDataActivation{
+			PromoId:     activation.GetPromoId(),
+			CompanyId:   activation.GetCompanyId(),
+			Code:        activation.GetCode(),
+			Description: activation.GetDescription(),
+			ActivatedAt: activation.GetActivatedAt().AsTime(),
+		})
+	}
+
+	return &dto.UserDataExport{
+		ExportedAt: time.Now().UTC(),
+		Account: dto.UserDataAccount{
+			Id:    id,
+			Email: account.Email,
+		},
+		Profile: dto.UserDataProfile{
+			Name:         profile.GetName(),
+			Surname:      profile.GetSurname(),
+			AvatarUrl:    profile.GetAvatarUrl(),
+			Age:          profile.GetAge(),
+			Country:      profile.GetCountry(),
+			ReferralCode: profile.GetReferralCode(),
+		},
+		Referrals:        referrals,
+		PromoActivations: activations,
+	}, nil
+}
@@ -4,10 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	"gitlab.com/pisya-dev/account-service/pkg/health"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/auth-service/internal/config"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
 	pb "gitlab.com/pisya-dev/auth-service/pkg/api/account_service" // замените на актуальный путь к protobuf
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationHeader = "authorization"
+	serviceTokenHeader  = "x-service-token"
+	userIdHeader        = "x-user-id"
 )
 
 type AccountServiceClient struct {
@@ -16,8 +25,16 @@ type AccountServiceClient struct {
 }
 
 func NewAccountServiceClient(config config.GrpcConfig) (*AccountServiceClient, error) {
+	creds, err := transportCredentials(config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load account service tls: %w", err)
+	}
+
 	conn, err := grpc.NewClient(config.AccountClientAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(tracing.ClientHandler()),
+		grpc.WithChainUnaryInterceptor(requestIDUnary, accountCredentials(config.AccountServiceToken)),
+		grpc.WithStreamInterceptor(requestIDStream),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to account service: %w", err)
@@ -29,6 +46,26 @@ func NewAccountServiceClient(config config.GrpcConfig) (*AccountServiceClient, e
 	}, nil
 }
 
+// accountCredentials передаёт в account-service access token пользователя, если запрос пришёл
+// от авторизованного пользователя, и сервисный токен для остальных вызовов, например при регистрации
+func accountCredentials(serviceToken string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if token, ok := ctx.Value(dto.AccessToken).(string); ok && token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, authorizationHeader, "Bearer "+token)
+		} else {
+			ctx = metadata.AppendToOutgoingContext(ctx, serviceTokenHeader, serviceToken)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// WithUserID передаёт в account-service id аккаунта, от имени которого вызывается метод
+// с сервисным токеном, например при регистрации компании
+func WithUserID(ctx context.Context, id string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, userIdHeader, id)
+}
+
 func (c *AccountServiceClient) Close() error {
 	return c.conn.Close()
 }
@@ -41,9 +78,38 @@ func (c *AccountServiceClient) CreateUserAccount(ctx context.Context, req *pb.Cr
 	return c.client.CreateUser(ctx, req)
 }
 
+func (c *AccountServiceClient) UpdateUserAccount(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	return c.client.UpdateUser(ctx, req)
+}
+
+func (c *AccountServiceClient) DeleteUserAccount(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	return c.client.DeleteUser(ctx, req)
+}
+
 func (c *AccountServiceClient) CreateBuisnessAccount(ctx context.Context, req *pb.CreateBuisnessRequest) (*pb.CreateBuisnessResponse, error) {
 	return c.client.CreateBuisness(ctx, req)
 }
 func (c *AccountServiceClient) GetBuisnessAccount(ctx context.Context, req *pb.GetBuisnessRequest) (*pb.GetBuisnessResponse, error) {
 	return c.client.GetBuisness(ctx, req)
 }
+
+func (c *AccountServiceClient) UpdateBusiness(ctx context.Context, req *pb.UpdateBusinessRequest) (*pb.UpdateBusinessResponse, error) {
+	return c.client.UpdateBusiness(ctx, req)
+}
+
+func (c *AccountServiceClient) DeleteBusiness(ctx context.Context, req *pb.DeleteBusinessRequest) (*pb.DeleteBusinessResponse, error) {
+	return c.client.DeleteBusiness(ctx, req)
+}
+
+func (c *AccountServiceClient) ListBusinesses(ctx context.Context, req *pb.ListBusinessesRequest) (*pb.ListBusinessesResponse, error) {
+	return c.client.ListBusinesses(ctx, req)
+}
+
+func (c *AccountServiceClient) GetReferralStats(ctx context.Context, req *pb.GetReferralStatsRequest) (*pb.GetReferralStatsResponse, error) {
+	return c.client.GetReferralStats(ctx, req)
+}
+
+// CheckHealth проверяет account-service по протоколу grpc.health.v1
+func (c *AccountServiceClient) CheckHealth(ctx context.Context) error {
+	return health.GRPCCheck(c.conn)(ctx)
+}
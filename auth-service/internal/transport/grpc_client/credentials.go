@@ -0,0 +1,39 @@
+package grpc_client
+
+import (
+	"context"
+
+	"gitlab.com/pisya-dev/account-service/pkg/mtls"
+	"gitlab.com/pisya-dev/auth-service/internal/config"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// transportCredentials возвращает mTLS-учётные данные клиента или insecure, если mTLS выключен
+func transportCredentials(cfg config.TLS) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	// конструкторы клиентов не получают контекст, поэтому логгер для ошибок ротации создаём здесь
+	ctx, err := logger.New(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	reloader, err := mtls.NewReloader(mtls.Config{
+		CertFile:       cfg.CertFile,
+		KeyFile:        cfg.KeyFile,
+		CAFile:         cfg.CAFile,
+		ReloadInterval: cfg.ReloadInterval,
+	}, func(err error) {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "filed reload tls certificates", zap.Error(err))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(reloader.ClientTLSConfig("")), nil
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"gitlab.com/pisya-dev/account-service/pkg/health"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/auth-service/internal/config"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
 	pb "gitlab.com/pisya-dev/auth-service/pkg/api/promopb"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type PromoSvcClient struct {
@@ -16,8 +19,16 @@ type PromoSvcClient struct {
 }
 
 func NewPromoServiceClient(config config.GrpcConfig) (*PromoSvcClient, error) {
+	creds, err := transportCredentials(config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load promo service tls: %w", err)
+	}
+
 	conn, err := grpc.NewClient(config.PromoClientAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(tracing.ClientHandler()),
+		grpc.WithChainUnaryInterceptor(requestIDUnary, promoCredentials(config.PromoServiceToken)),
+		grpc.WithStreamInterceptor(requestIDStream),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to account service: %w", err)
@@ -29,6 +40,116 @@ func NewPromoServiceClient(config config.GrpcConfig) (*PromoSvcClient, error) {
 	}, nil
 }
 
+// promoCredentials передаёт в promocode-service сервисный токен, без него недоступны ForgetUser и ExportUserData,
+// и id авторизованного пользователя, которого promocode-service записывает в журнал изменений
+func promoCredentials(serviceToken string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, serviceTokenHeader, serviceToken)
+		if userId, ok := ctx.Value(dto.UserID).(string); ok && userId != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, userIdHeader, userId)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
 func (p *PromoSvcClient) CreatePromo(ctx context.Context, req *pb.CreatePromoRequest) (*pb.CreatePromoResponse, error) {
 	return p.client.CreatePromo(ctx, req)
 }
+
+func (p *PromoSvcClient) GetPromo(ctx context.Context, req *pb.GetPromoRequest) (*pb.GetPromoResponse, error) {
+	return p.client.GetPromo(ctx, req)
+}
+
+func (p *PromoSvcClient) UpdatePromo(ctx context.Context, req *pb.UpdatePromoRequest) (*pb.UpdatePromoResponse, error) {
+	return p.client.UpdatePromo(ctx, req)
+}
+
+func (p *PromoSvcClient) ListPromo(ctx context.Context, req *pb.ListPromoRequest) (*pb.ListPromoResponse, error) {
+	return p.client.ListPromo(ctx, req)
+}
+
+func (p *PromoSvcClient) ListPromoFeed(ctx context.Context, req *pb.ListPromoFeedRequest) (*pb.ListPromoResponse, error) {
+	return p.client.ListPromoFeed(ctx, req)
+}
+
+func (p *PromoSvcClient) PublishPromo(ctx context.Context, req *pb.PublishPromoRequest) (*pb.PublishPromoResponse, error) {
+	return p.client.PublishPromo(ctx, req)
+}
+
+func (p *PromoSvcClient) PausePromo(ctx context.Context, req *pb.PausePromoRequest) (*pb.PausePromoResponse, error) {
+	return p.client.PausePromo(ctx, req)
+}
+
+func (p *PromoSvcClient) ResumePromo(ctx context.Context, req *pb.ResumePromoRequest) (*pb.ResumePromoResponse, error) {
+	return p.client.ResumePromo(ctx, req)
+}
+
+func (p *PromoSvcClient) ArchivePromo(ctx context.Context, req *pb.ArchivePromoRequest) (*pb.ArchivePromoResponse, error) {
+	return p.client.ArchivePromo(ctx, req)
+}
+
+func (p *PromoSvcClient) DeletePromo(ctx context.Context, req *pb.DeletePromoRequest) (*pb.DeletePromoResponse, error) {
+	return p.client.DeletePromo(ctx, req)
+}
+
+func (p *PromoSvcClient) RestorePromo(ctx context.Context, req *pb.RestorePromoRequest) (*pb.RestorePromoResponse, error) {
+	return p.client.RestorePromo(ctx, req)
+}
+
+func (p *PromoSvcClient) ListPromoAuditLog(ctx context.Context, req *pb.ListPromoAuditLogRequest) (*pb.ListPromoAuditLogResponse, error) {
+	return p.client.ListPromoAuditLog(ctx, req)
+}
+
+func (p *PromoSvcClient) ActivatePromo(ctx context.Context, req *pb.ActivatePromoRequest) (*pb.ActivatePromoResponse, error) {
+	return p.client.ActivatePromo(ctx, req)
+}
+
+func (p *PromoSvcClient) QuoteDiscount(ctx context.Context, req *pb.QuoteDiscountRequest) (*pb.QuoteDiscountResponse, error) {
+	return p.client.QuoteDiscount(ctx, req)
+}
+
+func (p *PromoSvcClient) ResolveApplicablePromos(ctx context.Context, req *pb.ResolveApplicablePromosRequest) (*pb.ResolveApplicablePromosResponse, error) {
+	return p.client.ResolveApplicablePromos(ctx, req)
+}
+
+func (p *PromoSvcClient) CreateWebhookEndpoint(ctx context.Context, req *pb.CreateWebhookEndpointRequest) (*pb.CreateWebhookEndpointResponse, error) {
+	return p.client.CreateWebhookEndpoint(ctx, req)
+}
+
+func (p *PromoSvcClient) ListWebhookEndpoints(ctx context.Context, req *pb.ListWebhookEndpointsRequest) (*pb.ListWebhookEndpointsResponse, error) {
+	return p.client.ListWebhookEndpoints(ctx, req)
+}
+
+func (p *PromoSvcClient) DeleteWebhookEndpoint(ctx context.Context, req *pb.DeleteWebhookEndpointRequest) (*pb.DeleteWebhookEndpointResponse, error) {
+	return p.client.DeleteWebhookEndpoint(ctx, req)
+}
+
+func (p *PromoSvcClient) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	return p.client.ListWebhookDeliveries(ctx, req)
+}
+
+func (p *PromoSvcClient) SetPromoImage(ctx context.Context, req *pb.SetPromoImageRequest) (*pb.SetPromoImageResponse, error) {
+	return p.client.SetPromoImage(ctx, req)
+}
+
+func (p *PromoSvcClient) ExportPromoData(ctx context.Context, req *pb.ExportPromoDataRequest) (grpc.ServerStreamingClient[pb.ExportPromoDataChunk], error) {
+	return p.client.ExportPromoData(ctx, req)
+}
+
+func (p *PromoSvcClient) RenderPromoCode(ctx context.Context, req *pb.RenderPromoCodeRequest) (*pb.RenderPromoCodeResponse, error) {
+	return p.client.RenderPromoCode(ctx, req)
+}
+
+func (p *PromoSvcClient) ForgetUser(ctx context.Context, req *pb.ForgetUserRequest) (*pb.ForgetUserResponse, error) {
+	return p.client.ForgetUser(ctx, req)
+}
+
+func (p *PromoSvcClient) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	return p.client.ExportUserData(ctx, req)
+}
+
+// CheckHealth проверяет promocode-service по протоколу grpc.health.v1
+func (p *PromoSvcClient) CheckHealth(ctx context.Context) error {
+	return health.GRPCCheck(p.conn)(ctx)
+}
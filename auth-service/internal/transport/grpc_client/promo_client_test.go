@@ -0,0 +1,37 @@
+package grpc_client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Тест: promocode-service получает сервисный токен и id авторизованного пользователя
+func TestPromoCredentials(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantUserId []string
+	}{
+		{name: "авторизованный пользователь", ctx: context.WithValue(context.Background(), dto.UserID, "user-1"), wantUserId: []string{"user-1"}},
+		{name: "без пользователя", ctx: context.Background(), wantUserId: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var md metadata.MD
+			invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				md, _ = metadata.FromOutgoingContext(ctx)
+				return nil
+			}
+
+			require.NoError(t, promoCredentials("secret")(tt.ctx, "/promo.PromoService/DeletePromo", nil, nil, nil, invoker))
+			require.Equal(t, []string{"secret"}, md.Get(serviceTokenHeader))
+			require.Equal(t, tt.wantUserId, md.Get(userIdHeader))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package grpc_client
+
+import (
+	"context"
+
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDHeader метаданные, в которых сервисы принимают сквозной идентификатор запроса
+const requestIDHeader = "x-request-id"
+
+// requestIDUnary передаёт идентификатор запроса из контекста в исходящие метаданные
+func requestIDUnary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withRequestID(ctx), method, req, reply, cc, opts...)
+}
+
+// requestIDStream то же для потоковых вызовов
+func requestIDStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withRequestID(ctx), desc, cc, method, opts...)
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	if requestID, ok := ctx.Value(dto.RequestID).(string); ok && requestID != "" {
+		return metadata.AppendToOutgoingContext(ctx, requestIDHeader, requestID)
+	}
+
+	return ctx
+}
@@ -2,17 +2,27 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"gitlab.com/pisya-dev/account-service/pkg/health"
 	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/internal/service"
 	jw "gitlab.com/pisya-dev/auth-service/pkg/jwt"
 	"gitlab.com/pisya-dev/auth-service/pkg/logger"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Service interface {
@@ -20,26 +30,73 @@ type Service interface {
 
 	AuthWithAccount(ctx context.Context, req *dto.AuthWithAccountReq) (string, error)
 	GetProfileFromDb(ctx context.Context, req *dto.GetProfileID) (*dto.AccountReqs, error)
+	UpdateProfile(ctx context.Context, req *dto.UserPatchReq, id string) (*dto.AccountReqs, error)
+	DeleteAccount(ctx context.Context, id string) error
+	ExportUserData(ctx context.Context, id string) (*dto.UserDataExport, error)
 
 	CreateBuisnessAccount(ctx context.Context, req *dto.AccountReqs, id string) error
 
+	GetBusinessProfile(ctx context.Context, id string) (*dto.BusinessProfileResp, error)
+	UpdateBusinessProfile(ctx context.Context, req *dto.BusinessProfileReq, id string) (*dto.BusinessProfileResp, error)
+	DeleteBusinessProfile(ctx context.Context, id string) error
+	ListBusinessProfiles(ctx context.Context, req *dto.ListBusinessesReq) ([]dto.BusinessProfileResp, int64, error)
+
 	CreatePromo(ctx context.Context, req *dto.PromoReq, id string) error
+	UpdatePromo(ctx context.Context, req *dto.PromoPatchReq, promoId string, id string) (*dto.PromoResp, error)
+	ListPromo(ctx context.Context, req *dto.ListPromoReq, id string) ([]dto.PromoResp, int64, error)
+
+	Feed(ctx context.Context, req *dto.FeedReq) ([]dto.PromoResp, int64, error)
+
+	ChangePromoStatus(ctx context.Context, action dto.PromoAction, promoId string, id string) (string, error)
+	DeletePromo(ctx context.Context, promoId string, id string) error
+	RestorePromo(ctx context.Context, promoId string, id string) error
+	ListPromoAuditLog(ctx context.Context, req *dto.AuditLogReq, promoId string, id string) ([]dto.AuditLogEntryResp, int64, error)
+	UploadPromoImage(ctx context.Context, promoId string, id string, data []byte) (*dto.PromoImageResp, error)
+	ActivatePromo(ctx context.Context, promoId string, id string) (string, error)
+	RenderPromoCode(ctx context.Context, req *dto.RenderCodeReq, promoId string, id string) ([]byte, string, error)
+	ExportPromoData(ctx context.Context, req *dto.ExportReq, promoId string, id string, start func(contentType string, filename string) io.Writer) error
+	QuoteDiscount(ctx context.Context, req *dto.QuoteReq) (*dto.QuoteResp, error)
+	ResolveApplicablePromos(ctx context.Context, req *dto.ResolveReq) (*dto.ResolveResp, error)
+
+	GetReferralStats(ctx context.Context, id string) (*dto.ReferralStatsResp, error)
+
+	CreateWebhookEndpoint(ctx context.Context, req *dto.WebhookEndpointReq, id string) (*dto.WebhookEndpointResp, error)
+	ListWebhookEndpoints(ctx context.Context, id string) ([]dto.WebhookEndpointResp, error)
+	DeleteWebhookEndpoint(ctx context.Context, endpointId string, id string) error
+	ListWebhookDeliveries(ctx context.Context, req *dto.WebhookDeliveriesReq, id string) ([]dto.WebhookDeliveryResp, int64, error)
 }
 
 type Handlers struct {
 	jwtService *jw.ServiceJWT
 
 	service Service
+
+	health *health.Checker
 }
 
-func NewHandlers(service Service, jwtService *jw.ServiceJWT) *Handlers {
-	return &Handlers{service: service, jwtService: jwtService}
+func NewHandlers(service Service, jwtService *jw.ServiceJWT, checker *health.Checker) *Handlers {
+	return &Handlers{service: service, jwtService: jwtService, health: checker}
 }
 
 func (h *Handlers) Ping(c echo.Context) error {
 	return c.String(http.StatusOK, "POONG!")
 }
 
+// Healthz liveness: процесс жив и обслуживает HTTP, зависимости не проверяются
+func (h *Handlers) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Readyz readiness: статус Postgres, Redis и downstream gRPC-сервисов по последней проверке
+func (h *Handlers) Readyz(c echo.Context) error {
+	ready, checks := h.health.Ready()
+	if !ready {
+		return c.JSON(http.StatusServiceUnavailable, dto.ReadinessResp{Status: "not ready", Checks: checks})
+	}
+
+	return c.JSON(http.StatusOK, dto.ReadinessResp{Status: "ready", Checks: checks})
+}
+
 func (h *Handlers) SingUp(c echo.Context) error {
 	const op = "transport.rest.SingUp"
 	ctx := c.Request().Context()
@@ -121,6 +178,88 @@ func (h *Handlers) Profile(c echo.Context) error {
 
 }
 
+func (h *Handlers) UpdateProfile(c echo.Context) error {
+	const op = "transport.rest.UpdateProfile"
+	ctx := c.Request().Context()
+
+	var req dto.UserPatchReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	profile, err := h.service.UpdateProfile(ctx, &req, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		if status.Code(err) == codes.NotFound || errors.Is(err, service.ErrUserNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"message": "Пользователь не найден."})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+	}
+
+	return c.JSON(http.StatusOK, profile)
+}
+
+func (h *Handlers) DeleteAccount(c echo.Context) error {
+	const op = "transport.rest.DeleteAccount"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	if err := h.service.DeleteAccount(ctx, id); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"message": "Пользователь не найден."})
+		}
+		return c.JSON(http.StatusBadGateway, map[string]string{"message": "Не удалось удалить аккаунт, повторите запрос."})
+	}
+
+	c.SetCookie(&http.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		HttpOnly: true,
+		Path:     "/",
+		MaxAge:   -1,
+	})
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Succesful"})
+}
+
+func (h *Handlers) ExportUserData(c echo.Context) error {
+	const op = "transport.rest.ExportUserData"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	export, err := h.service.ExportUserData(ctx, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		if status.Code(err) == codes.NotFound || errors.Is(err, service.ErrUserNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"message": "Пользователь не найден."})
+		}
+		return c.JSON(http.StatusBadGateway, map[string]string{"message": "Не удалось собрать данные, повторите запрос."})
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": "user-data-" + id + ".json"}))
+	c.Response().Header().Set("Cache-Control", "private, no-store")
+
+	return c.JSON(http.StatusOK, export)
+}
+
 func (h *Handlers) SingUpBuisness(c echo.Context) error {
 	const op = "transport.rest.SingUpBuisness"
 	ctx := c.Request().Context()
@@ -175,6 +314,9 @@ func (h *Handlers) CreatePromo(c echo.Context) error {
 
 	if err != nil {
 		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprint("%w error:", op), zap.Error(err))
+		if code, message, ok := idempotencyErrorResponse(err); ok {
+			return c.JSON(code, map[string]string{"message": message})
+		}
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "ERORRR"})
 	}
 
@@ -182,13 +324,629 @@ func (h *Handlers) CreatePromo(c echo.Context) error {
 
 }
 
+func (h *Handlers) UpdatePromo(c echo.Context) error {
+	const op = "transport.rest.UpdatePromo"
+	ctx := c.Request().Context()
+
+	var req dto.PromoPatchReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	promo, err := h.service.UpdatePromo(ctx, &req, c.Param("id"), id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return promoErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, promo)
+}
+
+func (h *Handlers) ListPromo(c echo.Context) error {
+	const op = "transport.rest.ListPromo"
+	ctx := c.Request().Context()
+
+	var req dto.ListPromoReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	promos, total, err := h.service.ListPromo(ctx, &req, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+	}
+
+	c.Response().Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+
+	return c.JSON(http.StatusOK, promos)
+}
+
+func (h *Handlers) Feed(c echo.Context) error {
+	const op = "transport.rest.Feed"
+	ctx := c.Request().Context()
+
+	var req dto.FeedReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+
+	promos, total, err := h.service.Feed(ctx, &req)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+	}
+
+	c.Response().Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+
+	return c.JSON(http.StatusOK, promos)
+}
+
+func (h *Handlers) ChangePromoStatus(action dto.PromoAction) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		const op = "transport.rest.ChangePromoStatus"
+		ctx := c.Request().Context()
+
+		id, err := h.getIdFromSubject(c)
+		if err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+		}
+
+		promoStatus, err := h.service.ChangePromoStatus(ctx, action, c.Param("id"), id)
+		if err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+			return promoErrorResponse(c, err)
+		}
+
+		return c.JSON(http.StatusOK, map[string]string{"status": promoStatus})
+	}
+}
+
+func (h *Handlers) DeletePromo(c echo.Context) error {
+	const op = "transport.rest.DeletePromo"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	if err := h.service.DeletePromo(ctx, c.Param("id"), id); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return promoErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Succesful"})
+}
+
+func (h *Handlers) RestorePromo(c echo.Context) error {
+	const op = "transport.rest.RestorePromo"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	if err := h.service.RestorePromo(ctx, c.Param("id"), id); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return promoErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Succesful"})
+}
+
+func (h *Handlers) ListPromoAuditLog(c echo.Context) error {
+	const op = "transport.rest.ListPromoAuditLog"
+	ctx := c.Request().Context()
+
+	var req dto.AuditLogReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	entries, total, err := h.service.ListPromoAuditLog(ctx, &req, c.Param("id"), id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return promoErrorResponse(c, err)
+	}
+
+	c.Response().Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+
+	return c.JSON(http.StatusOK, entries)
+}
+
+func (h *Handlers) UploadPromoImage(c echo.Context) error {
+	const op = "transport.rest.UploadPromoImage"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	// запас сверх размера изображения приходится на служебные части multipart
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, service.MaxImageSize+1<<20)
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return imageErrorResponse(c, service.ErrImageTooLarge)
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ожидается файл в поле image."})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ожидается файл в поле image."})
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, service.MaxImageSize+1))
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ожидается файл в поле image."})
+	}
+
+	promoImage, err := h.service.UploadPromoImage(ctx, c.Param("id"), id, data)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return imageErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, promoImage)
+}
+
+func (h *Handlers) ExportPromoData(c echo.Context) error {
+	const op = "transport.rest.ExportPromoData"
+	ctx := c.Request().Context()
+
+	var req dto.ExportReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	err = h.service.ExportPromoData(ctx, &req, c.Param("id"), id, func(contentType string, filename string) io.Writer {
+		c.Response().Header().Set(echo.HeaderContentType, contentType)
+		c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+		c.Response().Header().Set("Cache-Control", "private, no-store")
+		c.Response().WriteHeader(http.StatusOK)
+		return c.Response()
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		if c.Response().Committed {
+			// заголовки уже отправлены: обрываем соединение, чтобы клиент не принял неполный файл за целый
+			panic(http.ErrAbortHandler)
+		}
+		return promoErrorResponse(c, err)
+	}
+
+	return nil
+}
+
+func (h *Handlers) ActivatePromo(c echo.Context) error {
+	const op = "transport.rest.ActivatePromo"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	code, err := h.service.ActivatePromo(ctx, c.Param("id"), id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return activationErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.ActivateResp{Promo: code})
+}
+
+func (h *Handlers) RenderPromoCode(c echo.Context) error {
+	const op = "transport.rest.RenderPromoCode"
+	ctx := c.Request().Context()
+
+	var req dto.RenderCodeReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	image, contentType, err := h.service.RenderPromoCode(ctx, &req, c.Param("id"), id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		if status.Code(err) == codes.NotFound {
+			return c.JSON(http.StatusNotFound, map[string]string{"message": "Пользователь не активировал этот промокод."})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+	}
+
+	// код принадлежит пользователю, поэтому изображение нельзя кешировать в общих кешах
+	c.Response().Header().Set("Cache-Control", "private, no-store")
+
+	return c.Blob(http.StatusOK, contentType, image)
+}
+
+func (h *Handlers) QuoteDiscount(c echo.Context) error {
+	const op = "transport.rest.QuoteDiscount"
+	ctx := c.Request().Context()
+
+	var req dto.QuoteReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+
+	quote, err := h.service.QuoteDiscount(ctx, &req)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return promoErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, quote)
+}
+
+func (h *Handlers) ResolveApplicablePromos(c echo.Context) error {
+	const op = "transport.rest.ResolveApplicablePromos"
+	ctx := c.Request().Context()
+
+	var req dto.ResolveReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+
+	resolved, err := h.service.ResolveApplicablePromos(ctx, &req)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return promoErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resolved)
+}
+
+func (h *Handlers) ReferralStats(c echo.Context) error {
+	const op = "transport.rest.ReferralStats"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	stats, err := h.service.GetReferralStats(ctx, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		if status.Code(err) == codes.NotFound {
+			return c.JSON(http.StatusNotFound, map[string]string{"message": "Пользователь не найден."})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+func (h *Handlers) BusinessProfile(c echo.Context) error {
+	const op = "transport.rest.BusinessProfile"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	profile, err := h.service.GetBusinessProfile(ctx, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return businessErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, profile)
+}
+
+func (h *Handlers) UpdateBusinessProfile(c echo.Context) error {
+	const op = "transport.rest.UpdateBusinessProfile"
+	ctx := c.Request().Context()
+
+	var req dto.BusinessProfileReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	profile, err := h.service.UpdateBusinessProfile(ctx, &req, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return businessErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, profile)
+}
+
+func (h *Handlers) DeleteBusinessProfile(c echo.Context) error {
+	const op = "transport.rest.DeleteBusinessProfile"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	if err := h.service.DeleteBusinessProfile(ctx, id); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return businessErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Succesful"})
+}
+
+func (h *Handlers) ListBusinessProfiles(c echo.Context) error {
+	const op = "transport.rest.ListBusinessProfiles"
+	ctx := c.Request().Context()
+
+	var req dto.ListBusinessesReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+
+	profiles, total, err := h.service.ListBusinessProfiles(ctx, &req)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return businessErrorResponse(c, err)
+	}
+
+	c.Response().Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+
+	return c.JSON(http.StatusOK, profiles)
+}
+
+func (h *Handlers) CreateWebhookEndpoint(c echo.Context) error {
+	const op = "transport.rest.CreateWebhookEndpoint"
+	ctx := c.Request().Context()
+
+	var req dto.WebhookEndpointReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	endpoint, err := h.service.CreateWebhookEndpoint(ctx, &req, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return webhookErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, endpoint)
+}
+
+func (h *Handlers) ListWebhookEndpoints(c echo.Context) error {
+	const op = "transport.rest.ListWebhookEndpoints"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	endpoints, err := h.service.ListWebhookEndpoints(ctx, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return webhookErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, endpoints)
+}
+
+func (h *Handlers) DeleteWebhookEndpoint(c echo.Context) error {
+	const op = "transport.rest.DeleteWebhookEndpoint"
+	ctx := c.Request().Context()
+
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	if err := h.service.DeleteWebhookEndpoint(ctx, c.Param("id"), id); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return webhookErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Succesful"})
+}
+
+func (h *Handlers) ListWebhookDeliveries(c echo.Context) error {
+	const op = "transport.rest.ListWebhookDeliveries"
+	ctx := c.Request().Context()
+
+	var req dto.WebhookDeliveriesReq
+
+	if err := c.Bind(&req); err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return err
+	}
+	id, err := h.getIdFromSubject(c)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return c.JSON(http.StatusBadRequest, "invalid id  in jwt")
+	}
+
+	deliveries, total, err := h.service.ListWebhookDeliveries(ctx, &req, id)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, fmt.Sprintf("%s error:", op), zap.Error(err))
+		return webhookErrorResponse(c, err)
+	}
+
+	c.Response().Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+
+	return c.JSON(http.StatusOK, deliveries)
+}
+
+// promoErrorResponse переводит ошибку promocode-service в HTTP-ответ
+func promoErrorResponse(c echo.Context, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "Промокод не найден."})
+	case codes.PermissionDenied:
+		return c.JSON(http.StatusForbidden, map[string]string{"message": "Промокод не принадлежит этой компании."})
+	case codes.FailedPrecondition:
+		return c.JSON(http.StatusConflict, map[string]string{"message": "Недопустимый переход статуса промокода."})
+	}
+	return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+}
+
+// activationErrorResponse переводит отказ в активации промокода в HTTP-ответ
+func activationErrorResponse(c echo.Context, err error) error {
+	if code, message, ok := idempotencyErrorResponse(err); ok {
+		return c.JSON(code, map[string]string{"message": message})
+	}
+
+	switch {
+	case errors.Is(err, service.ErrNotUser):
+		return c.JSON(http.StatusForbidden, map[string]string{"status": "error", "message": "Промокоды активируют только пользователи."})
+	case errors.Is(err, service.ErrActivationFraud):
+		return c.JSON(http.StatusForbidden, map[string]string{"status": "error", "message": "Вы не можете использовать этот промокод."})
+	case errors.Is(err, service.ErrNoActivationsLeft):
+		return c.JSON(http.StatusForbidden, map[string]string{"status": "error", "message": "Промокоды закончились."})
+	case errors.Is(err, service.ErrPromoNotActive):
+		return c.JSON(http.StatusForbidden, map[string]string{"status": "error", "message": "Промокод сейчас не активен."})
+	case status.Code(err) == codes.ResourceExhausted:
+		return c.JSON(http.StatusForbidden, map[string]string{"status": "error", "message": "Превышен лимит активаций промокода."})
+	}
+	return promoErrorResponse(c, err)
+}
+
+// idempotencyErrorDomain домен errdetails.ErrorInfo, которым promocode-service помечает ошибки
+// Idempotency-Key. Ошибки обработчиков с теми же кодами gRPC этой пометки не имеют
+const idempotencyErrorDomain = "idempotency"
+
+// idempotencyErrorResponse код и сообщение для ошибок повтора по Idempotency-Key. false означает,
+// что ошибка с ключом не связана
+func idempotencyErrorResponse(err error) (int, string, bool) {
+	for _, detail := range status.Convert(err).Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != idempotencyErrorDomain {
+			continue
+		}
+
+		switch info.GetReason() {
+		case "INVALID_KEY":
+			return http.StatusBadRequest, "Некорректный Idempotency-Key.", true
+		case "IN_PROGRESS":
+			return http.StatusConflict, "Запрос с этим Idempotency-Key ещё выполняется.", true
+		case "KEY_REUSED":
+			return http.StatusUnprocessableEntity, "Idempotency-Key уже использован с другим запросом.", true
+		case "STORAGE_UNAVAILABLE":
+			return http.StatusServiceUnavailable, "Сервис временно недоступен.", true
+		}
+	}
+	return 0, "", false
+}
+
+// imageErrorResponse переводит ошибку загрузки изображения в HTTP-ответ
+func imageErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrImageTooLarge):
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"message": "Изображение слишком большое."})
+	case errors.Is(err, service.ErrUnsupportedImage):
+		return c.JSON(http.StatusUnsupportedMediaType, map[string]string{"message": "Поддерживаются изображения JPEG и PNG."})
+	case status.Code(err) == codes.Unknown:
+		return c.JSON(http.StatusBadGateway, map[string]string{"message": "Не удалось сохранить изображение."})
+	}
+	return promoErrorResponse(c, err)
+}
+
+// businessErrorResponse переводит ошибку account-service при работе с профилем компании в HTTP-ответ
+func businessErrorResponse(c echo.Context, err error) error {
+	if status.Code(err) == codes.NotFound {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "Компания не найдена."})
+	}
+	return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+}
+
+// webhookErrorResponse переводит ошибку управления вебхуками в HTTP-ответ
+func webhookErrorResponse(c echo.Context, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "Вебхук не найден."})
+	case codes.ResourceExhausted:
+		return c.JSON(http.StatusConflict, map[string]string{"message": "Превышено максимальное число вебхуков компании."})
+	}
+	return c.JSON(http.StatusBadRequest, map[string]string{"message": "Ошибка в данных запроса."})
+}
+
 func (h *Handlers) getIdFromSubject(c echo.Context) (string, error) {
 
 	authHeader := c.Request().Header.Get("Authorization")
 
 	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-	token, err := h.jwtService.DecodeKey(tokenString)
+	token, err := h.jwtService.DecodeAccessKey(tokenString)
 	if err != nil {
 
 		return "", err
@@ -204,9 +962,9 @@ func (h *Handlers) getIdFromSubject(c echo.Context) (string, error) {
 func (h *Handlers) generateNewToken(c echo.Context, ctx context.Context, id string) (string, error) {
 	const op = "transport.rest.GenerateNEwToken"
 
-	newAccesClaims := jwt.MapClaims{"sub": id, "exp": dto.AccesTimeExpr}
+	newAccesClaims := jwt.MapClaims{"sub": id, "exp": dto.AccesTimeExpr, "iat": time.Now().Unix(), "typ": jw.AccessTokenMode}
 
-	newRefreshClaims := jwt.MapClaims{"sub": id, "exp": dto.RefreshTimeExpr}
+	newRefreshClaims := jwt.MapClaims{"sub": id, "exp": dto.RefreshTimeExpr, "iat": time.Now().Unix(), "typ": jw.RefreshTokenMode}
 
 	accessToken, err := h.jwtService.Encode(newAccesClaims)
 	if err != nil {
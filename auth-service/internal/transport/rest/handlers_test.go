@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	jw "gitlab.com/pisya-dev/auth-service/pkg/jwt"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeService реализует только методы, которые вызывает тест; остальные методы Service паникуют
+type fakeService struct {
+	Service
+
+	updatePromo func(ctx context.Context, req *dto.PromoPatchReq, promoId string, id string) (*dto.PromoResp, error)
+}
+
+func (f *fakeService) UpdatePromo(ctx context.Context, req *dto.PromoPatchReq, promoId string, id string) (*dto.PromoResp, error) {
+	return f.updatePromo(ctx, req, promoId, id)
+}
+
+func newTestJWT(t *testing.T) *jw.ServiceJWT {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return jw.NewServiceJWT(key, &key.PublicKey, time.Hour, time.Hour)
+}
+
+// Тест: PATCH /business/promo/:id передаёт в сервис только переданные поля вместе с переводами описания
+func TestHandlers_UpdatePromo(t *testing.T) {
+	jwtService := newTestJWT(t)
+	token, err := jwtService.Encode(jwtService.GetClaims("company-1", jw.AccessTokenMode))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{name: "успех", wantCode: http.StatusOK},
+		{name: "недопустимые даты", err: status.Error(codes.FailedPrecondition, "active dates require invalid status transition"), wantCode: http.StatusConflict},
+		{name: "чужой промокод", err: status.Error(codes.PermissionDenied, "permission denied"), wantCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{
+				updatePromo: func(ctx context.Context, req *dto.PromoPatchReq, promoId string, id string) (*dto.PromoResp, error) {
+					assert.Equal(t, "promo-1", promoId)
+					assert.Equal(t, "company-1", id)
+					assert.Equal(t, map[string]string{"de": "Neue Beschreibung"}, req.DescriptionTranslations)
+					assert.Nil(t, req.Description)
+					assert.Nil(t, req.DefaultLocale)
+					assert.Nil(t, req.Target)
+
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &dto.PromoResp{PromoId: promoId, DescriptionTranslations: req.DescriptionTranslations}, nil
+				},
+			}
+			h := NewHandlers(svc, jwtService, nil)
+
+			e := echo.New()
+			e.PATCH("/business/promo/:id", h.UpdatePromo)
+
+			req := httptest.NewRequest(http.MethodPatch, "/business/promo/promo-1", strings.NewReader(`{"description_translations":{"de":"Neue Beschreibung"}}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set("Authorization", "Bearer "+token)
+			req = req.WithContext(context.WithValue(req.Context(), dto.Logger, &logger.Logger{L: zap.NewNop()}))
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantCode, rec.Code)
+			if tt.err == nil {
+				var promo dto.PromoResp
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &promo))
+				assert.Equal(t, "promo-1", promo.PromoId)
+				assert.Equal(t, map[string]string{"de": "Neue Beschreibung"}, promo.DescriptionTranslations)
+			}
+		})
+	}
+}
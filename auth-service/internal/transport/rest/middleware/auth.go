@@ -1,25 +1,43 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"gitlab.com/pisya-dev/account-service/pkg/reqlog"
 	"gitlab.com/pisya-dev/auth-service/internal/dto"
 	jw "gitlab.com/pisya-dev/auth-service/pkg/jwt"
 	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"gitlab.com/pisya-dev/auth-service/pkg/ratelimit"
 	"go.uber.org/zap"
 )
 
 type Middleware struct {
 	jwtService *jw.ServiceJWT
+
+	redisClient *redis.Client
+
+	logPolicy *reqlog.Policy
+
+	limiter *ratelimit.Limiter
+	limits  ratelimit.Rules
 }
 
-func NewMiddlware(jwtServ *jw.ServiceJWT) *Middleware {
-	return &Middleware{jwtService: jwtServ}
+func NewMiddlware(jwtServ *jw.ServiceJWT, redisClient *redis.Client, logPolicy *reqlog.Policy, limits ratelimit.Rules) *Middleware {
+	return &Middleware{
+		jwtService:  jwtServ,
+		redisClient: redisClient,
+		logPolicy:   logPolicy,
+		limiter:     ratelimit.New(redisClient),
+		limits:      limits,
+	}
 }
 
 func (m *Middleware) Auth(next echo.HandlerFunc) echo.HandlerFunc {
@@ -27,7 +45,7 @@ func (m *Middleware) Auth(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
 
-		if c.Request().URL.Path == "/user/auth/sign-up" || c.Request().URL.Path == "/user/auth/sign-in" || c.Request().URL.Path == "/business/auth/sign-up" || c.Request().URL.Path == "/business/auth/sign-in" {
+		if c.Request().URL.Path == "/user/auth/sign-up" || c.Request().URL.Path == "/user/auth/sign-in" || c.Request().URL.Path == "/business/auth/sign-up" || c.Request().URL.Path == "/business/auth/sign-in" || c.Request().URL.Path == "/healthz" || c.Request().URL.Path == "/readyz" || strings.HasPrefix(c.Request().URL.Path, "/media/") {
 			if err := next(c); err != nil {
 				c.Error(err)
 			}
@@ -38,7 +56,7 @@ func (m *Middleware) Auth(next echo.HandlerFunc) echo.HandlerFunc {
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-		_, err := m.jwtService.DecodeKey(tokenString)
+		access, err := m.jwtService.DecodeAccessKey(tokenString)
 
 		if err != nil {
 			refresh, err := c.Cookie("refresh_token")
@@ -49,7 +67,7 @@ func (m *Middleware) Auth(next echo.HandlerFunc) echo.HandlerFunc {
 				return echo.NewHTTPError(http.StatusBadRequest, "Invalid access and none refresh jwt")
 			}
 
-			claims, err := m.jwtService.DecodeKey(refresh.Value)
+			claims, err := m.jwtService.DecodeRefreshKey(refresh.Value)
 			if errors.Is(err, jw.ErrorInvalidToken) {
 				return echo.NewHTTPError(http.StatusBadRequest, "Invalid access and refresh jwt")
 			}
@@ -58,10 +76,13 @@ func (m *Middleware) Auth(next echo.HandlerFunc) echo.HandlerFunc {
 			if err != nil {
 				return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID in JWT")
 			}
+			if m.revoked(ctx, &claims.RegisteredClaims) {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Token revoked")
+			}
 
-			newAccesClaims := jwt.MapClaims{"sub": guid, "exp": dto.AccesTimeExpr}
+			newAccesClaims := jwt.MapClaims{"sub": guid, "exp": dto.AccesTimeExpr, "iat": time.Now().Unix(), "typ": jw.AccessTokenMode}
 
-			newRefreshClaims := jwt.MapClaims{"sub": guid, "exp": dto.RefreshTimeExpr}
+			newRefreshClaims := jwt.MapClaims{"sub": guid, "exp": dto.RefreshTimeExpr, "iat": time.Now().Unix(), "typ": jw.RefreshTokenMode}
 
 			accessToken, err := m.jwtService.Encode(newAccesClaims)
 			if err != nil {
@@ -85,6 +106,14 @@ func (m *Middleware) Auth(next echo.HandlerFunc) echo.HandlerFunc {
 
 		}
 
+		if m.revoked(ctx, &access.RegisteredClaims) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Token revoked")
+		}
+
+		ctx = context.WithValue(ctx, dto.AccessToken, tokenString)
+		ctx = context.WithValue(ctx, dto.UserID, access.Subject)
+		c.SetRequest(c.Request().WithContext(ctx))
+
 		if err := next(c); err != nil {
 			c.Error(err)
 		}
@@ -92,3 +121,19 @@ func (m *Middleware) Auth(next echo.HandlerFunc) echo.HandlerFunc {
 
 	}
 }
+
+// revoked проверяет, выдан ли токен до отзыва токенов пользователя при удалении аккаунта.
+// При недоступном redis запрос пропускается: отказ redis не должен блокировать всех пользователей
+func (m *Middleware) revoked(ctx context.Context, claims *jwt.RegisteredClaims) bool {
+	revokedAt, err := m.redisClient.Get(ctx, dto.RevokedUserKey(claims.Subject)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return false
+	}
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Info(ctx, "check revoked jwt :", zap.Error(err))
+
+		return false
+	}
+
+	return claims.IssuedAt == nil || claims.IssuedAt.Unix() <= revokedAt
+}
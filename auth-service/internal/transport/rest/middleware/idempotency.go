@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	headerIdempotencyKey = "Idempotency-Key"
+
+	idempotencyKeyMaxLen = 255
+)
+
+// IdempotencyKey передаёт заголовок Idempotency-Key в gRPC-метаданных idempotency-key. По нему
+// promo-service возвращает сохранённый ответ на повтор запроса вместо повторного выполнения.
+// Подключается к отдельным маршрутам, запрос без заголовка выполняется как обычно
+func (m *Middleware) IdempotencyKey(next echo.HandlerFunc) echo.HandlerFunc {
+
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(headerIdempotencyKey)
+		if key == "" {
+			return next(c)
+		}
+
+		if !validIdempotencyKey(key) {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Idempotency-Key")
+		}
+
+		ctx := metadata.AppendToOutgoingContext(c.Request().Context(), "idempotency-key", key)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+
+}
+
+// validIdempotencyKey допускает печатные ASCII-символы, как в значениях HTTP-заголовков
+func validIdempotencyKey(key string) bool {
+	if len(key) > idempotencyKeyMaxLen {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x21 || key[i] > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
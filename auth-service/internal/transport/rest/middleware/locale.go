@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxLocales ограничивает число языков из Accept-Language, передаваемых дальше
+const maxLocales = 10
+
+// Locale разбирает Accept-Language и передаёт языки в порядке убывания веса в gRPC-метаданных accept-language.
+// По ним promo-service выбирает перевод описания промокода. Некорректный заголовок игнорируется
+func (m *Middleware) Locale(next echo.HandlerFunc) echo.HandlerFunc {
+
+	return func(c echo.Context) error {
+		tags, _, err := language.ParseAcceptLanguage(c.Request().Header.Get("Accept-Language"))
+		if err != nil || len(tags) == 0 {
+			return next(c)
+		}
+
+		if len(tags) > maxLocales {
+			tags = tags[:maxLocales]
+		}
+
+		kv := make([]string, 0, 2*len(tags))
+		for _, tag := range tags {
+			kv = append(kv, "accept-language", tag.String())
+		}
+
+		ctx := metadata.AppendToOutgoingContext(c.Request().Context(), kv...)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+
+}
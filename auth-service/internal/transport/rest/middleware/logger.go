@@ -2,43 +2,52 @@ package middleware
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"gitlab.com/pisya-dev/auth-service/internal/dto"
 	"gitlab.com/pisya-dev/auth-service/pkg/logger"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
+// requestIDMaxLen ограничивает длину принятого X-Request-ID, чтобы он не раздувал логи
+const requestIDMaxLen = 128
+
+// Logger принимает X-Request-ID клиента или создаёт новый, кладёт его в контекст запроса,
+// откуда он попадает в логи и gRPC-метаданные, и возвращает его в заголовке ответа.
+// О каждом запросе пишется одна строка: уровень, семплирование успешных запросов и скрытие
+// полей тела задаёт политика логирования
 func (m *Middleware) Logger(next echo.HandlerFunc) echo.HandlerFunc {
+	logRequest := m.logPolicy.EchoMiddleware(func(ctx context.Context, level zapcore.Level, msg string, fields ...zap.Field) {
+		logger.GetLoggerFromCtx(ctx).Log(ctx, level, msg, fields...)
+	})(next)
 
 	return func(c echo.Context) error {
-		// Генерация request ID
-		guid := uuid.New()
-		reqID := strconv.FormatUint(uint64(guid.ID()), 10)
-
-		// Получение оригинального контекста
-		ctx := c.Request().Context()
+		reqID := c.Request().Header.Get(echo.HeaderXRequestID)
+		if !validRequestID(reqID) {
+			reqID = uuid.NewString()
+		}
 
-		// Создание нового контекста с request ID
-		ctx = context.WithValue(ctx, dto.RequestID, reqID)
+		ctx := context.WithValue(c.Request().Context(), dto.RequestID, reqID)
+		c.SetRequest(c.Request().WithContext(ctx))
+		c.Response().Header().Set(echo.HeaderXRequestID, reqID)
 
-		// Обновление контекста в echo.Context
-		req := c.Request().WithContext(ctx)
-		c.SetRequest(req)
+		return logRequest(c)
+	}
 
-		// Теперь можешь использовать этот контекст
-		logger.GetLoggerFromCtx(ctx).Info(ctx,
-			"request", zap.String("method", c.Request().Method),
-			zap.Time("request_time", time.Now()), zap.String("request_id", reqID))
+}
 
-		// Передача дальше
-		if err := next(c); err != nil {
-			c.Error(err)
+func validRequestID(id string) bool {
+	if id == "" || len(id) > requestIDMaxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.' || c == ':') {
+			return false
 		}
-		return nil
 	}
 
+	return true
 }
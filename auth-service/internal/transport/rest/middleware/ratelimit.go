@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RateLimit ограничивает число запросов к маршруту. Авторизованные запросы считаются по пользователю,
+// остальные, например вход и регистрация, по IP клиента. Стоит после Auth, чтобы знать пользователя
+func (m *Middleware) RateLimit(next echo.HandlerFunc) echo.HandlerFunc {
+
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		route := c.Request().Method + " " + c.Path()
+
+		limit, ok := m.limits.For(route)
+		if !ok {
+			return next(c)
+		}
+
+		subject := "ip:" + c.RealIP()
+		if userID, ok := ctx.Value(dto.UserID).(string); ok && userID != "" {
+			subject = "user:" + userID
+		}
+
+		result, err := m.limiter.Allow(ctx, route+":"+subject, limit)
+		if err != nil {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, "rate limit check failed", zap.String("route", route), zap.Error(err))
+
+			if m.limits.FailOpen {
+				return next(c)
+			}
+			return echo.NewHTTPError(http.StatusServiceUnavailable, "Rate limiter unavailable")
+		}
+
+		header := c.Response().Header()
+		header.Set(headerRateLimitLimit, strconv.Itoa(result.Limit))
+		header.Set(headerRateLimitRemaining, strconv.Itoa(result.Remaining))
+		header.Set(headerRateLimitReset, seconds(result.Reset))
+
+		if !result.Allowed {
+			header.Set(echo.HeaderRetryAfter, seconds(result.RetryAfter))
+			return echo.NewHTTPError(http.StatusTooManyRequests, "Too many requests")
+		}
+
+		return next(c)
+	}
+}
+
+// seconds округляет вверх, чтобы клиент не повторил запрос раньше времени
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
+	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"gitlab.com/pisya-dev/auth-service/pkg/ratelimit"
+	"go.uber.org/zap"
+)
+
+const activateRoute = "/user/promo/:id/activate"
+
+func newRateLimitMiddleware(t *testing.T, failOpen bool) (*Middleware, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return &Middleware{
+		limiter: ratelimit.New(client),
+		limits: ratelimit.Rules{
+			Routes:   map[string]ratelimit.Limit{http.MethodPost + " " + activateRoute: {Rate: 1, Period: time.Minute}},
+			FailOpen: failOpen,
+		},
+	}, mr
+}
+
+// callRateLimit вызывает middleware для запроса с IP ip и сообщает, дошёл ли запрос до обработчика
+func callRateLimit(m *Middleware, ip string) (*httptest.ResponseRecorder, bool, error) {
+	req := httptest.NewRequest(http.MethodPost, "/user/promo/p1/activate", nil)
+	req.Header.Set(echo.HeaderXRealIP, ip)
+	req = req.WithContext(context.WithValue(req.Context(), dto.Logger, &logger.Logger{L: zap.NewNop()}))
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetPath(activateRoute)
+
+	called := false
+	err := m.RateLimit(func(c echo.Context) error {
+		called = true
+		return c.NoContent(http.StatusOK)
+	})(c)
+
+	return rec, called, err
+}
+
+// Тест превышения лимита: 429 с Retry-After и заголовками X-RateLimit-*
+func TestRateLimit_TooManyRequests(t *testing.T) {
+	m, _ := newRateLimitMiddleware(t, true)
+
+	rec, called, err := callRateLimit(m, "10.0.0.1")
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, "1", rec.Header().Get(headerRateLimitLimit))
+	assert.Equal(t, "0", rec.Header().Get(headerRateLimitRemaining))
+	assert.Equal(t, "60", rec.Header().Get(headerRateLimitReset))
+
+	rec, called, err = callRateLimit(m, "10.0.0.1")
+	assert.False(t, called)
+
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+	assert.Equal(t, "60", rec.Header().Get(echo.HeaderRetryAfter))
+	assert.Equal(t, "1", rec.Header().Get(headerRateLimitLimit))
+	assert.Equal(t, "0", rec.Header().Get(headerRateLimitRemaining))
+
+	// лимит считается по IP клиента
+	_, called, err = callRateLimit(m, "10.0.0.2")
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+// Тест недоступного redis при RATE_LIMIT_FAIL_OPEN=true: запрос пропускается
+func TestRateLimit_RedisDownFailOpen(t *testing.T) {
+	m, mr := newRateLimitMiddleware(t, true)
+	mr.Close()
+
+	_, called, err := callRateLimit(m, "10.0.0.1")
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+// Тест недоступного redis при RATE_LIMIT_FAIL_OPEN=false: запрос отклоняется с 503
+func TestRateLimit_RedisDownFailClosed(t *testing.T) {
+	m, mr := newRateLimitMiddleware(t, false)
+	mr.Close()
+
+	_, called, err := callRateLimit(m, "10.0.0.1")
+	assert.False(t, called)
+
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+}
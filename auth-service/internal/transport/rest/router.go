@@ -8,9 +8,13 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/auth-service/internal/config"
+	"gitlab.com/pisya-dev/auth-service/internal/dto"
 	"gitlab.com/pisya-dev/auth-service/internal/transport/rest/middleware"
 	"gitlab.com/pisya-dev/auth-service/pkg/logger"
+	"gitlab.com/pisya-dev/auth-service/pkg/storage"
 	"go.uber.org/zap"
 )
 
@@ -22,25 +26,67 @@ type Router struct {
 	config config.Rest
 }
 
-func NewRouter(cfg config.Rest, handlers *Handlers, ctx context.Context, middleware *middleware.Middleware) *Router {
+func NewRouter(cfg config.Rest, storageCfg config.Storage, handlers *Handlers, ctx context.Context, middleware *middleware.Middleware) *Router {
 	e := echo.New()
 
 	e.Server.BaseContext = func(_ net.Listener) context.Context {
 		return ctx
 	}
 
+	// сервис стоит за nginx, который передаёт адрес клиента в X-Real-IP
+	e.IPExtractor = echo.ExtractIPFromRealIPHeader()
+
 	//hasndlers
+	e.Use(metrics.Middleware)
+	e.Use(tracing.Middleware)
 	e.Use(middleware.Logger)
 	e.Use(middleware.Auth)
+	e.Use(middleware.RateLimit)
+	e.Use(middleware.Locale)
 
 	e.POST("/user/auth/sign-up", handlers.SingUp)
 	e.POST("/user/auth/sign-in", handlers.SignIn)
 	e.POST("/business/auth/sign-up", handlers.SingUpBuisness)
 	e.POST("/business/auth/sign-in", handlers.SignIn)
-	e.POST("/business/promo", handlers.CreatePromo)
+	e.POST("/business/promo", handlers.CreatePromo, middleware.IdempotencyKey)
+	e.GET("/business/promo", handlers.ListPromo)
+	e.POST("/business/promo/:id/publish", handlers.ChangePromoStatus(dto.PromoActionPublish))
+	e.POST("/business/promo/:id/pause", handlers.ChangePromoStatus(dto.PromoActionPause))
+	e.POST("/business/promo/:id/resume", handlers.ChangePromoStatus(dto.PromoActionResume))
+	e.POST("/business/promo/:id/archive", handlers.ChangePromoStatus(dto.PromoActionArchive))
+	e.PATCH("/business/promo/:id", handlers.UpdatePromo)
+	e.DELETE("/business/promo/:id", handlers.DeletePromo)
+	e.POST("/business/promo/:id/restore", handlers.RestorePromo)
+	e.GET("/business/promo/:id/audit", handlers.ListPromoAuditLog)
+	e.POST("/business/promo/:id/image", handlers.UploadPromoImage)
+	e.GET("/business/promo/:id/export", handlers.ExportPromoData)
+	e.GET("/business/profile", handlers.BusinessProfile)
+	e.PUT("/business/profile", handlers.UpdateBusinessProfile)
+	e.DELETE("/business/profile", handlers.DeleteBusinessProfile)
+	e.GET("/business/profile/list", handlers.ListBusinessProfiles)
+	e.POST("/business/webhooks", handlers.CreateWebhookEndpoint)
+	e.GET("/business/webhooks", handlers.ListWebhookEndpoints)
+	e.GET("/business/webhooks/deliveries", handlers.ListWebhookDeliveries)
+	e.DELETE("/business/webhooks/:id", handlers.DeleteWebhookEndpoint)
 
 	e.GET(("/user/profile"), handlers.Profile)
+	e.PATCH("/user/profile", handlers.UpdateProfile)
+	e.DELETE("/user/account", handlers.DeleteAccount)
+	e.GET("/user/data-export", handlers.ExportUserData)
+	e.GET("/user/feed", handlers.Feed)
+	e.POST("/user/promo/:id/activate", handlers.ActivatePromo, middleware.IdempotencyKey)
+	e.GET("/user/promo/:id/code", handlers.RenderPromoCode)
+	e.POST("/user/promo/quote", handlers.QuoteDiscount)
+	e.POST("/user/promo/resolve", handlers.ResolveApplicablePromos)
+	e.GET("/user/referrals", handlers.ReferralStats)
 	e.GET("/ping", handlers.Ping)
+	e.GET("/healthz", handlers.Healthz)
+	e.GET("/readyz", handlers.Readyz)
+
+	// изображения из локального хранилища раздаются самим сервисом
+	if storageCfg.Backend == storage.BackendLocal {
+		e.Static("/media", storageCfg.LocalDir)
+	}
 	//e.GET("/", h.asdasd)
 
 	return &Router{router: e, handlers: handlers, config: cfg}
@@ -56,3 +102,8 @@ func (r *Router) Run(ctx context.Context) {
 	}
 
 }
+
+// Shutdown перестаёт принимать соединения и ждёт завершения текущих запросов
+func (r *Router) Shutdown(ctx context.Context) error {
+	return r.router.Shutdown(ctx)
+}
@@ -9,6 +9,7 @@ package account_service
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -108,6 +109,7 @@ type GetUserProfileResponse struct {
 	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	Age           int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
 	Country       string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	ReferralCode  string                 `protobuf:"bytes,6,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -177,6 +179,13 @@ func (x *GetUserProfileResponse) GetCountry() string {
 	return ""
 }
 
+func (x *GetUserProfileResponse) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
 type GetUserProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
@@ -354,8 +363,10 @@ func (x *GetBuisnessRequest) GetId() string {
 }
 
 type GetBuisnessResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// дублирует profile.name для клиентов, которым нужно только название
+	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Profile       *BusinessProfile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -397,32 +408,42 @@ func (x *GetBuisnessResponse) GetName() string {
 	return ""
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Surname       string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
-	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+func (x *GetBuisnessResponse) GetProfile() *BusinessProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type BusinessProfile struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl      string                 `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Website      string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	ContactEmail string                 `protobuf:"bytes,6,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Categories   []string               `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	// код страны ISO 3166-1 alpha-2
+	Country       string `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *BusinessProfile) Reset() {
+	*x = BusinessProfile{}
 	mi := &file_api_protos_account_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *BusinessProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*BusinessProfile) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *BusinessProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_api_protos_account_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -434,73 +455,96 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BusinessProfile.ProtoReflect.Descriptor instead.
+func (*BusinessProfile) Descriptor() ([]byte, []int) {
 	return file_api_protos_account_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateUserRequest) GetId() string {
+func (x *BusinessProfile) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetName() string {
+func (x *BusinessProfile) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetSurname() string {
+func (x *BusinessProfile) GetDescription() string {
 	if x != nil {
-		return x.Surname
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetAvatarUrl() string {
+func (x *BusinessProfile) GetLogoUrl() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.LogoUrl
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetAge() int32 {
+func (x *BusinessProfile) GetWebsite() string {
 	if x != nil {
-		return x.Age
+		return x.Website
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateUserRequest) GetCountry() string {
+func (x *BusinessProfile) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *BusinessProfile) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *BusinessProfile) GetCountry() string {
 	if x != nil {
 		return x.Country
 	}
 	return ""
 }
 
-type CreateUserResponse struct {
+// UpdateBusinessRequest перезаписывает профиль целиком, id берётся из jwt если он передан
+type UpdateBusinessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,6,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Categories    []string               `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	Country       string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
+func (x *UpdateBusinessRequest) Reset() {
+	*x = UpdateBusinessRequest{}
 	mi := &file_api_protos_account_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *UpdateBusinessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*UpdateBusinessRequest) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateBusinessRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_protos_account_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -512,37 +556,88 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBusinessRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBusinessRequest) Descriptor() ([]byte, []int) {
 	return file_api_protos_account_proto_rawDescGZIP(), []int{9}
 }
 
-type UpdateUserRequest struct {
+func (x *UpdateBusinessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBusinessRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBusinessRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateBusinessRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateBusinessRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *UpdateBusinessRequest) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *UpdateBusinessRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UpdateBusinessRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type UpdateBusinessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Surname       string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
-	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	Profile       *BusinessProfile       `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
+func (x *UpdateBusinessResponse) Reset() {
+	*x = UpdateBusinessResponse{}
 	mi := &file_api_protos_account_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *UpdateBusinessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*UpdateBusinessResponse) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateBusinessResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_protos_account_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,74 +649,124 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBusinessResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBusinessResponse) Descriptor() ([]byte, []int) {
 	return file_api_protos_account_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateUserRequest) GetUuid() string {
+func (x *UpdateBusinessResponse) GetProfile() *BusinessProfile {
 	if x != nil {
-		return x.Uuid
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateUserRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type DeleteBusinessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) GetSurname() string {
+func (x *DeleteBusinessRequest) Reset() {
+	*x = DeleteBusinessRequest{}
+	mi := &file_api_protos_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBusinessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBusinessRequest) ProtoMessage() {}
+
+func (x *DeleteBusinessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[11]
 	if x != nil {
-		return x.Surname
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserRequest) GetAvatarUrl() string {
+// Deprecated: Use DeleteBusinessRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBusinessRequest) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteBusinessRequest) GetId() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetAge() int32 {
-	if x != nil {
-		return x.Age
-	}
-	return 0
+type DeleteBusinessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) GetCountry() string {
+func (x *DeleteBusinessResponse) Reset() {
+	*x = DeleteBusinessResponse{}
+	mi := &file_api_protos_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBusinessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBusinessResponse) ProtoMessage() {}
+
+func (x *DeleteBusinessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[12]
 	if x != nil {
-		return x.Country
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type UpdateUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// Deprecated: Use DeleteBusinessResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBusinessResponse) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{12}
+}
+
+type ListBusinessesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Country  *string                `protobuf:"bytes,1,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	Category *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	// по умолчанию 20, не больше 100
+	Limit         int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserResponse) Reset() {
-	*x = UpdateUserResponse{}
-	mi := &file_api_protos_account_proto_msgTypes[11]
+func (x *ListBusinessesRequest) Reset() {
+	*x = ListBusinessesRequest{}
+	mi := &file_api_protos_account_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserResponse) String() string {
+func (x *ListBusinessesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserResponse) ProtoMessage() {}
+func (*ListBusinessesRequest) ProtoMessage() {}
 
-func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_protos_account_proto_msgTypes[11]
+func (x *ListBusinessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,33 +777,62 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_api_protos_account_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListBusinessesRequest.ProtoReflect.Descriptor instead.
+func (*ListBusinessesRequest) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{13}
 }
 
-type DeleteUserRequest struct {
+func (x *ListBusinessesRequest) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *ListBusinessesRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ListBusinessesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBusinessesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListBusinessesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Businesses    []*BusinessProfile     `protobuf:"bytes,1,rep,name=businesses,proto3" json:"businesses,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_api_protos_account_proto_msgTypes[12]
+func (x *ListBusinessesResponse) Reset() {
+	*x = ListBusinessesResponse{}
+	mi := &file_api_protos_account_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *ListBusinessesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*ListBusinessesResponse) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_protos_account_proto_msgTypes[12]
+func (x *ListBusinessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,39 +843,54 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_protos_account_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListBusinessesResponse.ProtoReflect.Descriptor instead.
+func (*ListBusinessesResponse) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeleteUserRequest) GetId() string {
+func (x *ListBusinessesResponse) GetBusinesses() []*BusinessProfile {
 	if x != nil {
-		return x.Id
+		return x.Businesses
 	}
-	return ""
+	return nil
 }
 
-type DeleteUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ListBusinessesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateUserRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Surname   string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Age       int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
+	Country   string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	// код пригласившего пользователя
+	ReferrerCode  string `protobuf:"bytes,7,opt,name=referrer_code,json=referrerCode,proto3" json:"referrer_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
-	mi := &file_api_protos_account_proto_msgTypes[13]
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_api_protos_account_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *CreateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*CreateUserRequest) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_protos_account_proto_msgTypes[13]
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,26 +901,667 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_api_protos_account_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{15}
 }
 
-var File_api_protos_account_proto protoreflect.FileDescriptor
+func (x *CreateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-const file_api_protos_account_proto_rawDesc = "" +
-	"\n" +
-	"\x18api/protos/account.proto\x12\x03api\"\r\n" +
-	"\vPingRequest\"0\n" +
-	"\fPingResponse\x12 \n" +
-	"\vpingMessage\x18\x01 \x01(\tR\vpingMessage\"\x91\x01\n" +
-	"\x16GetUserProfileResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\asurname\x18\x02 \x01(\tR\asurname\x12\x1d\n" +
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetReferrerCode() string {
+	if x != nil {
+		return x.ReferrerCode
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_api_protos_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{16}
+}
+
+type UpdateUserRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Uuid      string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Surname   string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Age       int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
+	Country   string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	// обновляемые поля: name, surname, avatar_url, age, country.
+	// Пустая маска обновляет все поля
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_api_protos_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateUserRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateUserResponse профиль после обновления
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Surname       string                 `protobuf:"bytes,2,opt,name=surname,proto3" json:"surname,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Age           int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
+	Country       string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	ReferralCode  string                 `protobuf:"bytes,6,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_api_protos_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateUserResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserResponse) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UpdateUserResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserResponse) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *UpdateUserResponse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *UpdateUserResponse) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_api_protos_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_api_protos_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{20}
+}
+
+type ReferrerReward struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// сумма в минимальных единицах валюты
+	Amount        int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferrerReward) Reset() {
+	*x = ReferrerReward{}
+	mi := &file_api_protos_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferrerReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferrerReward) ProtoMessage() {}
+
+func (x *ReferrerReward) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferrerReward.ProtoReflect.Descriptor instead.
+func (*ReferrerReward) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReferrerReward) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReferrerReward) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ConvertReferralRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RefereeId string                 `protobuf:"bytes,1,opt,name=referee_id,json=refereeId,proto3" json:"referee_id,omitempty"`
+	PromoId   string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	CompanyId string                 `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// награда пригласившему по правилам компании, отсутствует если компания ничего не начисляет
+	Reward        *ReferrerReward `protobuf:"bytes,4,opt,name=reward,proto3,oneof" json:"reward,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertReferralRequest) Reset() {
+	*x = ConvertReferralRequest{}
+	mi := &file_api_protos_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertReferralRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertReferralRequest) ProtoMessage() {}
+
+func (x *ConvertReferralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertReferralRequest.ProtoReflect.Descriptor instead.
+func (*ConvertReferralRequest) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConvertReferralRequest) GetRefereeId() string {
+	if x != nil {
+		return x.RefereeId
+	}
+	return ""
+}
+
+func (x *ConvertReferralRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *ConvertReferralRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ConvertReferralRequest) GetReward() *ReferrerReward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+type ConvertReferralResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// пользователь был приглашён
+	Referred   bool   `protobuf:"varint,1,opt,name=referred,proto3" json:"referred,omitempty"`
+	ReferrerId string `protobuf:"bytes,2,opt,name=referrer_id,json=referrerId,proto3" json:"referrer_id,omitempty"`
+	// награда начислена этой активацией
+	Credited      bool `protobuf:"varint,3,opt,name=credited,proto3" json:"credited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertReferralResponse) Reset() {
+	*x = ConvertReferralResponse{}
+	mi := &file_api_protos_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertReferralResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertReferralResponse) ProtoMessage() {}
+
+func (x *ConvertReferralResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertReferralResponse.ProtoReflect.Descriptor instead.
+func (*ConvertReferralResponse) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConvertReferralResponse) GetReferred() bool {
+	if x != nil {
+		return x.Referred
+	}
+	return false
+}
+
+func (x *ConvertReferralResponse) GetReferrerId() string {
+	if x != nil {
+		return x.ReferrerId
+	}
+	return ""
+}
+
+func (x *ConvertReferralResponse) GetCredited() bool {
+	if x != nil {
+		return x.Credited
+	}
+	return false
+}
+
+type GetReferralStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralStatsRequest) Reset() {
+	*x = GetReferralStatsRequest{}
+	mi := &file_api_protos_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralStatsRequest) ProtoMessage() {}
+
+func (x *GetReferralStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetReferralStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReferralStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferralCode  string                 `protobuf:"bytes,1,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	Invited       int64                  `protobuf:"varint,2,opt,name=invited,proto3" json:"invited,omitempty"`
+	Converted     int64                  `protobuf:"varint,3,opt,name=converted,proto3" json:"converted,omitempty"`
+	Rewards       []*ReferrerReward      `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralStatsResponse) Reset() {
+	*x = GetReferralStatsResponse{}
+	mi := &file_api_protos_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralStatsResponse) ProtoMessage() {}
+
+func (x *GetReferralStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protos_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_protos_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetReferralStatsResponse) GetReferralCode() string {
+	if x != nil {
+		return x.ReferralCode
+	}
+	return ""
+}
+
+func (x *GetReferralStatsResponse) GetInvited() int64 {
+	if x != nil {
+		return x.Invited
+	}
+	return 0
+}
+
+func (x *GetReferralStatsResponse) GetConverted() int64 {
+	if x != nil {
+		return x.Converted
+	}
+	return 0
+}
+
+func (x *GetReferralStatsResponse) GetRewards() []*ReferrerReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+var File_api_protos_account_proto protoreflect.FileDescriptor
+
+const file_api_protos_account_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/protos/account.proto\x12\x03api\x1a google/protobuf/field_mask.proto\"\r\n" +
+	"\vPingRequest\"0\n" +
+	"\fPingResponse\x12 \n" +
+	"\vpingMessage\x18\x01 \x01(\tR\vpingMessage\"\xb6\x01\n" +
+	"\x16GetUserProfileResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x02 \x01(\tR\asurname\x12\x1d\n" +
 	"\n" +
 	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x10\n" +
 	"\x03age\x18\x04 \x01(\x05R\x03age\x12\x18\n" +
-	"\acountry\x18\x05 \x01(\tR\acountry\"+\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12#\n" +
+	"\rreferral_code\x18\x06 \x01(\tR\freferralCode\"+\n" +
 	"\x15GetUserProfileRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"?\n" +
 	"\x15CreateBuisnessRequest\x12\x12\n" +
@@ -739,9 +1569,50 @@ const file_api_protos_account_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"\x18\n" +
 	"\x16CreateBuisnessResponse\"$\n" +
 	"\x12GetBuisnessRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
 	"\x13GetBuisnessResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\x9c\x01\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\aprofile\x18\x02 \x01(\v2\x14.api.BusinessProfileR\aprofile\"\xeb\x01\n" +
+	"\x0fBusinessProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\blogo_url\x18\x04 \x01(\tR\alogoUrl\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\x12#\n" +
+	"\rcontact_email\x18\x06 \x01(\tR\fcontactEmail\x12\x1e\n" +
+	"\n" +
+	"categories\x18\a \x03(\tR\n" +
+	"categories\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\"\xf1\x01\n" +
+	"\x15UpdateBusinessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\blogo_url\x18\x04 \x01(\tR\alogoUrl\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\x12#\n" +
+	"\rcontact_email\x18\x06 \x01(\tR\fcontactEmail\x12\x1e\n" +
+	"\n" +
+	"categories\x18\a \x03(\tR\n" +
+	"categories\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\"H\n" +
+	"\x16UpdateBusinessResponse\x12.\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.api.BusinessProfileR\aprofile\"'\n" +
+	"\x15DeleteBusinessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteBusinessResponse\"\x9e\x01\n" +
+	"\x15ListBusinessesRequest\x12\x1d\n" +
+	"\acountry\x18\x01 \x01(\tH\x00R\acountry\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x02 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offsetB\n" +
+	"\n" +
+	"\b_countryB\v\n" +
+	"\t_category\"d\n" +
+	"\x16ListBusinessesResponse\x124\n" +
+	"\n" +
+	"businesses\x18\x01 \x03(\v2\x14.api.BusinessProfileR\n" +
+	"businesses\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xc1\x01\n" +
 	"\x11CreateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -749,8 +1620,9 @@ const file_api_protos_account_proto_rawDesc = "" +
 	"\n" +
 	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x10\n" +
 	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x18\n" +
-	"\acountry\x18\x06 \x01(\tR\acountry\"\x14\n" +
-	"\x12CreateUserResponse\"\xa0\x01\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12#\n" +
+	"\rreferrer_code\x18\a \x01(\tR\freferrerCode\"\x14\n" +
+	"\x12CreateUserResponse\"\xdd\x01\n" +
 	"\x11UpdateUserRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -758,11 +1630,43 @@ const file_api_protos_account_proto_rawDesc = "" +
 	"\n" +
 	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x10\n" +
 	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x18\n" +
-	"\acountry\x18\x06 \x01(\tR\acountry\"\x14\n" +
-	"\x12UpdateUserResponse\"#\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xb2\x01\n" +
+	"\x12UpdateUserResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\asurname\x18\x02 \x01(\tR\asurname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x10\n" +
+	"\x03age\x18\x04 \x01(\x05R\x03age\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12#\n" +
+	"\rreferral_code\x18\x06 \x01(\tR\freferralCode\"#\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteUserResponse2\xdf\x03\n" +
+	"\x12DeleteUserResponse\"D\n" +
+	"\x0eReferrerReward\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xae\x01\n" +
+	"\x16ConvertReferralRequest\x12\x1d\n" +
+	"\n" +
+	"referee_id\x18\x01 \x01(\tR\trefereeId\x12\x19\n" +
+	"\bpromo_id\x18\x02 \x01(\tR\apromoId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x03 \x01(\tR\tcompanyId\x120\n" +
+	"\x06reward\x18\x04 \x01(\v2\x13.api.ReferrerRewardH\x00R\x06reward\x88\x01\x01B\t\n" +
+	"\a_reward\"r\n" +
+	"\x17ConvertReferralResponse\x12\x1a\n" +
+	"\breferred\x18\x01 \x01(\bR\breferred\x12\x1f\n" +
+	"\vreferrer_id\x18\x02 \x01(\tR\n" +
+	"referrerId\x12\x1a\n" +
+	"\bcredited\x18\x03 \x01(\bR\bcredited\"2\n" +
+	"\x17GetReferralStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xa6\x01\n" +
+	"\x18GetReferralStatsResponse\x12#\n" +
+	"\rreferral_code\x18\x01 \x01(\tR\freferralCode\x12\x18\n" +
+	"\ainvited\x18\x02 \x01(\x03R\ainvited\x12\x1c\n" +
+	"\tconverted\x18\x03 \x01(\x03R\tconverted\x12-\n" +
+	"\arewards\x18\x04 \x03(\v2\x13.api.ReferrerRewardR\arewards2\xe9\x06\n" +
 	"\x0fAccount_Service\x12+\n" +
 	"\x04Ping\x12\x10.api.PingRequest\x1a\x11.api.PingResponse\x12K\n" +
 	"\x0eGetUserProfile\x12\x1a.api.GetUserProfileRequest\x1a\x1b.api.GetUserProfileResponse\"\x00\x12?\n" +
@@ -773,7 +1677,12 @@ const file_api_protos_account_proto_rawDesc = "" +
 	"\n" +
 	"DeleteUser\x12\x16.api.DeleteUserRequest\x1a\x17.api.DeleteUserResponse\"\x00\x12K\n" +
 	"\x0eCreateBuisness\x12\x1a.api.CreateBuisnessRequest\x1a\x1b.api.CreateBuisnessResponse\"\x00\x12B\n" +
-	"\vGetBuisness\x12\x17.api.GetBuisnessRequest\x1a\x18.api.GetBuisnessResponse\"\x00B\x19Z\x17pkg/api/account_serviceb\x06proto3"
+	"\vGetBuisness\x12\x17.api.GetBuisnessRequest\x1a\x18.api.GetBuisnessResponse\"\x00\x12K\n" +
+	"\x0eUpdateBusiness\x12\x1a.api.UpdateBusinessRequest\x1a\x1b.api.UpdateBusinessResponse\"\x00\x12K\n" +
+	"\x0eDeleteBusiness\x12\x1a.api.DeleteBusinessRequest\x1a\x1b.api.DeleteBusinessResponse\"\x00\x12K\n" +
+	"\x0eListBusinesses\x12\x1a.api.ListBusinessesRequest\x1a\x1b.api.ListBusinessesResponse\"\x00\x12N\n" +
+	"\x0fConvertReferral\x12\x1b.api.ConvertReferralRequest\x1a\x1c.api.ConvertReferralResponse\"\x00\x12Q\n" +
+	"\x10GetReferralStats\x12\x1c.api.GetReferralStatsRequest\x1a\x1d.api.GetReferralStatsResponse\"\x00B\x19Z\x17pkg/api/account_serviceb\x06proto3"
 
 var (
 	file_api_protos_account_proto_rawDescOnce sync.Once
@@ -787,43 +1696,72 @@ func file_api_protos_account_proto_rawDescGZIP() []byte {
 	return file_api_protos_account_proto_rawDescData
 }
 
-var file_api_protos_account_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_protos_account_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_api_protos_account_proto_goTypes = []any{
-	(*PingRequest)(nil),            // 0: api.PingRequest
-	(*PingResponse)(nil),           // 1: api.PingResponse
-	(*GetUserProfileResponse)(nil), // 2: api.GetUserProfileResponse
-	(*GetUserProfileRequest)(nil),  // 3: api.GetUserProfileRequest
-	(*CreateBuisnessRequest)(nil),  // 4: api.CreateBuisnessRequest
-	(*CreateBuisnessResponse)(nil), // 5: api.CreateBuisnessResponse
-	(*GetBuisnessRequest)(nil),     // 6: api.GetBuisnessRequest
-	(*GetBuisnessResponse)(nil),    // 7: api.GetBuisnessResponse
-	(*CreateUserRequest)(nil),      // 8: api.CreateUserRequest
-	(*CreateUserResponse)(nil),     // 9: api.CreateUserResponse
-	(*UpdateUserRequest)(nil),      // 10: api.UpdateUserRequest
-	(*UpdateUserResponse)(nil),     // 11: api.UpdateUserResponse
-	(*DeleteUserRequest)(nil),      // 12: api.DeleteUserRequest
-	(*DeleteUserResponse)(nil),     // 13: api.DeleteUserResponse
+	(*PingRequest)(nil),              // 0: api.PingRequest
+	(*PingResponse)(nil),             // 1: api.PingResponse
+	(*GetUserProfileResponse)(nil),   // 2: api.GetUserProfileResponse
+	(*GetUserProfileRequest)(nil),    // 3: api.GetUserProfileRequest
+	(*CreateBuisnessRequest)(nil),    // 4: api.CreateBuisnessRequest
+	(*CreateBuisnessResponse)(nil),   // 5: api.CreateBuisnessResponse
+	(*GetBuisnessRequest)(nil),       // 6: api.GetBuisnessRequest
+	(*GetBuisnessResponse)(nil),      // 7: api.GetBuisnessResponse
+	(*BusinessProfile)(nil),          // 8: api.BusinessProfile
+	(*UpdateBusinessRequest)(nil),    // 9: api.UpdateBusinessRequest
+	(*UpdateBusinessResponse)(nil),   // 10: api.UpdateBusinessResponse
+	(*DeleteBusinessRequest)(nil),    // 11: api.DeleteBusinessRequest
+	(*DeleteBusinessResponse)(nil),   // 12: api.DeleteBusinessResponse
+	(*ListBusinessesRequest)(nil),    // 13: api.ListBusinessesRequest
+	(*ListBusinessesResponse)(nil),   // 14: api.ListBusinessesResponse
+	(*CreateUserRequest)(nil),        // 15: api.CreateUserRequest
+	(*CreateUserResponse)(nil),       // 16: api.CreateUserResponse
+	(*UpdateUserRequest)(nil),        // 17: api.UpdateUserRequest
+	(*UpdateUserResponse)(nil),       // 18: api.UpdateUserResponse
+	(*DeleteUserRequest)(nil),        // 19: api.DeleteUserRequest
+	(*DeleteUserResponse)(nil),       // 20: api.DeleteUserResponse
+	(*ReferrerReward)(nil),           // 21: api.ReferrerReward
+	(*ConvertReferralRequest)(nil),   // 22: api.ConvertReferralRequest
+	(*ConvertReferralResponse)(nil),  // 23: api.ConvertReferralResponse
+	(*GetReferralStatsRequest)(nil),  // 24: api.GetReferralStatsRequest
+	(*GetReferralStatsResponse)(nil), // 25: api.GetReferralStatsResponse
+	(*fieldmaskpb.FieldMask)(nil),    // 26: google.protobuf.FieldMask
 }
 var file_api_protos_account_proto_depIdxs = []int32{
-	0,  // 0: api.Account_Service.Ping:input_type -> api.PingRequest
-	3,  // 1: api.Account_Service.GetUserProfile:input_type -> api.GetUserProfileRequest
-	8,  // 2: api.Account_Service.CreateUser:input_type -> api.CreateUserRequest
-	10, // 3: api.Account_Service.UpdateUser:input_type -> api.UpdateUserRequest
-	12, // 4: api.Account_Service.DeleteUser:input_type -> api.DeleteUserRequest
-	4,  // 5: api.Account_Service.CreateBuisness:input_type -> api.CreateBuisnessRequest
-	6,  // 6: api.Account_Service.GetBuisness:input_type -> api.GetBuisnessRequest
-	1,  // 7: api.Account_Service.Ping:output_type -> api.PingResponse
-	2,  // 8: api.Account_Service.GetUserProfile:output_type -> api.GetUserProfileResponse
-	9,  // 9: api.Account_Service.CreateUser:output_type -> api.CreateUserResponse
-	11, // 10: api.Account_Service.UpdateUser:output_type -> api.UpdateUserResponse
-	13, // 11: api.Account_Service.DeleteUser:output_type -> api.DeleteUserResponse
-	5,  // 12: api.Account_Service.CreateBuisness:output_type -> api.CreateBuisnessResponse
-	7,  // 13: api.Account_Service.GetBuisness:output_type -> api.GetBuisnessResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	8,  // 0: api.GetBuisnessResponse.profile:type_name -> api.BusinessProfile
+	8,  // 1: api.UpdateBusinessResponse.profile:type_name -> api.BusinessProfile
+	8,  // 2: api.ListBusinessesResponse.businesses:type_name -> api.BusinessProfile
+	26, // 3: api.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	21, // 4: api.ConvertReferralRequest.reward:type_name -> api.ReferrerReward
+	21, // 5: api.GetReferralStatsResponse.rewards:type_name -> api.ReferrerReward
+	0,  // 6: api.Account_Service.Ping:input_type -> api.PingRequest
+	3,  // 7: api.Account_Service.GetUserProfile:input_type -> api.GetUserProfileRequest
+	15, // 8: api.Account_Service.CreateUser:input_type -> api.CreateUserRequest
+	17, // 9: api.Account_Service.UpdateUser:input_type -> api.UpdateUserRequest
+	19, // 10: api.Account_Service.DeleteUser:input_type -> api.DeleteUserRequest
+	4,  // 11: api.Account_Service.CreateBuisness:input_type -> api.CreateBuisnessRequest
+	6,  // 12: api.Account_Service.GetBuisness:input_type -> api.GetBuisnessRequest
+	9,  // 13: api.Account_Service.UpdateBusiness:input_type -> api.UpdateBusinessRequest
+	11, // 14: api.Account_Service.DeleteBusiness:input_type -> api.DeleteBusinessRequest
+	13, // 15: api.Account_Service.ListBusinesses:input_type -> api.ListBusinessesRequest
+	22, // 16: api.Account_Service.ConvertReferral:input_type -> api.ConvertReferralRequest
+	24, // 17: api.Account_Service.GetReferralStats:input_type -> api.GetReferralStatsRequest
+	1,  // 18: api.Account_Service.Ping:output_type -> api.PingResponse
+	2,  // 19: api.Account_Service.GetUserProfile:output_type -> api.GetUserProfileResponse
+	16, // 20: api.Account_Service.CreateUser:output_type -> api.CreateUserResponse
+	18, // 21: api.Account_Service.UpdateUser:output_type -> api.UpdateUserResponse
+	20, // 22: api.Account_Service.DeleteUser:output_type -> api.DeleteUserResponse
+	5,  // 23: api.Account_Service.CreateBuisness:output_type -> api.CreateBuisnessResponse
+	7,  // 24: api.Account_Service.GetBuisness:output_type -> api.GetBuisnessResponse
+	10, // 25: api.Account_Service.UpdateBusiness:output_type -> api.UpdateBusinessResponse
+	12, // 26: api.Account_Service.DeleteBusiness:output_type -> api.DeleteBusinessResponse
+	14, // 27: api.Account_Service.ListBusinesses:output_type -> api.ListBusinessesResponse
+	23, // 28: api.Account_Service.ConvertReferral:output_type -> api.ConvertReferralResponse
+	25, // 29: api.Account_Service.GetReferralStats:output_type -> api.GetReferralStatsResponse
+	18, // [18:30] is the sub-list for method output_type
+	6,  // [6:18] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_protos_account_proto_init() }
@@ -831,13 +1769,15 @@ func file_api_protos_account_proto_init() {
 	if File_api_protos_account_proto != nil {
 		return
 	}
+	file_api_protos_account_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_protos_account_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_protos_account_proto_rawDesc), len(file_api_protos_account_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
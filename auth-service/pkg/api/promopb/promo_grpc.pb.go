@@ -19,13 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PromoService_CreatePromo_FullMethodName   = "/api.PromoService/CreatePromo"
-	PromoService_ListPromo_FullMethodName     = "/api.PromoService/ListPromo"
-	PromoService_GetPromo_FullMethodName      = "/api.PromoService/GetPromo"
-	PromoService_UpdatePromo_FullMethodName   = "/api.PromoService/UpdatePromo"
-	PromoService_DeletePromo_FullMethodName   = "/api.PromoService/DeletePromo"
-	PromoService_ActivatePromo_FullMethodName = "/api.PromoService/ActivatePromo"
-	PromoService_PromoPing_FullMethodName     = "/api.PromoService/PromoPing"
+	PromoService_CreatePromo_FullMethodName             = "/api.PromoService/CreatePromo"
+	PromoService_ListPromo_FullMethodName               = "/api.PromoService/ListPromo"
+	PromoService_GetPromo_FullMethodName                = "/api.PromoService/GetPromo"
+	PromoService_UpdatePromo_FullMethodName             = "/api.PromoService/UpdatePromo"
+	PromoService_DeletePromo_FullMethodName             = "/api.PromoService/DeletePromo"
+	PromoService_ActivatePromo_FullMethodName           = "/api.PromoService/ActivatePromo"
+	PromoService_RenderPromoCode_FullMethodName         = "/api.PromoService/RenderPromoCode"
+	PromoService_ExportPromoData_FullMethodName         = "/api.PromoService/ExportPromoData"
+	PromoService_ListPromoFeed_FullMethodName           = "/api.PromoService/ListPromoFeed"
+	PromoService_PublishPromo_FullMethodName            = "/api.PromoService/PublishPromo"
+	PromoService_PausePromo_FullMethodName              = "/api.PromoService/PausePromo"
+	PromoService_ResumePromo_FullMethodName             = "/api.PromoService/ResumePromo"
+	PromoService_ArchivePromo_FullMethodName            = "/api.PromoService/ArchivePromo"
+	PromoService_RestorePromo_FullMethodName            = "/api.PromoService/RestorePromo"
+	PromoService_SetPromoImage_FullMethodName           = "/api.PromoService/SetPromoImage"
+	PromoService_ListPromoAuditLog_FullMethodName       = "/api.PromoService/ListPromoAuditLog"
+	PromoService_QuoteDiscount_FullMethodName           = "/api.PromoService/QuoteDiscount"
+	PromoService_ResolveApplicablePromos_FullMethodName = "/api.PromoService/ResolveApplicablePromos"
+	PromoService_CreateWebhookEndpoint_FullMethodName   = "/api.PromoService/CreateWebhookEndpoint"
+	PromoService_ListWebhookEndpoints_FullMethodName    = "/api.PromoService/ListWebhookEndpoints"
+	PromoService_DeleteWebhookEndpoint_FullMethodName   = "/api.PromoService/DeleteWebhookEndpoint"
+	PromoService_ListWebhookDeliveries_FullMethodName   = "/api.PromoService/ListWebhookDeliveries"
+	PromoService_ForgetUser_FullMethodName              = "/api.PromoService/ForgetUser"
+	PromoService_ExportUserData_FullMethodName          = "/api.PromoService/ExportUserData"
+	PromoService_PromoPing_FullMethodName               = "/api.PromoService/PromoPing"
 )
 
 // PromoServiceClient is the client API for PromoService service.
@@ -38,6 +56,24 @@ type PromoServiceClient interface {
 	UpdatePromo(ctx context.Context, in *UpdatePromoRequest, opts ...grpc.CallOption) (*UpdatePromoResponse, error)
 	DeletePromo(ctx context.Context, in *DeletePromoRequest, opts ...grpc.CallOption) (*DeletePromoResponse, error)
 	ActivatePromo(ctx context.Context, in *ActivatePromoRequest, opts ...grpc.CallOption) (*ActivatePromoResponse, error)
+	RenderPromoCode(ctx context.Context, in *RenderPromoCodeRequest, opts ...grpc.CallOption) (*RenderPromoCodeResponse, error)
+	ExportPromoData(ctx context.Context, in *ExportPromoDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPromoDataChunk], error)
+	ListPromoFeed(ctx context.Context, in *ListPromoFeedRequest, opts ...grpc.CallOption) (*ListPromoResponse, error)
+	PublishPromo(ctx context.Context, in *PublishPromoRequest, opts ...grpc.CallOption) (*PublishPromoResponse, error)
+	PausePromo(ctx context.Context, in *PausePromoRequest, opts ...grpc.CallOption) (*PausePromoResponse, error)
+	ResumePromo(ctx context.Context, in *ResumePromoRequest, opts ...grpc.CallOption) (*ResumePromoResponse, error)
+	ArchivePromo(ctx context.Context, in *ArchivePromoRequest, opts ...grpc.CallOption) (*ArchivePromoResponse, error)
+	RestorePromo(ctx context.Context, in *RestorePromoRequest, opts ...grpc.CallOption) (*RestorePromoResponse, error)
+	SetPromoImage(ctx context.Context, in *SetPromoImageRequest, opts ...grpc.CallOption) (*SetPromoImageResponse, error)
+	ListPromoAuditLog(ctx context.Context, in *ListPromoAuditLogRequest, opts ...grpc.CallOption) (*ListPromoAuditLogResponse, error)
+	QuoteDiscount(ctx context.Context, in *QuoteDiscountRequest, opts ...grpc.CallOption) (*QuoteDiscountResponse, error)
+	ResolveApplicablePromos(ctx context.Context, in *ResolveApplicablePromosRequest, opts ...grpc.CallOption) (*ResolveApplicablePromosResponse, error)
+	CreateWebhookEndpoint(ctx context.Context, in *CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*CreateWebhookEndpointResponse, error)
+	ListWebhookEndpoints(ctx context.Context, in *ListWebhookEndpointsRequest, opts ...grpc.CallOption) (*ListWebhookEndpointsResponse, error)
+	DeleteWebhookEndpoint(ctx context.Context, in *DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*DeleteWebhookEndpointResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*ForgetUserResponse, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
 	PromoPing(ctx context.Context, in *PromoPingRequest, opts ...grpc.CallOption) (*PromoPingResponse, error)
 }
 
@@ -109,6 +145,195 @@ func (c *promoServiceClient) ActivatePromo(ctx context.Context, in *ActivateProm
 	return out, nil
 }
 
+func (c *promoServiceClient) RenderPromoCode(ctx context.Context, in *RenderPromoCodeRequest, opts ...grpc.CallOption) (*RenderPromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderPromoCodeResponse)
+	err := c.cc.Invoke(ctx, PromoService_RenderPromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ExportPromoData(ctx context.Context, in *ExportPromoDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPromoDataChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PromoService_ServiceDesc.Streams[0], PromoService_ExportPromoData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPromoDataRequest, ExportPromoDataChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PromoService_ExportPromoDataClient = grpc.ServerStreamingClient[ExportPromoDataChunk]
+
+func (c *promoServiceClient) ListPromoFeed(ctx context.Context, in *ListPromoFeedRequest, opts ...grpc.CallOption) (*ListPromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromoResponse)
+	err := c.cc.Invoke(ctx, PromoService_ListPromoFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) PublishPromo(ctx context.Context, in *PublishPromoRequest, opts ...grpc.CallOption) (*PublishPromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishPromoResponse)
+	err := c.cc.Invoke(ctx, PromoService_PublishPromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) PausePromo(ctx context.Context, in *PausePromoRequest, opts ...grpc.CallOption) (*PausePromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PausePromoResponse)
+	err := c.cc.Invoke(ctx, PromoService_PausePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ResumePromo(ctx context.Context, in *ResumePromoRequest, opts ...grpc.CallOption) (*ResumePromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumePromoResponse)
+	err := c.cc.Invoke(ctx, PromoService_ResumePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ArchivePromo(ctx context.Context, in *ArchivePromoRequest, opts ...grpc.CallOption) (*ArchivePromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePromoResponse)
+	err := c.cc.Invoke(ctx, PromoService_ArchivePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) RestorePromo(ctx context.Context, in *RestorePromoRequest, opts ...grpc.CallOption) (*RestorePromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePromoResponse)
+	err := c.cc.Invoke(ctx, PromoService_RestorePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) SetPromoImage(ctx context.Context, in *SetPromoImageRequest, opts ...grpc.CallOption) (*SetPromoImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPromoImageResponse)
+	err := c.cc.Invoke(ctx, PromoService_SetPromoImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ListPromoAuditLog(ctx context.Context, in *ListPromoAuditLogRequest, opts ...grpc.CallOption) (*ListPromoAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromoAuditLogResponse)
+	err := c.cc.Invoke(ctx, PromoService_ListPromoAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) QuoteDiscount(ctx context.Context, in *QuoteDiscountRequest, opts ...grpc.CallOption) (*QuoteDiscountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteDiscountResponse)
+	err := c.cc.Invoke(ctx, PromoService_QuoteDiscount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ResolveApplicablePromos(ctx context.Context, in *ResolveApplicablePromosRequest, opts ...grpc.CallOption) (*ResolveApplicablePromosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveApplicablePromosResponse)
+	err := c.cc.Invoke(ctx, PromoService_ResolveApplicablePromos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) CreateWebhookEndpoint(ctx context.Context, in *CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*CreateWebhookEndpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookEndpointResponse)
+	err := c.cc.Invoke(ctx, PromoService_CreateWebhookEndpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ListWebhookEndpoints(ctx context.Context, in *ListWebhookEndpointsRequest, opts ...grpc.CallOption) (*ListWebhookEndpointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookEndpointsResponse)
+	err := c.cc.Invoke(ctx, PromoService_ListWebhookEndpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) DeleteWebhookEndpoint(ctx context.Context, in *DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*DeleteWebhookEndpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookEndpointResponse)
+	err := c.cc.Invoke(ctx, PromoService_DeleteWebhookEndpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, PromoService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*ForgetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForgetUserResponse)
+	err := c.cc.Invoke(ctx, PromoService_ForgetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, PromoService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *promoServiceClient) PromoPing(ctx context.Context, in *PromoPingRequest, opts ...grpc.CallOption) (*PromoPingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PromoPingResponse)
@@ -129,6 +354,24 @@ type PromoServiceServer interface {
 	UpdatePromo(context.Context, *UpdatePromoRequest) (*UpdatePromoResponse, error)
 	DeletePromo(context.Context, *DeletePromoRequest) (*DeletePromoResponse, error)
 	ActivatePromo(context.Context, *ActivatePromoRequest) (*ActivatePromoResponse, error)
+	RenderPromoCode(context.Context, *RenderPromoCodeRequest) (*RenderPromoCodeResponse, error)
+	ExportPromoData(*ExportPromoDataRequest, grpc.ServerStreamingServer[ExportPromoDataChunk]) error
+	ListPromoFeed(context.Context, *ListPromoFeedRequest) (*ListPromoResponse, error)
+	PublishPromo(context.Context, *PublishPromoRequest) (*PublishPromoResponse, error)
+	PausePromo(context.Context, *PausePromoRequest) (*PausePromoResponse, error)
+	ResumePromo(context.Context, *ResumePromoRequest) (*ResumePromoResponse, error)
+	ArchivePromo(context.Context, *ArchivePromoRequest) (*ArchivePromoResponse, error)
+	RestorePromo(context.Context, *RestorePromoRequest) (*RestorePromoResponse, error)
+	SetPromoImage(context.Context, *SetPromoImageRequest) (*SetPromoImageResponse, error)
+	ListPromoAuditLog(context.Context, *ListPromoAuditLogRequest) (*ListPromoAuditLogResponse, error)
+	QuoteDiscount(context.Context, *QuoteDiscountRequest) (*QuoteDiscountResponse, error)
+	ResolveApplicablePromos(context.Context, *ResolveApplicablePromosRequest) (*ResolveApplicablePromosResponse, error)
+	CreateWebhookEndpoint(context.Context, *CreateWebhookEndpointRequest) (*CreateWebhookEndpointResponse, error)
+	ListWebhookEndpoints(context.Context, *ListWebhookEndpointsRequest) (*ListWebhookEndpointsResponse, error)
+	DeleteWebhookEndpoint(context.Context, *DeleteWebhookEndpointRequest) (*DeleteWebhookEndpointResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	ForgetUser(context.Context, *ForgetUserRequest) (*ForgetUserResponse, error)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
 	PromoPing(context.Context, *PromoPingRequest) (*PromoPingResponse, error)
 	mustEmbedUnimplementedPromoServiceServer()
 }
@@ -158,6 +401,60 @@ func (UnimplementedPromoServiceServer) DeletePromo(context.Context, *DeletePromo
 func (UnimplementedPromoServiceServer) ActivatePromo(context.Context, *ActivatePromoRequest) (*ActivatePromoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivatePromo not implemented")
 }
+func (UnimplementedPromoServiceServer) RenderPromoCode(context.Context, *RenderPromoCodeRequest) (*RenderPromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderPromoCode not implemented")
+}
+func (UnimplementedPromoServiceServer) ExportPromoData(*ExportPromoDataRequest, grpc.ServerStreamingServer[ExportPromoDataChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPromoData not implemented")
+}
+func (UnimplementedPromoServiceServer) ListPromoFeed(context.Context, *ListPromoFeedRequest) (*ListPromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoFeed not implemented")
+}
+func (UnimplementedPromoServiceServer) PublishPromo(context.Context, *PublishPromoRequest) (*PublishPromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPromo not implemented")
+}
+func (UnimplementedPromoServiceServer) PausePromo(context.Context, *PausePromoRequest) (*PausePromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePromo not implemented")
+}
+func (UnimplementedPromoServiceServer) ResumePromo(context.Context, *ResumePromoRequest) (*ResumePromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePromo not implemented")
+}
+func (UnimplementedPromoServiceServer) ArchivePromo(context.Context, *ArchivePromoRequest) (*ArchivePromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePromo not implemented")
+}
+func (UnimplementedPromoServiceServer) RestorePromo(context.Context, *RestorePromoRequest) (*RestorePromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePromo not implemented")
+}
+func (UnimplementedPromoServiceServer) SetPromoImage(context.Context, *SetPromoImageRequest) (*SetPromoImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPromoImage not implemented")
+}
+func (UnimplementedPromoServiceServer) ListPromoAuditLog(context.Context, *ListPromoAuditLogRequest) (*ListPromoAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoAuditLog not implemented")
+}
+func (UnimplementedPromoServiceServer) QuoteDiscount(context.Context, *QuoteDiscountRequest) (*QuoteDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteDiscount not implemented")
+}
+func (UnimplementedPromoServiceServer) ResolveApplicablePromos(context.Context, *ResolveApplicablePromosRequest) (*ResolveApplicablePromosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveApplicablePromos not implemented")
+}
+func (UnimplementedPromoServiceServer) CreateWebhookEndpoint(context.Context, *CreateWebhookEndpointRequest) (*CreateWebhookEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookEndpoint not implemented")
+}
+func (UnimplementedPromoServiceServer) ListWebhookEndpoints(context.Context, *ListWebhookEndpointsRequest) (*ListWebhookEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookEndpoints not implemented")
+}
+func (UnimplementedPromoServiceServer) DeleteWebhookEndpoint(context.Context, *DeleteWebhookEndpointRequest) (*DeleteWebhookEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookEndpoint not implemented")
+}
+func (UnimplementedPromoServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedPromoServiceServer) ForgetUser(context.Context, *ForgetUserRequest) (*ForgetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetUser not implemented")
+}
+func (UnimplementedPromoServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedPromoServiceServer) PromoPing(context.Context, *PromoPingRequest) (*PromoPingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PromoPing not implemented")
 }
@@ -290,6 +587,323 @@ func _PromoService_ActivatePromo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PromoService_RenderPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).RenderPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_RenderPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).RenderPromoCode(ctx, req.(*RenderPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ExportPromoData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPromoDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PromoServiceServer).ExportPromoData(m, &grpc.GenericServerStream[ExportPromoDataRequest, ExportPromoDataChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PromoService_ExportPromoDataServer = grpc.ServerStreamingServer[ExportPromoDataChunk]
+
+func _PromoService_ListPromoFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ListPromoFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ListPromoFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ListPromoFeed(ctx, req.(*ListPromoFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_PublishPromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).PublishPromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_PublishPromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).PublishPromo(ctx, req.(*PublishPromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_PausePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).PausePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_PausePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).PausePromo(ctx, req.(*PausePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ResumePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ResumePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ResumePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ResumePromo(ctx, req.(*ResumePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ArchivePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ArchivePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ArchivePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ArchivePromo(ctx, req.(*ArchivePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_RestorePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).RestorePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_RestorePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).RestorePromo(ctx, req.(*RestorePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_SetPromoImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPromoImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).SetPromoImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_SetPromoImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).SetPromoImage(ctx, req.(*SetPromoImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ListPromoAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ListPromoAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ListPromoAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ListPromoAuditLog(ctx, req.(*ListPromoAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_QuoteDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).QuoteDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_QuoteDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).QuoteDiscount(ctx, req.(*QuoteDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ResolveApplicablePromos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveApplicablePromosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ResolveApplicablePromos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ResolveApplicablePromos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ResolveApplicablePromos(ctx, req.(*ResolveApplicablePromosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_CreateWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).CreateWebhookEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_CreateWebhookEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).CreateWebhookEndpoint(ctx, req.(*CreateWebhookEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ListWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ListWebhookEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ListWebhookEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ListWebhookEndpoints(ctx, req.(*ListWebhookEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_DeleteWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).DeleteWebhookEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_DeleteWebhookEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).DeleteWebhookEndpoint(ctx, req.(*DeleteWebhookEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ForgetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ForgetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ForgetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ForgetUser(ctx, req.(*ForgetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PromoService_PromoPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PromoPingRequest)
 	if err := dec(in); err != nil {
@@ -339,11 +953,85 @@ var PromoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ActivatePromo",
 			Handler:    _PromoService_ActivatePromo_Handler,
 		},
+		{
+			MethodName: "RenderPromoCode",
+			Handler:    _PromoService_RenderPromoCode_Handler,
+		},
+		{
+			MethodName: "ListPromoFeed",
+			Handler:    _PromoService_ListPromoFeed_Handler,
+		},
+		{
+			MethodName: "PublishPromo",
+			Handler:    _PromoService_PublishPromo_Handler,
+		},
+		{
+			MethodName: "PausePromo",
+			Handler:    _PromoService_PausePromo_Handler,
+		},
+		{
+			MethodName: "ResumePromo",
+			Handler:    _PromoService_ResumePromo_Handler,
+		},
+		{
+			MethodName: "ArchivePromo",
+			Handler:    _PromoService_ArchivePromo_Handler,
+		},
+		{
+			MethodName: "RestorePromo",
+			Handler:    _PromoService_RestorePromo_Handler,
+		},
+		{
+			MethodName: "SetPromoImage",
+			Handler:    _PromoService_SetPromoImage_Handler,
+		},
+		{
+			MethodName: "ListPromoAuditLog",
+			Handler:    _PromoService_ListPromoAuditLog_Handler,
+		},
+		{
+			MethodName: "QuoteDiscount",
+			Handler:    _PromoService_QuoteDiscount_Handler,
+		},
+		{
+			MethodName: "ResolveApplicablePromos",
+			Handler:    _PromoService_ResolveApplicablePromos_Handler,
+		},
+		{
+			MethodName: "CreateWebhookEndpoint",
+			Handler:    _PromoService_CreateWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "ListWebhookEndpoints",
+			Handler:    _PromoService_ListWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookEndpoint",
+			Handler:    _PromoService_DeleteWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _PromoService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ForgetUser",
+			Handler:    _PromoService_ForgetUser_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _PromoService_ExportUserData_Handler,
+		},
 		{
 			MethodName: "PromoPing",
 			Handler:    _PromoService_PromoPing_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportPromoData",
+			Handler:       _PromoService_ExportPromoData_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/protos/promo.proto",
 }
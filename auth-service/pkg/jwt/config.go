@@ -15,7 +15,7 @@ const (
 	AccessTokenCookieName       = "access-token"
 )
 
-func (j *ServiceJWT) GetClaims(id string, tokenMode mode) *jwt.RegisteredClaims {
+func (j *ServiceJWT) GetClaims(id string, tokenMode mode) *Claims {
 	var expiration *jwt.NumericDate
 
 	if tokenMode == RefreshTokenMode {
@@ -26,10 +26,13 @@ func (j *ServiceJWT) GetClaims(id string, tokenMode mode) *jwt.RegisteredClaims
 		panic("invalid type")
 	}
 
-	return &jwt.RegisteredClaims{
-		Subject:   id,
-		ExpiresAt: expiration,
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   id,
+			ExpiresAt: expiration,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		Type: tokenMode,
 	}
 }
 
@@ -17,6 +17,13 @@ var (
 	ErrorInvalidToken   = fmt.Errorf(ErrorInvalidTokenString)
 )
 
+// Claims claims токена вместе с его типом: access и refresh токены
+// выпускаются с одинаковым subject и различаются только claim typ
+type Claims struct {
+	jwt.RegisteredClaims
+	Type mode `json:"typ"`
+}
+
 type ServiceJWT struct {
 	privateKey     *rsa.PrivateKey
 	publicKey      *rsa.PublicKey
@@ -34,12 +41,22 @@ func NewServiceJWT(privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey,
 	}
 }
 
-func (j *ServiceJWT) DecodeKey(tokenString string) (*jwt.RegisteredClaims, error) {
+// DecodeAccessKey проверяет access token из заголовка Authorization
+func (j *ServiceJWT) DecodeAccessKey(tokenString string) (*Claims, error) {
+	return j.decodeKey(tokenString, AccessTokenMode)
+}
+
+// DecodeRefreshKey проверяет refresh token из cookie
+func (j *ServiceJWT) DecodeRefreshKey(tokenString string) (*Claims, error) {
+	return j.decodeKey(tokenString, RefreshTokenMode)
+}
+
+func (j *ServiceJWT) decodeKey(tokenString string, tokenMode mode) (*Claims, error) {
 	if tokenString == "" {
 		return nil, ErrorUndefinedToken
 	}
 
-	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{},
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{},
 		func(token *jwt.Token) (interface{}, error) {
 			return j.publicKey, nil
 		})
@@ -47,8 +64,8 @@ func (j *ServiceJWT) DecodeKey(tokenString string) (*jwt.RegisteredClaims, error
 		return nil, err
 	}
 
-	claims, ok := token.Claims.(*jwt.RegisteredClaims)
-	if ok && token.Valid {
+	claims, ok := token.Claims.(*Claims)
+	if ok && token.Valid && claims.Type == tokenMode {
 		return claims, nil
 	}
 
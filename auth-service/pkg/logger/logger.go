@@ -3,9 +3,11 @@ package logger
 import (
 	"context"
 
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/auth-service/internal/dto"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 type Logger struct {
@@ -31,6 +33,7 @@ func (l *Logger) Info(ctx context.Context, msg string, fields ...zap.Field) {
 	if ctx.Value(dto.RequestID) != nil {
 		fields = append(fields, zap.String(string(dto.RequestID), ctx.Value(dto.RequestID).(string)))
 	}
+	fields = append(fields, tracing.LogFields(ctx)...)
 
 	l.L.Info(msg, fields...)
 }
@@ -39,6 +42,17 @@ func (l *Logger) Fatal(ctx context.Context, msg string, fields ...zap.Field) {
 	if ctx.Value(dto.RequestID) != nil {
 		fields = append(fields, zap.String(string(dto.RequestID), ctx.Value(dto.RequestID).(string)))
 	}
+	fields = append(fields, tracing.LogFields(ctx)...)
 
 	l.L.Fatal(msg, fields...)
 }
+
+// Log пишет строку с уровнем, выбранным вызывающим, например политикой логирования запросов
+func (l *Logger) Log(ctx context.Context, level zapcore.Level, msg string, fields ...zap.Field) {
+	if ctx.Value(dto.RequestID) != nil {
+		fields = append(fields, zap.String(string(dto.RequestID), ctx.Value(dto.RequestID).(string)))
+	}
+	fields = append(fields, tracing.LogFields(ctx)...)
+
+	l.L.Log(level, msg, fields...)
+}
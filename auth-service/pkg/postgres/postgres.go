@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/auth-service/internal/config"
 	"gitlab.com/pisya-dev/auth-service/pkg/logger"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -27,7 +30,13 @@ func NewPostgres(ctx context.Context, config *config.Postgres) (*pgxpool.Pool, e
 		config.MinConn,
 	)
 
-	conn, err := pgxpool.New(ctx, connString)
+	poolConfig, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to parse database config: %w", op, err)
+	}
+	poolConfig.ConnConfig.Tracer = multitracer.New(tracing.QueryTracer{}, metrics.QueryTracer{})
+
+	conn, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to database: %w", err)
 	}
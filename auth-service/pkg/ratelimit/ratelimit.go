@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "ratelimit:"
+
+// Limit token bucket: Rate запросов за Period, ёмкость корзины равна Rate
+type Limit struct {
+	Rate   int
+	Period time.Duration
+}
+
+// ParseLimit разбирает лимит в виде запросы/период, например 10/1m
+func ParseLimit(s string) (Limit, error) {
+	rate, period, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid limit %q, expected rate/period", s)
+	}
+
+	r, err := strconv.Atoi(rate)
+	if err != nil || r <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate in limit %q", s)
+	}
+
+	p, err := time.ParseDuration(period)
+	if err != nil || p < time.Millisecond {
+		return Limit{}, fmt.Errorf("invalid period in limit %q", s)
+	}
+
+	return Limit{Rate: r, Period: p}, nil
+}
+
+// ParseRoutes разбирает лимиты маршрутов в виде METHOD /route=запросы/период
+func ParseRoutes(items []string) (map[string]Limit, error) {
+	routes := make(map[string]Limit, len(items))
+	for _, item := range items {
+		route, limit, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid route limit %q, expected METHOD /route=rate/period", item)
+		}
+
+		parsed, err := ParseLimit(limit)
+		if err != nil {
+			return nil, err
+		}
+		routes[strings.TrimSpace(route)] = parsed
+	}
+
+	return routes, nil
+}
+
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter через сколько появится свободный токен, если запрос отклонён
+	RetryAfter time.Duration
+	// Reset через сколько корзина наполнится полностью
+	Reset time.Duration
+}
+
+// bucket пополняет корзину за прошедшее время и забирает из неё токен. Состояние хранится в hash,
+// который истекает, когда корзина снова полна
+var bucket = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = capacity / tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+local reset = math.ceil((capacity - tokens) / rate)
+local retry = 0
+if allowed == 0 then
+	retry = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], reset + 1000)
+
+return {allowed, math.floor(tokens), retry, reset}
+`)
+
+type Limiter struct {
+	client redis.Scripter
+
+	now func() time.Time
+}
+
+func New(client redis.Scripter) *Limiter {
+	return &Limiter{client: client, now: time.Now}
+}
+
+// Allow забирает токен из корзины key. Ошибку redis вызывающий обрабатывает сам: пропускает
+// запрос или отклоняет его
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	values, err := bucket.Run(ctx, l.client, []string{keyPrefix + key},
+		limit.Rate, limit.Period.Milliseconds(), l.now().UnixMilli()).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("run token bucket: %w", err)
+	}
+	if len(values) != 4 {
+		return Result{}, fmt.Errorf("unexpected token bucket reply %v", values)
+	}
+
+	return Result{
+		Allowed:    values[0] == 1,
+		Limit:      limit.Rate,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+		Reset:      time.Duration(values[3]) * time.Millisecond,
+	}, nil
+}
+
+// Rules лимиты маршрутов. Default применяется к маршрутам без своего лимита, нулевой Default
+// означает, что такие маршруты не ограничиваются. FailOpen пропускает запросы, когда redis недоступен
+type Rules struct {
+	Routes   map[string]Limit
+	Default  Limit
+	FailOpen bool
+}
+
+func (r Rules) For(route string) (Limit, bool) {
+	if limit, ok := r.Routes[route]; ok {
+		return limit, true
+	}
+
+	return r.Default, r.Default.Rate > 0
+}
@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(t *testing.T) (*Limiter, *time.Time) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	now := time.Date(2025, 10, 15, 12, 0, 0, 0, time.UTC)
+	l := New(client)
+	l.now = func() time.Time { return now }
+
+	return l, &now
+}
+
+// Тест корзины: токены расходуются, затем восстанавливаются со скоростью Rate/Period
+func TestLimiter_Allow_Refill(t *testing.T) {
+	l, now := newTestLimiter(t)
+	limit := Limit{Rate: 2, Period: time.Second}
+
+	first, err := l.Allow(context.Background(), "k", limit)
+	require.NoError(t, err)
+	assert.True(t, first.Allowed)
+	assert.Equal(t, 2, first.Limit)
+	assert.Equal(t, 1, first.Remaining)
+
+	second, err := l.Allow(context.Background(), "k", limit)
+	require.NoError(t, err)
+	assert.True(t, second.Allowed)
+	assert.Equal(t, 0, second.Remaining)
+	assert.Equal(t, time.Second, second.Reset)
+
+	denied, err := l.Allow(context.Background(), "k", limit)
+	require.NoError(t, err)
+	assert.False(t, denied.Allowed)
+	assert.Equal(t, 500*time.Millisecond, denied.RetryAfter)
+
+	// за половину периода восстанавливается один токен
+	*now = now.Add(500 * time.Millisecond)
+
+	refilled, err := l.Allow(context.Background(), "k", limit)
+	require.NoError(t, err)
+	assert.True(t, refilled.Allowed)
+	assert.Equal(t, 0, refilled.Remaining)
+
+	// корзина не наполняется сверх ёмкости
+	*now = now.Add(time.Hour)
+
+	full, err := l.Allow(context.Background(), "k", limit)
+	require.NoError(t, err)
+	assert.True(t, full.Allowed)
+	assert.Equal(t, 1, full.Remaining)
+}
+
+// Тест независимых корзин для разных ключей
+func TestLimiter_Allow_SeparateKeys(t *testing.T) {
+	l, _ := newTestLimiter(t)
+	limit := Limit{Rate: 1, Period: time.Minute}
+
+	res, err := l.Allow(context.Background(), "ip:1", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	res, err = l.Allow(context.Background(), "ip:1", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	res, err = l.Allow(context.Background(), "ip:2", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+// Тест недоступного redis: ошибка возвращается вызывающему
+func TestLimiter_Allow_RedisDown(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	mr.Close()
+
+	_, err := New(client).Allow(context.Background(), "k", Limit{Rate: 1, Period: time.Minute})
+	assert.Error(t, err)
+}
+
+func TestParseRoutes(t *testing.T) {
+	routes, err := ParseRoutes([]string{"POST /user/auth/sign-in=10/1m", " POST /user/promo/:id/activate = 5/30s"})
+	require.NoError(t, err)
+	assert.Equal(t, Limit{Rate: 10, Period: time.Minute}, routes["POST /user/auth/sign-in"])
+	assert.Equal(t, Limit{Rate: 5, Period: 30 * time.Second}, routes["POST /user/promo/:id/activate"])
+
+	for _, invalid := range []string{"POST /x", "POST /x=0/1m", "POST /x=10", "POST /x=10/soon"} {
+		_, err := ParseRoutes([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestRules_For(t *testing.T) {
+	rules := Rules{Routes: map[string]Limit{"POST /a": {Rate: 1, Period: time.Second}}}
+
+	limit, ok := rules.For("POST /a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, limit.Rate)
+
+	_, ok = rules.For("GET /b")
+	assert.False(t, ok)
+
+	rules.Default = Limit{Rate: 100, Period: time.Minute}
+	limit, ok = rules.For("GET /b")
+	assert.True(t, ok)
+	assert.Equal(t, 100, limit.Rate)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/auth-service/internal/config"
 
 	"github.com/redis/go-redis/v9"
@@ -15,6 +17,8 @@ func NewRedis(config *config.Redis, ctx context.Context) (*redis.Client, error)
 		Password: config.Password,
 		DB:       0,
 	})
+	client.AddHook(tracing.RedisHook{})
+	client.AddHook(metrics.RedisHook{})
 
 	pong := client.Ping(ctx)
 	if pong.Err() != nil {
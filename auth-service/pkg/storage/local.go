@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Local хранит файлы в каталоге на диске, который раздаётся по PublicURL
+type Local struct {
+	dir       string
+	publicURL string
+}
+
+func NewLocal(dir string, publicURL string) (*Local, error) {
+	if publicURL == "" {
+		return nil, errors.New("public url is required for local storage")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	return &Local{dir: dir, publicURL: strings.TrimSuffix(publicURL, "/")}, nil
+}
+
+func (l *Local) Put(_ context.Context, key string, _ string, data []byte) (string, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("os.WriteFile: %w", err)
+	}
+
+	return l.publicURL + "/" + key, nil
+}
+
+func (l *Local) Delete(_ context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("os.Remove: %w", err)
+	}
+
+	return nil
+}
+
+// path не даёт ключу выйти за пределы каталога хранилища
+func (l *Local) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
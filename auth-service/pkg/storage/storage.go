@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/pisya-dev/auth-service/internal/config"
+)
+
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// Storage хранит загруженные файлы и отдаёт их публичные адреса
+type Storage interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) (url string, err error)
+	Delete(ctx context.Context, key string) error
+}
+
+func NewStorage(config *config.Storage) (Storage, error) {
+	switch config.Backend {
+	case BackendLocal:
+		return NewLocal(config.LocalDir, config.PublicURL)
+	case BackendS3:
+		return NewS3(config)
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", config.Backend)
+}
@@ -1,32 +1,52 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
-	_ "github.com/jackc/pgx/v5"
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
 	"github.com/redis/go-redis/v9"
 
 	"gitlab.com/pisya-dev/account-service/pkg/api/account_service"
+	healthcheck "gitlab.com/pisya-dev/account-service/pkg/health"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/mtls"
+	"gitlab.com/pisya-dev/account-service/pkg/reqlog"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
 	"gitlab.com/pisya-dev/promo-code-service/internal/config"
 	promogrpc "gitlab.com/pisya-dev/promo-code-service/internal/grpc"
 	accountserviceclient "gitlab.com/pisya-dev/promo-code-service/internal/grpc/client/account_service"
 	promoHandler "gitlab.com/pisya-dev/promo-code-service/internal/grpc/handler/promo"
+	webhookHandler "gitlab.com/pisya-dev/promo-code-service/internal/grpc/handler/webhook"
 	"gitlab.com/pisya-dev/promo-code-service/internal/grpc/interceptor"
+	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/requestid"
 	promoService "gitlab.com/pisya-dev/promo-code-service/internal/service/promo"
+	webhookService "gitlab.com/pisya-dev/promo-code-service/internal/service/webhook"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/idempotency"
 	"gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
 	"gitlab.com/pisya-dev/promo-code-service/internal/storage/promo_code"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/webhook"
+	"gitlab.com/pisya-dev/promo-code-service/internal/worker"
 	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
 	"gitlab.com/pisya-dev/promo-code-service/pkg/migrations"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 const (
@@ -48,18 +68,31 @@ func main() {
 
 	log.Info("Starting Promo Service", zap.Int("port", cfg.GRPCPort))
 
+	metrics.Register(promoService.MetricsNamespace)
+
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName:  "promo-service",
+		Exporter:     cfg.TracingExporter,
+		OTLPEndpoint: cfg.OTLPEndpoint,
+		SampleRatio:  cfg.TracingSampleRatio,
+	})
+	if err != nil {
+		log.Fatal("failed to init tracing", zap.Error(err))
+	}
+
 	redisDb := redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%d", cfg.RedisHost, cfg.RedisPort), // или "redis:6379" в docker-compose
 		Password: "",                                                 // если нет пароля
 		DB:       0,                                                  // используем 0-ю базу
 	})
+	redisDb.AddHook(tracing.RedisHook{})
+	redisDb.AddHook(metrics.RedisHook{})
 	err = migrations.Migrate(cfg)
 	if err != nil {
 		log.Fatal("failed mgrations", zap.Error(err))
 	}
 
-	db, err := sqlx.Open(
-		"pgx",
+	connConfig, err := pgx.ParseConfig(
 		fmt.Sprintf(
 			"postgres://%s:%s@%s:%d/%s",
 			cfg.PostgresUser,
@@ -74,11 +107,66 @@ func main() {
 		panic(fmt.Errorf("%s: failed to connect to database:\n%s", op, err))
 	}
 
+	connConfig.Tracer = multitracer.New(tracing.QueryTracer{}, metrics.QueryTracer{})
+
+	db := sqlx.NewDb(stdlib.OpenDB(*connConfig), "pgx")
+
+	if err := db.PingContext(context.Background()); err != nil {
+		panic(fmt.Errorf("%s: failed to ping database:\n%s", op, err))
+	}
+
 	promoRepository := promo.New(db)
 
 	promoCodeRepository := promo_code.New(db)
 
-	accountServiceGRPCConnect, err := grpc.NewClient(cfg.AccountServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	auditRepository := audit.New(db)
+
+	webhookRepository := webhook.New(db)
+
+	idempotencyRepository := idempotency.New(redisDb, cfg.IdempotencyTTL)
+
+	serverCreds, clientCreds := insecure.NewCredentials(), insecure.NewCredentials()
+	logPolicy, err := reqlog.NewPolicy(reqlog.Config{
+		Levels:            cfg.LogMethodLevels,
+		SuccessSampleRate: cfg.LogSuccessSampleRate,
+		Redact:            cfg.LogRedactFields,
+	})
+	if err != nil {
+		log.Fatal("failed to configure request logging", zap.Error(err))
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{metrics.UnaryServerInterceptor, interceptor.LogRequestUnaryInterceptor(log, logPolicy)}
+	streamInterceptors := []grpc.StreamServerInterceptor{metrics.StreamServerInterceptor, interceptor.LogRequestStreamInterceptor(log, logPolicy)}
+
+	if cfg.TLSEnabled {
+		reloader, err := mtls.NewReloader(mtls.Config{
+			CertFile:       cfg.TLSCertFile,
+			KeyFile:        cfg.TLSKeyFile,
+			CAFile:         cfg.TLSCAFile,
+			ReloadInterval: cfg.TLSReloadInterval,
+		}, func(err error) {
+			log.Error("failed to reload tls certificates", zap.Error(err))
+		})
+		if err != nil {
+			log.Fatal("failed to load tls certificates", zap.Error(err))
+		}
+
+		serverCreds = credentials.NewTLS(reloader.ServerTLSConfig())
+		clientCreds = credentials.NewTLS(reloader.ClientTLSConfig(""))
+
+		allowList := mtls.NewAllowList(cfg.TLSAllowedPeers)
+		unaryInterceptors = append(unaryInterceptors, allowList.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, allowList.StreamServerInterceptor())
+	} else {
+		// без mTLS пиры не проверяются и TLS_ALLOWED_PEERS не действует
+		log.Warn("grpc tls disabled: peer allow-list is not enforced")
+	}
+
+	accountServiceGRPCConnect, err := grpc.NewClient(cfg.AccountServiceAddr,
+		grpc.WithTransportCredentials(clientCreds),
+		grpc.WithStatsHandler(tracing.ClientHandler()),
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor, accountserviceclient.ServiceTokenInterceptor(cfg.AccountServiceToken)),
+	)
 	if err != nil {
 		panic(fmt.Errorf("grpc.NewClient: failed to create account service client: %s", err))
 	}
@@ -87,18 +175,73 @@ func main() {
 
 	accountServiceClient := accountserviceclient.NewClient(accountServiceGRPCClient)
 
-	promoS := promoService.New(log, promoRepository, promoCodeRepository, redisDb, accountServiceClient)
+	webhookS := webhookService.New(log, webhookRepository, cfg.WebhookTimeout)
+
+	promoS := promoService.New(log, promoRepository, promoCodeRepository, redisDb, accountServiceClient, auditRepository, webhookS, cfg.DeletedPromoRetention)
 
 	promoH := promoHandler.New(promoS)
 
-	server := grpc.NewServer(grpc.UnaryInterceptor(interceptor.AuthInterceptor))
+	webhookH := webhookHandler.New(webhookS)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
 
-	serverAPI := promogrpc.New(promoH)
+	// lifecycle переводит промокоды в expired, exhausted и active, purge окончательно удаляет промокоды
+	// после срока хранения, webhook отправляет вебхуки и повторяет неудачные доставки
+	lifecycleWorker := worker.New(log, "lifecycle", promoS.AdvanceStatuses, cfg.LifecycleInterval)
+
+	go lifecycleWorker.Run(workerCtx)
+
+	purgeWorker := worker.New(log, "purge", promoS.PurgeDeleted, cfg.PurgeInterval)
+
+	go purgeWorker.Run(workerCtx)
+
+	webhookWorker := worker.New(log, "webhook", webhookS.DispatchDue, cfg.WebhookDispatchInterval)
+
+	go webhookWorker.Run(workerCtx)
+
+	server := grpc.NewServer(
+		grpc.Creds(serverCreds),
+		grpc.StatsHandler(tracing.ServerHandler()),
+		grpc.ChainUnaryInterceptor(append(unaryInterceptors,
+			interceptor.AuthInterceptor(cfg.ServiceTokens),
+			interceptor.IdempotencyInterceptor(log, idempotencyRepository,
+				promopb.PromoService_CreatePromo_FullMethodName,
+				promopb.PromoService_ActivatePromo_FullMethodName,
+			),
+		)...),
+		grpc.ChainStreamInterceptor(append(streamInterceptors, interceptor.StreamAuthInterceptor)...),
+	)
+
+	serverAPI := promogrpc.New(promoH, webhookH)
 
 	promopb.RegisterPromoServiceServer(server, serverAPI)
 
+	healthServer := health.NewServer()
+
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	checker := healthcheck.NewChecker(healthServer, cfg.HealthCheckInterval, cfg.HealthCheckTimeout, func(name string, err error) {
+		log.Info("dependency status changed", zap.String("dependency", name), zap.Error(err))
+	}, promopb.PromoService_ServiceDesc.ServiceName)
+	checker.Add("postgres", db.PingContext)
+	checker.Add("redis", func(ctx context.Context) error {
+		return redisDb.Ping(ctx).Err()
+	})
+	checker.Add("account-service", healthcheck.GRPCCheck(accountServiceGRPCConnect))
+
+	go checker.Run(workerCtx)
+
 	go mustRunGRPCServer(server, cfg.GRPCPort)
 
+	metricsServer := metrics.NewServer(cfg.MetricsPort)
+
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("failed to serve metrics", zap.Error(err))
+		}
+	}()
+
 	stop := make(chan os.Signal, 1)
 
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
@@ -107,8 +250,23 @@ func main() {
 
 	log.Info("Stopping application...\n", zap.String("signal", sign.String()))
 
+	// сначала сообщаем о неготовности, чтобы клиенты и балансировщик успели уйти с инстанса
+	checker.Shutdown()
+	time.Sleep(cfg.ShutdownDelay)
+
+	stopWorkers()
+
 	server.GracefulStop()
 
+	metricsServer.Close()
+
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFlush()
+
+	if err := shutdownTracing(flushCtx); err != nil {
+		log.Error("failed to flush traces", zap.Error(err))
+	}
+
 	log.Info("Application stopped")
 }
 
@@ -3,10 +3,23 @@ package adaptergrpc
 import (
 	"errors"
 
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
 	promodto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
+	auditenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/audit"
+	exportenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/export"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
 	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
 
 	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/barcode"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/tabular"
 )
 
 var InvalidPromoMode = errors.New("unknown promo mode")
@@ -44,3 +57,362 @@ func MapPbModeToDomain(m promopb.Mode) (promodto.Mode, error) {
 		return "", InvalidPromoMode
 	}
 }
+
+func MapDomainStatusToPb(s promoenum.Status) promopb.PromoStatus {
+	switch s {
+	case promoenum.StatusScheduled:
+		return promopb.PromoStatus_SCHEDULED
+	case promoenum.StatusActive:
+		return promopb.PromoStatus_ACTIVE
+	case promoenum.StatusPaused:
+		return promopb.PromoStatus_PAUSED
+	case promoenum.StatusExhausted:
+		return promopb.PromoStatus_EXHAUSTED
+	case promoenum.StatusExpired:
+		return promopb.PromoStatus_EXPIRED
+	case promoenum.StatusArchived:
+		return promopb.PromoStatus_ARCHIVED
+	default:
+		return promopb.PromoStatus_DRAFT
+	}
+}
+
+var auditOperations = map[auditenum.Operation]promopb.AuditOperation{
+	auditenum.OperationCreate:       promopb.AuditOperation_CREATE,
+	auditenum.OperationUpdate:       promopb.AuditOperation_UPDATE,
+	auditenum.OperationDelete:       promopb.AuditOperation_DELETE,
+	auditenum.OperationRestore:      promopb.AuditOperation_RESTORE,
+	auditenum.OperationPublish:      promopb.AuditOperation_PUBLISH,
+	auditenum.OperationPause:        promopb.AuditOperation_PAUSE,
+	auditenum.OperationResume:       promopb.AuditOperation_RESUME,
+	auditenum.OperationArchive:      promopb.AuditOperation_ARCHIVE,
+	auditenum.OperationStatusChange: promopb.AuditOperation_STATUS_CHANGE,
+	auditenum.OperationPurge:        promopb.AuditOperation_PURGE,
+}
+
+func MapDomainAuditOperationToPb(o auditenum.Operation) promopb.AuditOperation {
+	return auditOperations[o]
+}
+
+func MapPbAuditOperationToDomain(o promopb.AuditOperation) auditenum.Operation {
+	for domainOperation, pbOperation := range auditOperations {
+		if pbOperation == o {
+			return domainOperation
+		}
+	}
+	return ""
+}
+
+var rewardTypes = map[rewardenum.Type]promopb.RewardType{
+	rewardenum.TypePercent:      promopb.RewardType_PERCENT,
+	rewardenum.TypeFixedAmount:  promopb.RewardType_FIXED_AMOUNT,
+	rewardenum.TypeFreeItem:     promopb.RewardType_FREE_ITEM,
+	rewardenum.TypeFreeShipping: promopb.RewardType_FREE_SHIPPING,
+}
+
+func MapDomainRewardTypeToPb(t rewardenum.Type) promopb.RewardType {
+	return rewardTypes[t]
+}
+
+func MapPbRewardTypeToDomain(t promopb.RewardType) rewardenum.Type {
+	for domainType, pbType := range rewardTypes {
+		if pbType == t {
+			return domainType
+		}
+	}
+	return ""
+}
+
+var rejectReasons = map[rewardenum.RejectReason]promopb.QuoteRejectReason{
+	rewardenum.RejectReasonNone:              promopb.QuoteRejectReason_REJECT_NONE,
+	rewardenum.RejectReasonCodeNotFound:      promopb.QuoteRejectReason_REJECT_CODE_NOT_FOUND,
+	rewardenum.RejectReasonPromoNotActive:    promopb.QuoteRejectReason_REJECT_PROMO_NOT_ACTIVE,
+	rewardenum.RejectReasonNoActivationsLeft: promopb.QuoteRejectReason_REJECT_NO_ACTIVATIONS_LEFT,
+	rewardenum.RejectReasonNoReward:          promopb.QuoteRejectReason_REJECT_NO_REWARD,
+	rewardenum.RejectReasonCurrencyMismatch:  promopb.QuoteRejectReason_REJECT_CURRENCY_MISMATCH,
+	rewardenum.RejectReasonMinOrderNotMet:    promopb.QuoteRejectReason_REJECT_MIN_ORDER_NOT_MET,
+	rewardenum.RejectReasonNoEligibleItems:   promopb.QuoteRejectReason_REJECT_NO_ELIGIBLE_ITEMS,
+	rewardenum.RejectReasonFreeItemNotInCart: promopb.QuoteRejectReason_REJECT_FREE_ITEM_NOT_IN_CART,
+
+	rewardenum.RejectReasonDuplicateCode:         promopb.QuoteRejectReason_REJECT_DUPLICATE_CODE,
+	rewardenum.RejectReasonExclusiveConflict:     promopb.QuoteRejectReason_REJECT_EXCLUSIVE_CONFLICT,
+	rewardenum.RejectReasonStackingGroupConflict: promopb.QuoteRejectReason_REJECT_STACKING_GROUP_CONFLICT,
+}
+
+func MapDomainRejectReasonToPb(r rewardenum.RejectReason) promopb.QuoteRejectReason {
+	return rejectReasons[r]
+}
+
+// MapPbRewardToDomain возвращает nil, если награда не передана
+func MapPbRewardToDomain(r *promopb.Reward) *reward.DTO {
+	if r == nil {
+		return nil
+	}
+
+	return &reward.DTO{
+		Type:     MapPbRewardTypeToDomain(r.GetType()),
+		Percent:  r.GetPercent(),
+		Amount:   r.GetAmount(),
+		Currency: r.GetCurrency(),
+		ItemSku:  r.GetItemSku(),
+		Conditions: reward.Conditions{
+			MinOrderAmount:     r.GetConditions().GetMinOrderAmount(),
+			EligibleCategories: r.GetConditions().GetEligibleCategories(),
+			MaxDiscountAmount:  r.GetConditions().GetMaxDiscountAmount(),
+		},
+	}
+}
+
+func MapDomainRewardToPb(r *reward.DTO) *promopb.Reward {
+	if r == nil {
+		return nil
+	}
+
+	pbReward := &promopb.Reward{
+		Type: MapDomainRewardTypeToPb(r.Type),
+		Conditions: &promopb.RewardConditions{
+			EligibleCategories: r.Conditions.EligibleCategories,
+		},
+	}
+	if r.Percent > 0 {
+		pbReward.Percent = &r.Percent
+	}
+	if r.Amount > 0 {
+		pbReward.Amount = &r.Amount
+	}
+	if r.Currency != "" {
+		pbReward.Currency = &r.Currency
+	}
+	if r.ItemSku != "" {
+		pbReward.ItemSku = &r.ItemSku
+	}
+	if r.Conditions.MinOrderAmount > 0 {
+		pbReward.Conditions.MinOrderAmount = &r.Conditions.MinOrderAmount
+	}
+	if r.Conditions.MaxDiscountAmount > 0 {
+		pbReward.Conditions.MaxDiscountAmount = &r.Conditions.MaxDiscountAmount
+	}
+
+	return pbReward
+}
+
+func MapPbCartToDomain(c *promopb.Cart) *cart.DTO {
+	items := make([]cart.Item, len(c.GetItems()))
+	for idx, item := range c.GetItems() {
+		items[idx] = cart.Item{
+			Sku:       item.GetSku(),
+			Category:  item.GetCategory(),
+			UnitPrice: item.GetUnitPrice(),
+			Quantity:  item.GetQuantity(),
+		}
+	}
+
+	return &cart.DTO{
+		Currency:       c.GetCurrency(),
+		Items:          items,
+		ShippingAmount: c.GetShippingAmount(),
+	}
+}
+
+// MapPbStackingToDomain возвращает nil, если правила совмещения не переданы
+func MapPbStackingToDomain(s *promopb.Stacking) *stacking.Settings {
+	if s == nil {
+		return nil
+	}
+
+	return &stacking.Settings{
+		Group:     s.GetGroup(),
+		Exclusive: s.GetExclusive(),
+		Priority:  s.GetPriority(),
+	}
+}
+
+func MapDomainStackingToPb(s stacking.Settings) *promopb.Stacking {
+	pbStacking := &promopb.Stacking{
+		Exclusive: s.Exclusive,
+		Priority:  s.Priority,
+	}
+	if s.Group != "" {
+		pbStacking.Group = &s.Group
+	}
+
+	return pbStacking
+}
+
+// MapPbReferralRewardToDomain возвращает nil, если награда за приглашение не передана
+func MapPbReferralRewardToDomain(r *promopb.ReferralReward) *referral.Reward {
+	if r == nil {
+		return nil
+	}
+
+	return &referral.Reward{
+		Amount:   r.GetAmount(),
+		Currency: r.GetCurrency(),
+	}
+}
+
+func MapDomainReferralRewardToPb(r *referral.Reward) *promopb.ReferralReward {
+	if r == nil {
+		return nil
+	}
+
+	return &promopb.ReferralReward{
+		Amount:   r.Amount,
+		Currency: r.Currency,
+	}
+}
+
+var limitScopes = map[limitenum.Scope]promopb.LimitScope{
+	limitenum.ScopeGlobal: promopb.LimitScope_LIMIT_GLOBAL,
+	limitenum.ScopeUser:   promopb.LimitScope_LIMIT_PER_USER,
+}
+
+var limitWindows = map[limitenum.Window]promopb.LimitWindow{
+	limitenum.WindowLifetime: promopb.LimitWindow_WINDOW_LIFETIME,
+	limitenum.WindowDay:      promopb.LimitWindow_WINDOW_DAY,
+	limitenum.WindowWeek:     promopb.LimitWindow_WINDOW_WEEK,
+	limitenum.WindowMonth:    promopb.LimitWindow_WINDOW_MONTH,
+}
+
+// MapPbActivationLimitsToDomain всегда возвращает не-nil срез: пустой список означает снятие всех лимитов
+func MapPbActivationLimitsToDomain(l []*promopb.ActivationLimit) []limit.Policy {
+	policies := make([]limit.Policy, 0, len(l))
+	for _, pbLimit := range l {
+		policy := limit.Policy{Max: pbLimit.GetMax()}
+		for domainScope, pbScope := range limitScopes {
+			if pbScope == pbLimit.GetScope() {
+				policy.Scope = domainScope
+			}
+		}
+		for domainWindow, pbWindow := range limitWindows {
+			if pbWindow == pbLimit.GetWindow() {
+				policy.Window = domainWindow
+			}
+		}
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+func MapDomainActivationLimitsToPb(policies []limit.Policy) []*promopb.ActivationLimit {
+	pbLimits := make([]*promopb.ActivationLimit, 0, len(policies))
+	for _, policy := range policies {
+		pbLimits = append(pbLimits, &promopb.ActivationLimit{
+			Scope:  limitScopes[policy.Scope],
+			Window: limitWindows[policy.Window],
+			Max:    policy.Max,
+		})
+	}
+	return pbLimits
+}
+
+var webhookEvents = map[webhookenum.Event]promopb.WebhookEvent{
+	webhookenum.EventPromoActivated: promopb.WebhookEvent_WEBHOOK_PROMO_ACTIVATED,
+	webhookenum.EventPromoExhausted: promopb.WebhookEvent_WEBHOOK_PROMO_EXHAUSTED,
+	webhookenum.EventPromoExpired:   promopb.WebhookEvent_WEBHOOK_PROMO_EXPIRED,
+}
+
+func MapDomainWebhookEventToPb(e webhookenum.Event) promopb.WebhookEvent {
+	return webhookEvents[e]
+}
+
+// MapPbWebhookEventToDomain возвращает пустое событие для неизвестного значения
+func MapPbWebhookEventToDomain(e promopb.WebhookEvent) webhookenum.Event {
+	for domainEvent, pbEvent := range webhookEvents {
+		if pbEvent == e {
+			return domainEvent
+		}
+	}
+	return ""
+}
+
+var webhookDeliveryStatuses = map[webhookenum.DeliveryStatus]promopb.WebhookDeliveryStatus{
+	webhookenum.DeliveryStatusPending:   promopb.WebhookDeliveryStatus_DELIVERY_PENDING,
+	webhookenum.DeliveryStatusDelivered: promopb.WebhookDeliveryStatus_DELIVERY_DELIVERED,
+	webhookenum.DeliveryStatusDead:      promopb.WebhookDeliveryStatus_DELIVERY_DEAD,
+}
+
+func MapDomainWebhookDeliveryStatusToPb(s webhookenum.DeliveryStatus) promopb.WebhookDeliveryStatus {
+	return webhookDeliveryStatuses[s]
+}
+
+func MapPbWebhookDeliveryStatusToDomain(s promopb.WebhookDeliveryStatus) webhookenum.DeliveryStatus {
+	for domainStatus, pbStatus := range webhookDeliveryStatuses {
+		if pbStatus == s {
+			return domainStatus
+		}
+	}
+	return ""
+}
+
+var codeSymbologies = map[promopb.CodeSymbology]barcode.Symbology{
+	promopb.CodeSymbology_SYMBOLOGY_QR:      barcode.SymbologyQR,
+	promopb.CodeSymbology_SYMBOLOGY_CODE128: barcode.SymbologyCode128,
+	promopb.CodeSymbology_SYMBOLOGY_EAN13:   barcode.SymbologyEAN13,
+}
+
+var codeImageFormats = map[promopb.CodeImageFormat]barcode.Format{
+	promopb.CodeImageFormat_IMAGE_PNG: barcode.FormatPNG,
+	promopb.CodeImageFormat_IMAGE_SVG: barcode.FormatSVG,
+}
+
+var qrErrorCorrections = map[promopb.QrErrorCorrection]barcode.ErrorCorrection{
+	promopb.QrErrorCorrection_QR_EC_L: barcode.ErrorCorrectionL,
+	promopb.QrErrorCorrection_QR_EC_M: barcode.ErrorCorrectionM,
+	promopb.QrErrorCorrection_QR_EC_Q: barcode.ErrorCorrectionQ,
+	promopb.QrErrorCorrection_QR_EC_H: barcode.ErrorCorrectionH,
+}
+
+// MapPbRenderOptions собирает параметры отрисовки кода, подставляя значения по умолчанию для незаданных полей.
+// Неизвестные значения перечислений остаются пустыми и отклоняются при валидации
+func MapPbRenderOptions(r *promopb.RenderPromoCodeRequest) barcode.Options {
+	opts := barcode.Options{
+		Symbology:       codeSymbologies[r.GetSymbology()],
+		Format:          codeImageFormats[r.GetFormat()],
+		Size:            barcode.DefaultSize,
+		ErrorCorrection: barcode.ErrorCorrectionM,
+	}
+	if r.Size != nil {
+		opts.Size = int(r.GetSize())
+	}
+	if r.ErrorCorrection != nil {
+		opts.ErrorCorrection = qrErrorCorrections[r.GetErrorCorrection()]
+	}
+	return opts
+}
+
+var exportDatasets = map[promopb.ExportDataset]exportenum.Dataset{
+	promopb.ExportDataset_EXPORT_CODES:       exportenum.DatasetCodes,
+	promopb.ExportDataset_EXPORT_ACTIVATIONS: exportenum.DatasetActivations,
+}
+
+var exportFormats = map[promopb.ExportFormat]tabular.Format{
+	promopb.ExportFormat_EXPORT_CSV:  tabular.FormatCSV,
+	promopb.ExportFormat_EXPORT_XLSX: tabular.FormatXLSX,
+}
+
+func MapPbExportDataset(d promopb.ExportDataset) exportenum.Dataset {
+	return exportDatasets[d]
+}
+
+func MapPbExportFormat(f promopb.ExportFormat) tabular.Format {
+	return exportFormats[f]
+}
+
+func MapPbLocalizationToDomain(l *promopb.Localization) *localization.Localization {
+	if l == nil {
+		return nil
+	}
+
+	return &localization.Localization{
+		DefaultLocale: l.GetDefaultLocale(),
+		Translations:  l.GetTranslations(),
+	}
+}
+
+func MapDomainLocalizationToPb(l localization.Localization) *promopb.Localization {
+	return &promopb.Localization{
+		DefaultLocale: l.DefaultLocale,
+		Translations:  l.Translations,
+	}
+}
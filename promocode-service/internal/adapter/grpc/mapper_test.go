@@ -6,8 +6,15 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	adaptergrpc "gitlab.com/pisya-dev/promo-code-service/internal/adapter/grpc"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
 	promodto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
+	auditenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/audit"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
 	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
 	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
 )
 
@@ -113,3 +120,126 @@ func TestMapPbModeToDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestMapDomainStatusToPb(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    promoenum.Status
+		expected promopb.PromoStatus
+	}{
+		{
+			name:     "Draft",
+			input:    promoenum.StatusDraft,
+			expected: promopb.PromoStatus_DRAFT,
+		},
+		{
+			name:     "Active",
+			input:    promoenum.StatusActive,
+			expected: promopb.PromoStatus_ACTIVE,
+		},
+		{
+			name:     "Exhausted",
+			input:    promoenum.StatusExhausted,
+			expected: promopb.PromoStatus_EXHAUSTED,
+		},
+		{
+			name:     "Archived",
+			input:    promoenum.StatusArchived,
+			expected: promopb.PromoStatus_ARCHIVED,
+		},
+		{
+			name:     "UnknownValue",
+			input:    promoenum.Status("unknown"),
+			expected: promopb.PromoStatus_DRAFT,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := adaptergrpc.MapDomainStatusToPb(tt.input)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestMapAuditOperation(t *testing.T) {
+	operations := []auditenum.Operation{
+		auditenum.OperationCreate,
+		auditenum.OperationUpdate,
+		auditenum.OperationDelete,
+		auditenum.OperationRestore,
+		auditenum.OperationPublish,
+		auditenum.OperationPause,
+		auditenum.OperationResume,
+		auditenum.OperationArchive,
+		auditenum.OperationStatusChange,
+		auditenum.OperationPurge,
+	}
+
+	for _, operation := range operations {
+		t.Run(string(operation), func(t *testing.T) {
+			pbOperation := adaptergrpc.MapDomainAuditOperationToPb(operation)
+			assert.Equal(t, operation, adaptergrpc.MapPbAuditOperationToDomain(pbOperation))
+		})
+	}
+
+	assert.Equal(t, auditenum.Operation(""), adaptergrpc.MapPbAuditOperationToDomain(promopb.AuditOperation(999)))
+}
+
+func TestMapReward(t *testing.T) {
+	rewardDto := &reward.DTO{
+		Type:     rewardenum.TypeFixedAmount,
+		Amount:   50000,
+		Currency: "RUB",
+		Conditions: reward.Conditions{
+			MinOrderAmount:     300000,
+			EligibleCategories: []string{"coffee"},
+		},
+	}
+
+	pbReward := adaptergrpc.MapDomainRewardToPb(rewardDto)
+
+	assert.Equal(t, promopb.RewardType_FIXED_AMOUNT, pbReward.GetType())
+	assert.Nil(t, pbReward.Percent)
+	assert.Nil(t, pbReward.GetConditions().MaxDiscountAmount)
+	assert.Equal(t, rewardDto, adaptergrpc.MapPbRewardToDomain(pbReward))
+
+	assert.Nil(t, adaptergrpc.MapDomainRewardToPb(nil))
+	assert.Nil(t, adaptergrpc.MapPbRewardToDomain(nil))
+}
+
+func TestMapStacking(t *testing.T) {
+	settings := stacking.Settings{Group: "seasonal", Exclusive: true, Priority: 3}
+
+	pbStacking := adaptergrpc.MapDomainStackingToPb(settings)
+
+	assert.Equal(t, "seasonal", pbStacking.GetGroup())
+	assert.Equal(t, &settings, adaptergrpc.MapPbStackingToDomain(pbStacking))
+	assert.Nil(t, adaptergrpc.MapDomainStackingToPb(stacking.Settings{}).Group)
+	assert.Nil(t, adaptergrpc.MapPbStackingToDomain(nil))
+}
+
+func TestMapActivationLimits(t *testing.T) {
+	policies := []limit.Policy{
+		{Scope: limitenum.ScopeUser, Window: limitenum.WindowMonth, Max: 3},
+		{Scope: limitenum.ScopeGlobal, Window: limitenum.WindowDay, Max: 500},
+	}
+
+	pbLimits := adaptergrpc.MapDomainActivationLimitsToPb(policies)
+
+	assert.Equal(t, promopb.LimitScope_LIMIT_PER_USER, pbLimits[0].GetScope())
+	assert.Equal(t, promopb.LimitWindow_WINDOW_MONTH, pbLimits[0].GetWindow())
+	assert.Equal(t, policies, adaptergrpc.MapPbActivationLimitsToDomain(pbLimits))
+	assert.NotNil(t, adaptergrpc.MapPbActivationLimitsToDomain(nil))
+}
+
+func TestMapReferralReward(t *testing.T) {
+	rewardDto := &referral.Reward{Amount: 50000, Currency: "RUB"}
+
+	pbReward := adaptergrpc.MapDomainReferralRewardToPb(rewardDto)
+
+	assert.Equal(t, int64(50000), pbReward.GetAmount())
+	assert.Equal(t, rewardDto, adaptergrpc.MapPbReferralRewardToDomain(pbReward))
+	assert.Nil(t, adaptergrpc.MapDomainReferralRewardToPb(nil))
+	assert.Nil(t, adaptergrpc.MapPbReferralRewardToDomain(nil))
+}
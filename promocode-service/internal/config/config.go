@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
@@ -17,6 +20,76 @@ type Config struct {
 	RedisPort          int    `env:"REDIS_PORT"`
 	RedisHost          string `env:"REDIS_HOST"`
 	AccountServiceAddr string `env:"ACCOUNT_SERVICE_ADDR"`
+	// AccountServiceToken сервисный токен promo-service из SERVICE_TOKENS account-service
+	AccountServiceToken string `env:"ACCOUNT_SERVICE_TOKEN"`
+
+	// ServiceTokens токены сервисов, которым доступны данные пользователей, в формате name:token
+	// через запятую, например auth-service:secret
+	ServiceTokens map[string]string `env:"SERVICE_TOKENS"`
+
+	LifecycleInterval time.Duration `env:"LIFECYCLE_INTERVAL" env-default:"1m"`
+
+	DeletedPromoRetention time.Duration `env:"DELETED_PROMO_RETENTION" env-default:"720h"`
+	PurgeInterval         time.Duration `env:"PURGE_INTERVAL" env-default:"1h"`
+
+	// IdempotencyTTL сколько хранится ответ запроса с Idempotency-Key
+	IdempotencyTTL time.Duration `env:"IDEMPOTENCY_TTL" env-default:"24h"`
+
+	WebhookDispatchInterval time.Duration `env:"WEBHOOK_DISPATCH_INTERVAL" env-default:"5s"`
+	WebhookTimeout          time.Duration `env:"WEBHOOK_TIMEOUT" env-default:"10s"`
+
+	// mTLS для gRPC-сервера и клиента account-service. TLSAllowedPeers задаёт методы для каждого
+	// клиента по CommonName его сертификата: auth-service:*
+	TLSEnabled        bool              `env:"TLS_ENABLED"`
+	TLSCertFile       string            `env:"TLS_CERT_FILE" env-default:"./certs/mtls/tls.pem"`
+	TLSKeyFile        string            `env:"TLS_KEY_FILE" env-default:"./certs/mtls/tls-key.pem"`
+	TLSCAFile         string            `env:"TLS_CA_FILE" env-default:"./certs/mtls/ca.pem"`
+	TLSReloadInterval time.Duration     `env:"TLS_RELOAD_INTERVAL" env-default:"1m"`
+	TLSAllowedPeers   map[string]string `env:"TLS_ALLOWED_PEERS"`
+
+	// проверки зависимостей для grpc.health.v1. ShutdownDelay сколько сервис после сигнала остановки
+	// отвечает NOT_SERVING, прежде чем перестать принимать соединения
+	HealthCheckInterval time.Duration `env:"HEALTH_CHECK_INTERVAL" env-default:"5s"`
+	HealthCheckTimeout  time.Duration `env:"HEALTH_CHECK_TIMEOUT" env-default:"2s"`
+	ShutdownDelay       time.Duration `env:"SHUTDOWN_DELAY" env-default:"5s"`
+
+	// MetricsPort admin-порт, на котором отдаётся /metrics
+	MetricsPort int `env:"METRICS_PORT" env-default:"9090"`
+
+	// трассировка OpenTelemetry: none, stdout или otlp
+	TracingExporter    string  `env:"TRACING_EXPORTER" env-default:"none"`
+	OTLPEndpoint       string  `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	TracingSampleRatio float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+
+	// логирование вызовов: уровень по методу (/api.PromoService/PromoPing:off, off отключает),
+	// доля успешных вызовов в логе и поля тела запроса, скрываемые в дополнение к стандартным
+	LogMethodLevels      []string `env:"LOG_METHOD_LEVELS"`
+	LogSuccessSampleRate float64  `env:"LOG_SUCCESS_SAMPLE_RATE" env-default:"1"`
+	LogRedactFields      []string `env:"LOG_REDACT_FIELDS"`
+}
+
+// redacted заменяет значение секрета при выводе конфигурации
+const redacted = "[REDACTED]"
+
+// String выводит конфигурацию без секретов: её печатают при старте, и вывод попадает в логи
+func (c Config) String() string {
+	// у копии нет метода String, иначе %+v вызывал бы его рекурсивно
+	type config Config
+	safe := config(c)
+
+	if safe.PostgresPassword != "" {
+		safe.PostgresPassword = redacted
+	}
+	if safe.AccountServiceToken != "" {
+		safe.AccountServiceToken = redacted
+	}
+
+	safe.ServiceTokens = make(map[string]string, len(c.ServiceTokens))
+	for name := range c.ServiceTokens {
+		safe.ServiceTokens[name] = redacted
+	}
+
+	return fmt.Sprintf("%+v", safe)
 }
 
 func MustLoad() *Config {
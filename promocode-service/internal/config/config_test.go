@@ -0,0 +1,26 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Тест вывода конфигурации: секреты не попадают в лог
+func TestConfig_String(t *testing.T) {
+	cfg := &Config{
+		PostgresUser:        "promo",
+		PostgresPassword:    "pg-secret",
+		AccountServiceToken: "account-secret",
+		ServiceTokens:       map[string]string{"auth-service": "token-secret"},
+	}
+
+	out := fmt.Sprint(cfg)
+
+	assert.Contains(t, out, "promo")
+	assert.Contains(t, out, "auth-service")
+	assert.NotContains(t, out, "pg-secret")
+	assert.NotContains(t, out, "token-secret")
+	assert.NotContains(t, out, "account-secret")
+}
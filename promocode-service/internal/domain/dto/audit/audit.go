@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	auditenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/audit"
+)
+
+type Entry struct {
+	Id          string
+	PromoId     string
+	CompanyId   string
+	ActorId     string
+	ActorUserId string
+	Operation   auditenum.Operation
+	Changes     json.RawMessage
+	CreatedAt   time.Time
+}
+
+// Filter задаёт выборку записей журнала; пустые поля не ограничивают выборку
+type Filter struct {
+	PromoId   string
+	CompanyId string
+	Operation auditenum.Operation
+	From      time.Time
+	Until     time.Time
+}
+
+// Change хранит значения поля до и после изменения
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Diff сравнивает JSON-представления before и after и возвращает только изменившиеся поля.
+// nil на месте before или after означает, что промокод был создан или удалён.
+func Diff(before any, after any) (json.RawMessage, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return nil, fmt.Errorf("before: %w", err)
+	}
+
+	afterFields, err := toFields(after)
+	if err != nil {
+		return nil, fmt.Errorf("after: %w", err)
+	}
+
+	changes := make(map[string]Change)
+
+	for field, beforeValue := range beforeFields {
+		afterValue := afterFields[field]
+		if !reflect.DeepEqual(beforeValue, afterValue) {
+			changes[field] = Change{Before: beforeValue, After: afterValue}
+		}
+	}
+
+	for field, afterValue := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = Change{Before: nil, After: afterValue}
+		}
+	}
+
+	return json.Marshal(changes)
+}
+
+func toFields(v any) (map[string]any, error) {
+	fields := make(map[string]any)
+
+	if v == nil || reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil() {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
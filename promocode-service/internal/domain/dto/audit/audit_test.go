@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type snapshot struct {
+	Description string   `json:"description"`
+	Categories  []string `json:"categories"`
+	Status      string   `json:"status"`
+}
+
+func TestDiff_ChangedFieldsOnly(t *testing.T) {
+	before := snapshot{Description: "old description", Categories: []string{"cats"}, Status: "active"}
+	after := snapshot{Description: "new description", Categories: []string{"cats"}, Status: "active"}
+
+	changes, err := Diff(before, after)
+	require.NoError(t, err)
+
+	var got map[string]Change
+	require.NoError(t, json.Unmarshal(changes, &got))
+
+	assert.Equal(t, map[string]Change{
+		"description": {Before: "old description", After: "new description"},
+	}, got)
+}
+
+func TestDiff_Create(t *testing.T) {
+	after := &snapshot{Description: "description", Status: "draft"}
+
+	changes, err := Diff(nil, after)
+	require.NoError(t, err)
+
+	var got map[string]Change
+	require.NoError(t, json.Unmarshal(changes, &got))
+
+	assert.Len(t, got, 3)
+	assert.Nil(t, got["status"].Before)
+	assert.Equal(t, "draft", got["status"].After)
+}
+
+func TestDiff_Delete(t *testing.T) {
+	before := &snapshot{Description: "description", Status: "active"}
+	var after *snapshot
+
+	changes, err := Diff(before, after)
+	require.NoError(t, err)
+
+	var got map[string]Change
+	require.NoError(t, json.Unmarshal(changes, &got))
+
+	assert.Equal(t, "active", got["status"].Before)
+	assert.Nil(t, got["status"].After)
+}
+
+func TestDiff_NoChanges(t *testing.T) {
+	s := snapshot{Description: "description", Status: "active"}
+
+	changes, err := Diff(s, s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(changes))
+}
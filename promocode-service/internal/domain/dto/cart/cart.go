@@ -0,0 +1,52 @@
+package cart
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+// Item описывает позицию корзины. Цены указываются в минимальных единицах валюты корзины (копейки, центы)
+type Item struct {
+	Sku       string `validate:"required,max=64"`
+	Category  string `validate:"max=20"`
+	UnitPrice int64  `validate:"min=0"`
+	Quantity  int64  `validate:"gt=0"`
+}
+
+type DTO struct {
+	Currency       string `validate:"required,iso4217"`
+	Items          []Item `validate:"required,min=1,dive"`
+	ShippingAmount int64  `validate:"min=0"`
+}
+
+func (dto *DTO) Validate() error {
+	if err := validator.New().Struct(dto); err != nil {
+		var ve validator.ValidationErrors
+		if errors.As(err, &ve) {
+			fe := ve[0]
+			return domainerrors.ValidationError{
+				Field:   strings.TrimPrefix(fe.Namespace(), "DTO."),
+				Message: fmt.Sprintf("failed on %s", fe.Tag()),
+			}
+		}
+		return domainerrors.ValidationError{
+			Field:   "cart",
+			Message: "invalid cart",
+		}
+	}
+
+	return nil
+}
+
+// Subtotal возвращает стоимость товаров без доставки
+func (dto *DTO) Subtotal() int64 {
+	var subtotal int64
+	for _, item := range dto.Items {
+		subtotal += item.UnitPrice * item.Quantity
+	}
+	return subtotal
+}
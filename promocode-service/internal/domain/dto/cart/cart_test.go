@@ -0,0 +1,23 @@
+package cart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+func TestDTO_Validate(t *testing.T) {
+	valid := &DTO{
+		Currency: "RUB",
+		Items:    []Item{{Sku: "coffee-250", UnitPrice: 45000, Quantity: 2}},
+	}
+	assert.NoError(t, valid.Validate())
+	assert.Equal(t, int64(90000), valid.Subtotal())
+
+	err := (&DTO{Currency: "RUB", Items: []Item{{Sku: "coffee-250", UnitPrice: 45000}}}).Validate()
+	assert.Equal(t, domainerrors.ValidationError{Field: "Items[0].Quantity", Message: "failed on gt"}, err)
+
+	assert.Error(t, (&DTO{Currency: "rubles", Items: valid.Items}).Validate())
+	assert.Error(t, (&DTO{Currency: "RUB"}).Validate())
+}
@@ -0,0 +1,153 @@
+package limit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+// MaxPolicies максимальное число политик у одного промокода
+const MaxPolicies = 10
+
+// Policy ограничивает число активаций промокода в календарном окне: всего или для одного пользователя
+type Policy struct {
+	Scope  limitenum.Scope  `validate:"required,oneof=global user"`
+	Window limitenum.Window `validate:"required,oneof=lifetime day week month"`
+	Max    int64            `validate:"min=1,max=1000000"`
+}
+
+// Validate проверяет набор политик: каждая политика корректна, пара scope/window не повторяется
+func Validate(policies []Policy) error {
+	if len(policies) > MaxPolicies {
+		return domainerrors.ValidationError{
+			Field:   "activation_limits",
+			Message: fmt.Sprintf("at most %d limits are allowed", MaxPolicies),
+		}
+	}
+
+	validate := validator.New()
+	seen := make(map[Policy]struct{}, len(policies))
+	for i, policy := range policies {
+		if err := validate.Struct(policy); err != nil {
+			var ve validator.ValidationErrors
+			if errors.As(err, &ve) {
+				return domainerrors.ValidationError{
+					Field:   fmt.Sprintf("activation_limits[%d].%s", i, ve[0].Field()),
+					Message: fmt.Sprintf("failed on %s", ve[0].Tag()),
+				}
+			}
+			return domainerrors.ValidationError{Field: "activation_limits", Message: err.Error()}
+		}
+
+		key := Policy{Scope: policy.Scope, Window: policy.Window}
+		if _, ok := seen[key]; ok {
+			return domainerrors.ValidationError{
+				Field:   fmt.Sprintf("activation_limits[%d]", i),
+				Message: fmt.Sprintf("duplicate %s limit per %s", policy.Scope, policy.Window),
+			}
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// PerUser сообщает, нужна ли для проверки политик личность пользователя
+func PerUser(policies []Policy) bool {
+	for _, policy := range policies {
+		if policy.Scope == limitenum.ScopeUser {
+			return true
+		}
+	}
+	return false
+}
+
+// WindowStart возвращает начало текущего окна политики. Для lifetime возвращается нулевое время
+func (p Policy) WindowStart(now time.Time) time.Time {
+	now = now.UTC()
+	year, month, day := now.Date()
+
+	switch p.Window {
+	case limitenum.WindowDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	case limitenum.WindowWeek:
+		// неделя начинается с понедельника
+		offset := (int(now.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, time.UTC)
+	case limitenum.WindowMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Time{}
+	}
+}
+
+// ResetAt возвращает момент, когда счётчик политики обнулится. Для lifetime возвращается нулевое время
+func (p Policy) ResetAt(now time.Time) time.Time {
+	start := p.WindowStart(now)
+
+	switch p.Window {
+	case limitenum.WindowDay:
+		return start.AddDate(0, 0, 1)
+	case limitenum.WindowWeek:
+		return start.AddDate(0, 0, 7)
+	case limitenum.WindowMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return time.Time{}
+	}
+}
+
+// ExceededError возвращается, когда активация нарушила бы одну из политик
+type ExceededError struct {
+	Policy  Policy
+	ResetAt time.Time
+}
+
+func (e *ExceededError) Error() string {
+	scope := "global"
+	if e.Policy.Scope == limitenum.ScopeUser {
+		scope = "per-user"
+	}
+
+	activations := "activations"
+	if e.Policy.Max == 1 {
+		activations = "activation"
+	}
+
+	if e.ResetAt.IsZero() {
+		return fmt.Sprintf("%s limit of %d %s reached, limit never resets", scope, e.Policy.Max, activations)
+	}
+
+	return fmt.Sprintf("%s limit of %d %s per %s reached, resets at %s",
+		scope, e.Policy.Max, activations, e.Policy.Window, e.ResetAt.UTC().Format(time.RFC3339))
+}
+
+// Check сравнивает использование с политиками. used[i] число активаций в текущем окне policies[i].
+// Если нарушено несколько политик, возвращается та, что блокирует активации дольше всех
+func Check(policies []Policy, used []int64, now time.Time) *ExceededError {
+	var exceeded *ExceededError
+
+	for i, policy := range policies {
+		if used[i] < policy.Max {
+			continue
+		}
+
+		candidate := &ExceededError{Policy: policy, ResetAt: policy.ResetAt(now)}
+		if exceeded == nil || blocksLonger(candidate, exceeded) {
+			exceeded = candidate
+		}
+	}
+
+	return exceeded
+}
+
+func blocksLonger(a, b *ExceededError) bool {
+	if b.ResetAt.IsZero() {
+		return false
+	}
+	return a.ResetAt.IsZero() || a.ResetAt.After(b.ResetAt)
+}
@@ -0,0 +1,124 @@
+package limit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+func TestPolicy_Window(t *testing.T) {
+	// среда, 15 октября 2025
+	now := time.Date(2025, time.October, 15, 13, 45, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	tests := []struct {
+		window    limitenum.Window
+		wantStart time.Time
+		wantReset time.Time
+	}{
+		{
+			window:    limitenum.WindowDay,
+			wantStart: time.Date(2025, time.October, 15, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, time.October, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			window:    limitenum.WindowWeek,
+			wantStart: time.Date(2025, time.October, 13, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, time.October, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			window:    limitenum.WindowMonth,
+			wantStart: time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			window: limitenum.WindowLifetime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.window), func(t *testing.T) {
+			policy := Policy{Scope: limitenum.ScopeGlobal, Window: tt.window, Max: 1}
+			assert.True(t, tt.wantStart.Equal(policy.WindowStart(now)), policy.WindowStart(now))
+			assert.True(t, tt.wantReset.Equal(policy.ResetAt(now)), policy.ResetAt(now))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		policies  []Policy
+		wantField string
+	}{
+		{
+			name: "valid",
+			policies: []Policy{
+				{Scope: limitenum.ScopeUser, Window: limitenum.WindowLifetime, Max: 1},
+				{Scope: limitenum.ScopeUser, Window: limitenum.WindowMonth, Max: 3},
+				{Scope: limitenum.ScopeGlobal, Window: limitenum.WindowDay, Max: 500},
+			},
+		},
+		{
+			name:      "zero max",
+			policies:  []Policy{{Scope: limitenum.ScopeUser, Window: limitenum.WindowDay}},
+			wantField: "activation_limits[0].Max",
+		},
+		{
+			name:      "unknown window",
+			policies:  []Policy{{Scope: limitenum.ScopeUser, Window: "year", Max: 1}},
+			wantField: "activation_limits[0].Window",
+		},
+		{
+			name: "duplicate scope and window",
+			policies: []Policy{
+				{Scope: limitenum.ScopeUser, Window: limitenum.WindowDay, Max: 1},
+				{Scope: limitenum.ScopeUser, Window: limitenum.WindowDay, Max: 2},
+			},
+			wantField: "activation_limits[1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.policies)
+			if tt.wantField == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var verr domainerrors.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.wantField, verr.Field)
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2025, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	perUserMonth := Policy{Scope: limitenum.ScopeUser, Window: limitenum.WindowMonth, Max: 3}
+	globalDay := Policy{Scope: limitenum.ScopeGlobal, Window: limitenum.WindowDay, Max: 500}
+	perUserLifetime := Policy{Scope: limitenum.ScopeUser, Window: limitenum.WindowLifetime, Max: 10}
+
+	t.Run("under limits", func(t *testing.T) {
+		assert.Nil(t, Check([]Policy{perUserMonth, globalDay}, []int64{2, 499}, now))
+	})
+
+	t.Run("reports the limit that blocks longest", func(t *testing.T) {
+		exceeded := Check([]Policy{globalDay, perUserMonth}, []int64{500, 3}, now)
+		require.NotNil(t, exceeded)
+		assert.Equal(t, perUserMonth, exceeded.Policy)
+		assert.Equal(t, "per-user limit of 3 activations per month reached, resets at 2025-11-01T00:00:00Z", exceeded.Error())
+	})
+
+	t.Run("lifetime limit never resets", func(t *testing.T) {
+		exceeded := Check([]Policy{perUserMonth, perUserLifetime}, []int64{3, 10}, now)
+		require.NotNil(t, exceeded)
+		assert.Equal(t, perUserLifetime, exceeded.Policy)
+		assert.True(t, exceeded.ResetAt.IsZero())
+	})
+}
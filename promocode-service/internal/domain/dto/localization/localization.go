@@ -0,0 +1,129 @@
+package localization
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+	"golang.org/x/text/language"
+)
+
+// MaxTranslations ограничивает число переводов описания одного промокода
+const MaxTranslations = 20
+
+// maxPreferred ограничивает число учитываемых языков из accept-language
+const maxPreferred = 10
+
+// Localization переводы описания промокода. Основное описание хранится в promo.description и написано
+// на языке DefaultLocale, Translations содержит описания на других языках. Ключи — теги BCP-47
+type Localization struct {
+	DefaultLocale string
+	Translations  map[string]string
+}
+
+// Normalize проверяет локализацию и приводит теги к канонической форме BCP-47 (en-us -> en-US).
+// Переводы требуют указанного DefaultLocale и не могут дублировать его
+func Normalize(l *Localization) (*Localization, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	normalized := &Localization{}
+
+	if l.DefaultLocale != "" {
+		tag, err := language.Parse(l.DefaultLocale)
+		if err != nil {
+			return nil, domainerrors.ValidationError{Field: "localization.default_locale", Message: fmt.Sprintf("invalid BCP-47 tag %q", l.DefaultLocale)}
+		}
+		normalized.DefaultLocale = tag.String()
+	}
+
+	if len(l.Translations) == 0 {
+		return normalized, nil
+	}
+
+	if normalized.DefaultLocale == "" {
+		return nil, domainerrors.ValidationError{Field: "localization.default_locale", Message: "required when translations are set"}
+	}
+
+	if len(l.Translations) > MaxTranslations {
+		return nil, domainerrors.ValidationError{Field: "localization.translations", Message: fmt.Sprintf("at most %d translations are allowed", MaxTranslations)}
+	}
+
+	normalized.Translations = make(map[string]string, len(l.Translations))
+
+	for locale, description := range l.Translations {
+		field := fmt.Sprintf("localization.translations[%s]", locale)
+
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return nil, domainerrors.ValidationError{Field: field, Message: fmt.Sprintf("invalid BCP-47 tag %q", locale)}
+		}
+
+		key := tag.String()
+		if key == normalized.DefaultLocale {
+			return nil, domainerrors.ValidationError{Field: field, Message: "duplicates default_locale, use description instead"}
+		}
+		if _, ok := normalized.Translations[key]; ok {
+			return nil, domainerrors.ValidationError{Field: field, Message: fmt.Sprintf("duplicates locale %s", key)}
+		}
+
+		if n := utf8.RuneCountInString(description); n < 10 || n > 300 {
+			return nil, domainerrors.ValidationError{Field: field, Message: "must be between 10 and 300 characters"}
+		}
+
+		normalized.Translations[key] = description
+	}
+
+	return normalized, nil
+}
+
+// Negotiate выбирает описание для языков preferred в порядке убывания предпочтения. Подходящим считается
+// и близкий язык (de-AT для de), а если подходящего перевода нет, возвращается основное описание.
+// locale — язык возвращённого описания, пустой, если язык основного описания не указан
+func (l Localization) Negotiate(description string, preferred []language.Tag) (text string, locale string) {
+	if len(l.Translations) == 0 || len(preferred) == 0 {
+		return description, l.DefaultLocale
+	}
+
+	locales := make([]string, 0, len(l.Translations))
+	for key := range l.Translations {
+		locales = append(locales, key)
+	}
+	sort.Strings(locales)
+
+	// первый поддерживаемый язык используется matcher'ом как запасной
+	supported := make([]language.Tag, 0, len(locales)+1)
+	supported = append(supported, language.Make(l.DefaultLocale))
+	for _, key := range locales {
+		supported = append(supported, language.Make(key))
+	}
+
+	_, idx, confidence := language.NewMatcher(supported).Match(preferred...)
+	if confidence == language.No || idx == 0 {
+		return description, l.DefaultLocale
+	}
+
+	key := locales[idx-1]
+	return l.Translations[key], key
+}
+
+// ParsePreferred разбирает значения заголовка Accept-Language в порядке убывания веса.
+// Некорректные значения пропускаются
+func ParsePreferred(values []string) []language.Tag {
+	var preferred []language.Tag
+
+	for _, value := range values {
+		tags, _, err := language.ParseAcceptLanguage(value)
+		if err != nil {
+			continue
+		}
+		preferred = append(preferred, tags...)
+		if len(preferred) >= maxPreferred {
+			return preferred[:maxPreferred]
+		}
+	}
+
+	return preferred
+}
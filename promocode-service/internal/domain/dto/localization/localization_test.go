@@ -0,0 +1,85 @@
+package localization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		l         *Localization
+		want      *Localization
+		wantField string
+	}{
+		{name: "no localization", l: nil, want: nil},
+		{name: "default locale only", l: &Localization{DefaultLocale: "RU"}, want: &Localization{DefaultLocale: "ru"}},
+		{
+			name: "canonical tags",
+			l:    &Localization{DefaultLocale: "ru", Translations: map[string]string{"en-us": "Ten percent off everything", "de": "Zehn Prozent auf alles"}},
+			want: &Localization{DefaultLocale: "ru", Translations: map[string]string{"en-US": "Ten percent off everything", "de": "Zehn Prozent auf alles"}},
+		},
+		{name: "invalid default locale", l: &Localization{DefaultLocale: "not a tag"}, wantField: "localization.default_locale"},
+		{name: "translations without default locale", l: &Localization{Translations: map[string]string{"en": "Ten percent off everything"}}, wantField: "localization.default_locale"},
+		{name: "invalid translation tag", l: &Localization{DefaultLocale: "ru", Translations: map[string]string{"??": "Ten percent off everything"}}, wantField: "localization.translations[??]"},
+		{name: "translation for default locale", l: &Localization{DefaultLocale: "ru", Translations: map[string]string{"RU": "Скидка десять процентов"}}, wantField: "localization.translations[RU]"},
+		{name: "short translation", l: &Localization{DefaultLocale: "ru", Translations: map[string]string{"en": "Sale"}}, wantField: "localization.translations[en]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.l)
+			if tt.wantField == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				return
+			}
+
+			var verr domainerrors.ValidationError
+			if assert.ErrorAs(t, err, &verr) {
+				assert.Equal(t, tt.wantField, verr.Field)
+			}
+		})
+	}
+}
+
+func TestLocalization_Negotiate(t *testing.T) {
+	l := Localization{
+		DefaultLocale: "ru",
+		Translations: map[string]string{
+			"en":    "Ten percent off everything",
+			"pt-BR": "Dez por cento de desconto",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		wantText       string
+		wantLocale     string
+	}{
+		{name: "no preference", acceptLanguage: "", wantText: "Скидка десять процентов", wantLocale: "ru"},
+		{name: "exact match", acceptLanguage: "en", wantText: "Ten percent off everything", wantLocale: "en"},
+		{name: "regional variant", acceptLanguage: "en-GB", wantText: "Ten percent off everything", wantLocale: "en"},
+		{name: "weights", acceptLanguage: "de;q=0.9, pt-BR;q=0.8, en;q=0.5", wantText: "Dez por cento de desconto", wantLocale: "pt-BR"},
+		{name: "default locale preferred", acceptLanguage: "ru-RU, en;q=0.5", wantText: "Скидка десять процентов", wantLocale: "ru"},
+		{name: "fallback to default", acceptLanguage: "ja", wantText: "Скидка десять процентов", wantLocale: "ru"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, locale := l.Negotiate("Скидка десять процентов", ParsePreferred([]string{tt.acceptLanguage}))
+			assert.Equal(t, tt.wantText, text)
+			assert.Equal(t, tt.wantLocale, locale)
+		})
+	}
+}
+
+func TestLocalization_Negotiate_UnknownDefaultLocale(t *testing.T) {
+	text, locale := Localization{}.Negotiate("Скидка десять процентов", ParsePreferred([]string{"en"}))
+	assert.Equal(t, "Скидка десять процентов", text)
+	assert.Empty(t, locale)
+}
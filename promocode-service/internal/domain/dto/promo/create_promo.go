@@ -5,21 +5,32 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
 	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 )
 
 type CreatePromoDTO struct {
-	CompanyId   string     `validate:"required,uuid4"`
-	Mode        Mode       `validate:"required,oneof=COMMON UNIQUE"`
-	PromoCommon string     `validate:"required_if=Mode COMMON,omitempty,min=5,max=30"`
-	PromoUnique []string   `validate:"required_if=Mode UNIQUE,omitempty,dive,min=3,max=30"`
-	Description string     `validate:"required,min=10,max=300"`
-	ImageUrl    string     `validate:"omitempty,url,max=350"`
-	Target      target.DTO `validate:"required"`
-	MaxCount    int64      `validate:"required"`
-	ActiveFrom  time.Time  `validate:"omitempty"`
-	ActiveUntil time.Time  `validate:"omitempty,gtfield=ActiveFrom"`
+	CompanyId        string     `validate:"required,uuid4"`
+	Mode             Mode       `validate:"required,oneof=COMMON UNIQUE"`
+	PromoCommon      string     `validate:"required_if=Mode COMMON,omitempty,min=5,max=30"`
+	PromoUnique      []string   `validate:"required_if=Mode UNIQUE,omitempty,dive,min=3,max=30"`
+	Description      string     `validate:"required,min=10,max=300"`
+	ImageUrl         string     `validate:"omitempty,url,max=350"`
+	Target           target.DTO `validate:"required"`
+	MaxCount         int64      `validate:"required"`
+	ActiveFrom       time.Time  `validate:"omitempty"`
+	ActiveUntil      time.Time  `validate:"omitempty,gtfield=ActiveFrom"`
+	Draft            bool
+	Reward           *reward.DTO
+	Stacking         stacking.Settings
+	ActivationLimits []limit.Policy
+	ReferralReward   *referral.Reward
+	Localization     *localization.Localization
 }
 
 func (dto *CreatePromoDTO) Validate() error {
@@ -59,6 +70,18 @@ func (dto *CreatePromoDTO) Validate() error {
 		}
 	}
 
+	if err := ValidateReward(dto.Reward); err != nil {
+		return err
+	}
+
+	if err := limit.Validate(dto.ActivationLimits); err != nil {
+		return err
+	}
+
+	if err := referral.Validate(dto.ReferralReward); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -89,3 +112,34 @@ func (dto *CreatePromoDTO) validateDates() error {
 	}
 	return nil
 }
+
+// ValidateReward проверяет награду промокода, если она задана
+func ValidateReward(rewardDto *reward.DTO) error {
+	if rewardDto == nil {
+		return nil
+	}
+
+	err := rewardDto.Validate()
+	if err == nil {
+		return nil
+	}
+
+	var ve validator.ValidationErrors
+	if errors.As(err, &ve) {
+		fe := ve[0]
+		return domainerrors.ValidationError{
+			Field:   "reward." + fe.Field(),
+			Message: validationMessage(fe.Tag(), fe.Param()),
+		}
+	}
+
+	var verr domainerrors.ValidationError
+	if errors.As(err, &verr) {
+		return verr
+	}
+
+	return domainerrors.ValidationError{
+		Field:   "reward",
+		Message: err.Error(),
+	}
+}
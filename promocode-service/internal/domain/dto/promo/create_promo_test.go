@@ -4,7 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
 	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 
 	"github.com/google/uuid"
@@ -126,6 +130,60 @@ func TestCreatePromoDTO_Validate(t *testing.T) {
 				Message: "target validation error", // adjust based on actual target validation
 			},
 		},
+		{
+			name: "percent reward without percent",
+			dto: CreatePromoDTO{
+				CompanyId:   validUUID,
+				Mode:        COMMON,
+				PromoCommon: "SUMMER25",
+				Description: "Test description",
+				Target:      validTarget,
+				MaxCount:    100,
+				Reward:      &reward.DTO{Type: rewardenum.TypePercent},
+			},
+			wantErr: true,
+			expectedErr: domainerrors.ValidationError{
+				Field:   "reward.Percent",
+				Message: "is required when Type percent",
+			},
+		},
+		{
+			name: "fixed amount reward without currency",
+			dto: CreatePromoDTO{
+				CompanyId:   validUUID,
+				Mode:        COMMON,
+				PromoCommon: "SUMMER25",
+				Description: "Test description",
+				Target:      validTarget,
+				MaxCount:    100,
+				Reward:      &reward.DTO{Type: rewardenum.TypeFixedAmount, Amount: 50000},
+			},
+			wantErr: true,
+			expectedErr: domainerrors.ValidationError{
+				Field:   "reward.currency",
+				Message: "is required for fixed amounts and amount conditions",
+			},
+		},
+		{
+			name: "duplicate activation limits",
+			dto: CreatePromoDTO{
+				CompanyId:   validUUID,
+				Mode:        COMMON,
+				PromoCommon: "SUMMER25",
+				Description: "Test description",
+				Target:      validTarget,
+				MaxCount:    100,
+				ActivationLimits: []limit.Policy{
+					{Scope: limitenum.ScopeUser, Window: limitenum.WindowDay, Max: 1},
+					{Scope: limitenum.ScopeUser, Window: limitenum.WindowDay, Max: 2},
+				},
+			},
+			wantErr: true,
+			expectedErr: domainerrors.ValidationError{
+				Field:   "activation_limits[1]",
+				Message: "duplicate user limit per day",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -6,7 +6,13 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
+	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
 	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 )
 
@@ -28,6 +34,38 @@ type DTO struct {
 	ActiveUntil      time.Time   `validate:"omitempty,gtfield=ActiveFrom"`
 	Codes            []Code      `validate:"required,dive"`
 	ActivationsCount int64       `validate:"min=0"`
+	Highlight        string      `validate:"omitempty"`
+	SearchRank       float64     `validate:"min=0"`
+	Status           promoenum.Status
+	Active           bool
+	Reward           *reward.DTO
+	Stacking         stacking.Settings
+	ActivationLimits []limit.Policy
+	ReferralReward   *referral.Reward
+	Localization     localization.Localization
+}
+
+// IsActive вычисляет флаг active: промокод опубликован, находится в периоде действия и у него остались активации
+func (dto *DTO) IsActive(now time.Time) bool {
+	if dto.Status != promoenum.StatusActive {
+		return false
+	}
+
+	if !dto.ActiveFrom.IsZero() && dto.ActiveFrom.After(now) {
+		return false
+	}
+
+	if !dto.ActiveUntil.IsZero() && dto.ActiveUntil.Before(now) {
+		return false
+	}
+
+	for _, code := range dto.Codes {
+		if code.Activations < code.MaxCount {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (dto *DTO) Validate() error {
@@ -119,6 +157,12 @@ func validationMessage(tag string, param string) string {
 		return fmt.Sprintf("must be greater than %s", param)
 	case "dive":
 		return "contains invalid items"
+	case "required_if":
+		return fmt.Sprintf("is required when %s", param)
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", param)
+	case "iso4217":
+		return "must be an ISO 4217 currency code"
 	default:
 		return "failed validation"
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
+	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
 	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 )
 
@@ -141,3 +142,41 @@ func TestDTO_Validate_InvalidTarget(t *testing.T) {
 	var verr domainerrors.ValidationError
 	assert.ErrorAs(t, err, &verr)
 }
+
+func TestDTO_IsActive(t *testing.T) {
+	now := time.Now().UTC()
+
+	dto := validDTO()
+	dto.Status = promoenum.StatusActive
+	dto.ActiveFrom = now.Add(-time.Hour)
+	dto.ActiveUntil = now.Add(time.Hour)
+	assert.True(t, dto.IsActive(now))
+
+	dto.Status = promoenum.StatusPaused
+	assert.False(t, dto.IsActive(now))
+}
+
+func TestDTO_IsActive_OutsideWindow(t *testing.T) {
+	now := time.Now().UTC()
+
+	dto := validDTO()
+	dto.Status = promoenum.StatusActive
+	dto.ActiveFrom = now.Add(time.Hour)
+	dto.ActiveUntil = now.Add(2 * time.Hour)
+	assert.False(t, dto.IsActive(now))
+
+	dto.ActiveFrom = now.Add(-2 * time.Hour)
+	dto.ActiveUntil = now.Add(-time.Hour)
+	assert.False(t, dto.IsActive(now))
+}
+
+func TestDTO_IsActive_NoActivationsLeft(t *testing.T) {
+	now := time.Now().UTC()
+
+	dto := validDTO()
+	dto.Status = promoenum.StatusActive
+	dto.ActiveFrom = now.Add(-time.Hour)
+	dto.ActiveUntil = now.Add(time.Hour)
+	dto.Codes = []Code{{Code: "VALIDCODE123", Activations: 100, MaxCount: 100}}
+	assert.False(t, dto.IsActive(now))
+}
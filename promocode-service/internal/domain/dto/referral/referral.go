@@ -0,0 +1,40 @@
+package referral
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+// MaxAmount верхняя граница начисления за одного приглашённого в минимальных единицах валюты
+const MaxAmount = 100_000_000
+
+// Reward начисление пригласившему, когда приглашённый им пользователь впервые активирует промокод компании.
+// Правило задаёт бизнес при создании промокода, сумма указывается в минимальных единицах валюты
+type Reward struct {
+	Amount   int64  `validate:"min=1,max=100000000"`
+	Currency string `validate:"required,iso4217"`
+}
+
+// Validate проверяет правило начисления, если оно задано
+func Validate(reward *Reward) error {
+	if reward == nil {
+		return nil
+	}
+
+	if err := validator.New().Struct(reward); err != nil {
+		var ve validator.ValidationErrors
+		if errors.As(err, &ve) {
+			return domainerrors.ValidationError{
+				Field:   "referral_reward." + strings.ToLower(ve[0].Field()),
+				Message: fmt.Sprintf("failed on %s", ve[0].Tag()),
+			}
+		}
+		return domainerrors.ValidationError{Field: "referral_reward", Message: err.Error()}
+	}
+
+	return nil
+}
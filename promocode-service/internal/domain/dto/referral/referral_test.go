@@ -0,0 +1,38 @@
+package referral
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		reward    *Reward
+		wantField string
+	}{
+		{name: "no reward", reward: nil},
+		{name: "valid", reward: &Reward{Amount: 50000, Currency: "RUB"}},
+		{name: "zero amount", reward: &Reward{Amount: 0, Currency: "RUB"}, wantField: "referral_reward.amount"},
+		{name: "amount too large", reward: &Reward{Amount: MaxAmount + 1, Currency: "RUB"}, wantField: "referral_reward.amount"},
+		{name: "missing currency", reward: &Reward{Amount: 100}, wantField: "referral_reward.currency"},
+		{name: "unknown currency", reward: &Reward{Amount: 100, Currency: "XXY"}, wantField: "referral_reward.currency"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.reward)
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var verr domainerrors.ValidationError
+			if assert.ErrorAs(t, err, &verr) {
+				assert.Equal(t, tt.wantField, verr.Field)
+			}
+		})
+	}
+}
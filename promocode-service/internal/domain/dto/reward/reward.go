@@ -0,0 +1,123 @@
+package reward
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+// Conditions ограничивают применение награды. Суммы указываются в минимальных единицах валюты награды
+type Conditions struct {
+	MinOrderAmount     int64    `validate:"min=0"`
+	EligibleCategories []string `validate:"dive,min=1,max=20"`
+	MaxDiscountAmount  int64    `validate:"min=0"`
+}
+
+// DTO описывает выгоду, которую даёт промокод
+type DTO struct {
+	Type       rewardenum.Type `validate:"required,oneof=percent fixed_amount free_item free_shipping"`
+	Percent    float64         `validate:"required_if=Type percent,omitempty,gt=0,max=100"`
+	Amount     int64           `validate:"required_if=Type fixed_amount,omitempty,gt=0"`
+	Currency   string          `validate:"omitempty,iso4217"`
+	ItemSku    string          `validate:"required_if=Type free_item,omitempty,max=64"`
+	Conditions Conditions
+}
+
+// Quote результат расчёта скидки для корзины
+type Quote struct {
+	PromoId      string
+	RewardType   rewardenum.Type
+	Discount     int64
+	Currency     string
+	RejectReason rewardenum.RejectReason
+}
+
+func (q *Quote) Applicable() bool {
+	return q.RejectReason == rewardenum.RejectReasonNone
+}
+
+func (dto *DTO) Validate() error {
+	if err := validator.New().Struct(dto); err != nil {
+		return err
+	}
+
+	needsCurrency := dto.Type == rewardenum.TypeFixedAmount ||
+		dto.Conditions.MinOrderAmount > 0 ||
+		dto.Conditions.MaxDiscountAmount > 0
+
+	if needsCurrency && dto.Currency == "" {
+		return domainerrors.ValidationError{
+			Field:   "reward.currency",
+			Message: "is required for fixed amounts and amount conditions",
+		}
+	}
+
+	return nil
+}
+
+// Quote рассчитывает скидку для корзины. Если награда не применима, возвращает причину отказа
+func (dto *DTO) Quote(c *cart.DTO) (discount int64, reason rewardenum.RejectReason) {
+	if dto.Currency != "" && !strings.EqualFold(dto.Currency, c.Currency) {
+		return 0, rewardenum.RejectReasonCurrencyMismatch
+	}
+
+	if dto.Conditions.MinOrderAmount > 0 && c.Subtotal() < dto.Conditions.MinOrderAmount {
+		return 0, rewardenum.RejectReasonMinOrderNotMet
+	}
+
+	eligible := dto.eligibleItems(c.Items)
+	if len(eligible) == 0 {
+		return 0, rewardenum.RejectReasonNoEligibleItems
+	}
+
+	var eligibleSubtotal int64
+	for _, item := range eligible {
+		eligibleSubtotal += item.UnitPrice * item.Quantity
+	}
+
+	switch dto.Type {
+	case rewardenum.TypePercent:
+		// процент переводится в базисные пункты, чтобы не считать деньги во float
+		discount = eligibleSubtotal * int64(math.Round(dto.Percent*100)) / 10000
+	case rewardenum.TypeFixedAmount:
+		discount = min(dto.Amount, eligibleSubtotal)
+	case rewardenum.TypeFreeItem:
+		idx := slices.IndexFunc(eligible, func(item cart.Item) bool {
+			return item.Sku == dto.ItemSku
+		})
+		if idx == -1 {
+			return 0, rewardenum.RejectReasonFreeItemNotInCart
+		}
+		discount = eligible[idx].UnitPrice
+	case rewardenum.TypeFreeShipping:
+		discount = c.ShippingAmount
+	}
+
+	if dto.Conditions.MaxDiscountAmount > 0 {
+		discount = min(discount, dto.Conditions.MaxDiscountAmount)
+	}
+
+	return discount, rewardenum.RejectReasonNone
+}
+
+func (dto *DTO) eligibleItems(items []cart.Item) []cart.Item {
+	if len(dto.Conditions.EligibleCategories) == 0 {
+		return items
+	}
+
+	var eligible []cart.Item
+	for _, item := range items {
+		for _, category := range dto.Conditions.EligibleCategories {
+			if strings.EqualFold(item.Category, category) {
+				eligible = append(eligible, item)
+				break
+			}
+		}
+	}
+	return eligible
+}
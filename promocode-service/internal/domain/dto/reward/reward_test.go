@@ -0,0 +1,105 @@
+package reward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+func testCart() *cart.DTO {
+	return &cart.DTO{
+		Currency: "RUB",
+		Items: []cart.Item{
+			{Sku: "coffee-250", Category: "coffee", UnitPrice: 45000, Quantity: 2},
+			{Sku: "mug", Category: "dishes", UnitPrice: 30000, Quantity: 1},
+		},
+		ShippingAmount: 25000,
+	}
+}
+
+func TestDTO_Quote(t *testing.T) {
+	tests := []struct {
+		name         string
+		reward       DTO
+		wantDiscount int64
+		wantReason   rewardenum.RejectReason
+	}{
+		{
+			name:         "percent of whole cart",
+			reward:       DTO{Type: rewardenum.TypePercent, Percent: 10},
+			wantDiscount: 12000,
+		},
+		{
+			name: "percent of eligible categories capped by max discount",
+			reward: DTO{Type: rewardenum.TypePercent, Percent: 50, Currency: "RUB", Conditions: Conditions{
+				EligibleCategories: []string{"Coffee"},
+				MaxDiscountAmount:  40000,
+			}},
+			wantDiscount: 40000,
+		},
+		{
+			name:         "fixed amount does not exceed eligible subtotal",
+			reward:       DTO{Type: rewardenum.TypeFixedAmount, Amount: 500000, Currency: "RUB"},
+			wantDiscount: 120000,
+		},
+		{
+			name:         "free item",
+			reward:       DTO{Type: rewardenum.TypeFreeItem, ItemSku: "mug"},
+			wantDiscount: 30000,
+		},
+		{
+			name:       "free item missing from cart",
+			reward:     DTO{Type: rewardenum.TypeFreeItem, ItemSku: "grinder"},
+			wantReason: rewardenum.RejectReasonFreeItemNotInCart,
+		},
+		{
+			name:         "free shipping",
+			reward:       DTO{Type: rewardenum.TypeFreeShipping},
+			wantDiscount: 25000,
+		},
+		{
+			name:       "currency mismatch",
+			reward:     DTO{Type: rewardenum.TypeFixedAmount, Amount: 1000, Currency: "USD"},
+			wantReason: rewardenum.RejectReasonCurrencyMismatch,
+		},
+		{
+			name: "minimum order not met",
+			reward: DTO{Type: rewardenum.TypePercent, Percent: 10, Currency: "RUB", Conditions: Conditions{
+				MinOrderAmount: 200000,
+			}},
+			wantReason: rewardenum.RejectReasonMinOrderNotMet,
+		},
+		{
+			name: "no eligible items",
+			reward: DTO{Type: rewardenum.TypePercent, Percent: 10, Conditions: Conditions{
+				EligibleCategories: []string{"tea"},
+			}},
+			wantReason: rewardenum.RejectReasonNoEligibleItems,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, reason := tt.reward.Quote(testCart())
+			assert.Equal(t, tt.wantReason, reason)
+			assert.Equal(t, tt.wantDiscount, discount)
+		})
+	}
+}
+
+func TestDTO_Validate(t *testing.T) {
+	assert.NoError(t, (&DTO{Type: rewardenum.TypePercent, Percent: 12.5}).Validate())
+	assert.NoError(t, (&DTO{Type: rewardenum.TypeFreeShipping}).Validate())
+	assert.Error(t, (&DTO{Type: rewardenum.TypePercent, Percent: 120}).Validate())
+	assert.Error(t, (&DTO{Type: rewardenum.TypeFreeItem}).Validate())
+	assert.Error(t, (&DTO{Type: "cashback"}).Validate())
+
+	err := (&DTO{Type: rewardenum.TypeFreeShipping, Conditions: Conditions{MinOrderAmount: 100000}}).Validate()
+	assert.Equal(t, domainerrors.ValidationError{
+		Field:   "reward.currency",
+		Message: "is required for fixed amounts and amount conditions",
+	}, err)
+}
@@ -0,0 +1,124 @@
+package stacking
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-playground/validator/v10"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+)
+
+// Settings описывают, с какими промокодами той же компании можно совмещать промокод.
+// Из одной группы применяется только один промокод, эксклюзивный промокод не совмещается ни с какими другими.
+// При конфликте побеждает промокод с большим приоритетом
+type Settings struct {
+	Group     string `validate:"max=50"`
+	Exclusive bool
+	Priority  int64 `validate:"min=0,max=1000"`
+}
+
+func (s *Settings) Validate() error {
+	return validator.New().Struct(s)
+}
+
+// Candidate код из корзины, который прошёл проверку активности
+type Candidate struct {
+	Code      string
+	PromoId   string
+	CompanyId string
+	Settings  Settings
+}
+
+type Rejection struct {
+	Code               string
+	PromoId            string
+	Reason             rewardenum.RejectReason
+	ConflictingPromoId string
+}
+
+// Explanation возвращает человекочитаемое объяснение отказа
+func (r Rejection) Explanation() string {
+	switch r.Reason {
+	case rewardenum.RejectReasonCodeNotFound:
+		return "promo code not found"
+	case rewardenum.RejectReasonPromoNotActive:
+		return "promo is not active"
+	case rewardenum.RejectReasonNoActivationsLeft:
+		return "promo code has no activations left"
+	case rewardenum.RejectReasonDuplicateCode:
+		return fmt.Sprintf("promo %s is already applied", r.ConflictingPromoId)
+	case rewardenum.RejectReasonExclusiveConflict:
+		return fmt.Sprintf("cannot be combined with promo %s, one of them is exclusive", r.ConflictingPromoId)
+	case rewardenum.RejectReasonStackingGroupConflict:
+		return fmt.Sprintf("promo %s from the same stacking group has higher priority", r.ConflictingPromoId)
+	default:
+		return string(r.Reason)
+	}
+}
+
+type companyState struct {
+	first     string
+	exclusive string
+	groups    map[string]string
+}
+
+// Resolve выбирает промокоды, которые можно применить вместе. Кандидаты рассматриваются по убыванию
+// приоритета, при равном приоритете в исходном порядке. Правила действуют в пределах одной компании
+func Resolve(candidates []Candidate) (applied []Candidate, rejected []Rejection) {
+	ordered := make([]Candidate, len(candidates))
+	copy(ordered, candidates)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Settings.Priority > ordered[j].Settings.Priority
+	})
+
+	promos := make(map[string]struct{}, len(ordered))
+	companies := make(map[string]*companyState)
+
+	for _, candidate := range ordered {
+		rejection := Rejection{Code: candidate.Code, PromoId: candidate.PromoId}
+
+		if _, ok := promos[candidate.PromoId]; ok {
+			rejection.Reason = rewardenum.RejectReasonDuplicateCode
+			rejection.ConflictingPromoId = candidate.PromoId
+			rejected = append(rejected, rejection)
+			continue
+		}
+
+		state, ok := companies[candidate.CompanyId]
+		if !ok {
+			state = &companyState{groups: make(map[string]string)}
+			companies[candidate.CompanyId] = state
+		}
+
+		switch {
+		case state.exclusive != "":
+			rejection.Reason = rewardenum.RejectReasonExclusiveConflict
+			rejection.ConflictingPromoId = state.exclusive
+		case candidate.Settings.Exclusive && state.first != "":
+			rejection.Reason = rewardenum.RejectReasonExclusiveConflict
+			rejection.ConflictingPromoId = state.first
+		case candidate.Settings.Group != "" && state.groups[candidate.Settings.Group] != "":
+			rejection.Reason = rewardenum.RejectReasonStackingGroupConflict
+			rejection.ConflictingPromoId = state.groups[candidate.Settings.Group]
+		}
+
+		if rejection.Reason != rewardenum.RejectReasonNone {
+			rejected = append(rejected, rejection)
+			continue
+		}
+
+		promos[candidate.PromoId] = struct{}{}
+		if state.first == "" {
+			state.first = candidate.PromoId
+		}
+		if candidate.Settings.Exclusive {
+			state.exclusive = candidate.PromoId
+		}
+		if candidate.Settings.Group != "" {
+			state.groups[candidate.Settings.Group] = candidate.PromoId
+		}
+		applied = append(applied, candidate)
+	}
+
+	return applied, rejected
+}
@@ -0,0 +1,96 @@
+package stacking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name        string
+		candidates  []Candidate
+		wantApplied []string
+		wantRejects []Rejection
+	}{
+		{
+			name: "independent promos stack",
+			candidates: []Candidate{
+				{Code: "A", PromoId: "p1", CompanyId: "c1"},
+				{Code: "B", PromoId: "p2", CompanyId: "c1", Settings: Settings{Group: "shipping"}},
+			},
+			wantApplied: []string{"A", "B"},
+		},
+		{
+			name: "same group keeps higher priority",
+			candidates: []Candidate{
+				{Code: "A", PromoId: "p1", CompanyId: "c1", Settings: Settings{Group: "seasonal", Priority: 1}},
+				{Code: "B", PromoId: "p2", CompanyId: "c1", Settings: Settings{Group: "seasonal", Priority: 5}},
+			},
+			wantApplied: []string{"B"},
+			wantRejects: []Rejection{
+				{Code: "A", PromoId: "p1", Reason: rewardenum.RejectReasonStackingGroupConflict, ConflictingPromoId: "p2"},
+			},
+		},
+		{
+			name: "exclusive promo with higher priority wins",
+			candidates: []Candidate{
+				{Code: "A", PromoId: "p1", CompanyId: "c1"},
+				{Code: "B", PromoId: "p2", CompanyId: "c1", Settings: Settings{Exclusive: true, Priority: 10}},
+			},
+			wantApplied: []string{"B"},
+			wantRejects: []Rejection{
+				{Code: "A", PromoId: "p1", Reason: rewardenum.RejectReasonExclusiveConflict, ConflictingPromoId: "p2"},
+			},
+		},
+		{
+			name: "exclusive promo with lower priority is rejected",
+			candidates: []Candidate{
+				{Code: "A", PromoId: "p1", CompanyId: "c1", Settings: Settings{Exclusive: true}},
+				{Code: "B", PromoId: "p2", CompanyId: "c1", Settings: Settings{Priority: 3}},
+			},
+			wantApplied: []string{"B"},
+			wantRejects: []Rejection{
+				{Code: "A", PromoId: "p1", Reason: rewardenum.RejectReasonExclusiveConflict, ConflictingPromoId: "p2"},
+			},
+		},
+		{
+			name: "rules do not cross companies",
+			candidates: []Candidate{
+				{Code: "A", PromoId: "p1", CompanyId: "c1", Settings: Settings{Exclusive: true}},
+				{Code: "B", PromoId: "p2", CompanyId: "c2", Settings: Settings{Exclusive: true}},
+			},
+			wantApplied: []string{"A", "B"},
+		},
+		{
+			name: "two codes of one promo",
+			candidates: []Candidate{
+				{Code: "A", PromoId: "p1", CompanyId: "c1"},
+				{Code: "B", PromoId: "p1", CompanyId: "c1"},
+			},
+			wantApplied: []string{"A"},
+			wantRejects: []Rejection{
+				{Code: "B", PromoId: "p1", Reason: rewardenum.RejectReasonDuplicateCode, ConflictingPromoId: "p1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied, rejected := Resolve(tt.candidates)
+
+			var appliedCodes []string
+			for _, candidate := range applied {
+				appliedCodes = append(appliedCodes, candidate.Code)
+			}
+			assert.ElementsMatch(t, tt.wantApplied, appliedCodes)
+			assert.Equal(t, tt.wantRejects, rejected)
+		})
+	}
+}
+
+func TestRejection_Explanation(t *testing.T) {
+	rejection := Rejection{Reason: rewardenum.RejectReasonStackingGroupConflict, ConflictingPromoId: "p2"}
+	assert.Equal(t, "promo p2 from the same stacking group has higher priority", rejection.Explanation())
+}
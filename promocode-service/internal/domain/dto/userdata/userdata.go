@@ -0,0 +1,19 @@
+// Package userdata описывает персональные данные пользователя, которые хранит сервис промокодов
+package userdata
+
+import "time"
+
+// Activation активация промокода пользователем
+type Activation struct {
+	PromoId     string
+	CompanyId   string
+	Code        string
+	Description string
+	ActivatedAt time.Time
+}
+
+// Erasure результат обезличивания данных пользователя
+type Erasure struct {
+	AnonymizedActivations     int64
+	ScrubbedWebhookDeliveries int
+}
@@ -0,0 +1,170 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+const (
+	// MaxAttempts число попыток доставки, после которого доставка переносится в dead-letter
+	MaxAttempts = 8
+
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+
+	// MaxEndpoints максимальное число адресов у одной компании
+	MaxEndpoints = 10
+)
+
+// Заголовки, с которыми отправляется событие
+const (
+	HeaderId        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+var (
+	// ErrInvalidSignature подпись не совпадает с телом запроса или отметка времени вне допустимого окна
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrForbiddenAddress адрес получателя ведёт во внутреннюю сеть
+	ErrForbiddenAddress = errors.New("webhook address is not public")
+)
+
+// sharedAddressSpace адреса carrier-grade NAT (RFC 6598), не входящие в netip.Addr.IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicAddr сообщает, можно ли отправлять события на адрес. Запрещены loopback, link-local,
+// в том числе адрес метаданных облака 169.254.169.254, частные сети и прочие внутренние адреса
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// Endpoint адрес компании, на который отправляются события промокодов
+type Endpoint struct {
+	Id        string
+	CompanyId string              `validate:"required"`
+	Url       string              `validate:"required,url,max=2048"`
+	Events    []webhookenum.Event `validate:"required,min=1,max=3,unique,dive,oneof=promo.activated promo.exhausted promo.expired"`
+	Secret    string
+	CreatedAt time.Time
+}
+
+func (e *Endpoint) Validate() error {
+	if err := validator.New().Struct(e); err != nil {
+		var ve validator.ValidationErrors
+		if errors.As(err, &ve) {
+			return domainerrors.ValidationError{
+				Field:   ve[0].Field(),
+				Message: fmt.Sprintf("failed on %s", ve[0].Tag()),
+			}
+		}
+		return domainerrors.ValidationError{Field: "", Message: err.Error()}
+	}
+
+	parsed, err := url.Parse(e.Url)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return domainerrors.ValidationError{Field: "Url", Message: "must be an http or https url"}
+	}
+
+	// имя хоста может разрешиться во внутренний адрес позже, его проверяет dialer при отправке
+	host := strings.ToLower(parsed.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return domainerrors.ValidationError{Field: "Url", Message: "must not point to an internal address"}
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !PublicAddr(addr) {
+		return domainerrors.ValidationError{Field: "Url", Message: "must not point to an internal address"}
+	}
+
+	return nil
+}
+
+type Delivery struct {
+	Id             string
+	EndpointId     string
+	CompanyId      string
+	Event          webhookenum.Event
+	Payload        json.RawMessage
+	Status         webhookenum.DeliveryStatus
+	Attempts       int
+	LastStatusCode int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+}
+
+// Filter задаёт выборку доставок; пустые поля, кроме CompanyId, не ограничивают выборку
+type Filter struct {
+	CompanyId  string
+	EndpointId string
+	Status     webhookenum.DeliveryStatus
+}
+
+// Envelope тело запроса, которое получает компания
+type Envelope struct {
+	Event     webhookenum.Event `json:"event"`
+	CreatedAt time.Time         `json:"created_at"`
+	Data      any               `json:"data"`
+}
+
+// Backoff возвращает задержку перед следующей попыткой после attempt неудачных попыток:
+// 30s, 1m, 2m, 4m, ... но не больше 6h
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := baseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+
+	return delay
+}
+
+// Sign вычисляет подпись HMAC-SHA256 над строкой "<timestamp>.<body>". Отметка времени входит в подпись,
+// чтобы получатель мог отклонять повторно отправленные старые запросы
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись, полученную в заголовках запроса, и что отметка времени не старше tolerance
+func Verify(secret string, timestampHeader string, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	unix, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	timestamp := time.Unix(unix, 0)
+	if now.Sub(timestamp) > tolerance || timestamp.Sub(now) > tolerance {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Backoff(0))
+	assert.Equal(t, 30*time.Second, Backoff(1))
+	assert.Equal(t, time.Minute, Backoff(2))
+	assert.Equal(t, 32*time.Minute, Backoff(7))
+	assert.Equal(t, 6*time.Hour, Backoff(20))
+}
+
+func TestSignVerify(t *testing.T) {
+	now := time.Date(2025, 10, 15, 12, 0, 0, 0, time.UTC)
+	body := []byte(`{"event":"promo.activated"}`)
+
+	signature := Sign("secret", now, body)
+	timestamp := "1760529600"
+
+	assert.NoError(t, Verify("secret", timestamp, signature, body, 5*time.Minute, now.Add(time.Minute)))
+	assert.ErrorIs(t, Verify("other", timestamp, signature, body, 5*time.Minute, now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("secret", timestamp, signature, []byte(`{}`), 5*time.Minute, now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("secret", timestamp, signature, body, 5*time.Minute, now.Add(time.Hour)), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("secret", "not-a-number", signature, body, 5*time.Minute, now), ErrInvalidSignature)
+}
+
+func TestPublicAddr(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"93.184.216.34":        true,
+		"2606:2800:220:1::1":   true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"169.254.169.254":      false,
+		"fe80::1":              false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"fd00::1":              false,
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"::ffff:127.0.0.1":     false,
+		"::ffff:93.184.216.34": true,
+		"224.0.0.1":            false,
+		"255.255.255.255":      false,
+	} {
+		assert.Equal(t, want, PublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestEndpoint_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  Endpoint
+		wantField string
+	}{
+		{
+			name:     "valid",
+			endpoint: Endpoint{CompanyId: "c1", Url: "https://example.com/hooks", Events: []webhookenum.Event{webhookenum.EventPromoActivated}},
+		},
+		{
+			name:      "no events",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "https://example.com/hooks"},
+			wantField: "Events",
+		},
+		{
+			name:      "unknown event",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "https://example.com/hooks", Events: []webhookenum.Event{"promo.deleted"}},
+			wantField: "Events[0]",
+		},
+		{
+			name:      "duplicate events",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "https://example.com/hooks", Events: []webhookenum.Event{webhookenum.EventPromoExpired, webhookenum.EventPromoExpired}},
+			wantField: "Events",
+		},
+		{
+			name:      "localhost",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "http://localhost:8080/hooks", Events: []webhookenum.Event{webhookenum.EventPromoExpired}},
+			wantField: "Url",
+		},
+		{
+			name:      "loopback",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "http://127.0.0.1/hooks", Events: []webhookenum.Event{webhookenum.EventPromoExpired}},
+			wantField: "Url",
+		},
+		{
+			name:      "cloud metadata",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "http://169.254.169.254/latest/meta-data", Events: []webhookenum.Event{webhookenum.EventPromoExpired}},
+			wantField: "Url",
+		},
+		{
+			name:      "private network",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "https://10.0.0.5/hooks", Events: []webhookenum.Event{webhookenum.EventPromoExpired}},
+			wantField: "Url",
+		},
+		{
+			name:      "ipv6 loopback",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "https://[::1]/hooks", Events: []webhookenum.Event{webhookenum.EventPromoExpired}},
+			wantField: "Url",
+		},
+		{
+			name:     "public address",
+			endpoint: Endpoint{CompanyId: "c1", Url: "https://93.184.216.34/hooks", Events: []webhookenum.Event{webhookenum.EventPromoExpired}},
+		},
+		{
+			name:      "unsupported scheme",
+			endpoint:  Endpoint{CompanyId: "c1", Url: "ftp://example.com/hooks", Events: []webhookenum.Event{webhookenum.EventPromoExpired}},
+			wantField: "Url",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.endpoint.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var verr domainerrors.ValidationError
+			if assert.ErrorAs(t, err, &verr) {
+				assert.Equal(t, tt.wantField, verr.Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package audit
+
+type Operation string
+
+const (
+	OperationCreate       Operation = "create"
+	OperationUpdate       Operation = "update"
+	OperationDelete       Operation = "delete"
+	OperationRestore      Operation = "restore"
+	OperationPublish      Operation = "publish"
+	OperationPause        Operation = "pause"
+	OperationResume       Operation = "resume"
+	OperationArchive      Operation = "archive"
+	OperationStatusChange Operation = "status_change"
+	OperationPurge        Operation = "purge"
+)
+
+// SystemActor записывается в журнал как автор изменений, сделанных фоновыми задачами
+const SystemActor = "system"
@@ -0,0 +1,10 @@
+package export
+
+type Dataset string
+
+const (
+	// DatasetCodes коды промокода с числом активаций
+	DatasetCodes Dataset = "codes"
+	// DatasetActivations записи об отдельных активациях
+	DatasetActivations Dataset = "activations"
+)
@@ -0,0 +1,20 @@
+package limit
+
+type Scope string
+
+const (
+	// ScopeGlobal считает активации всех пользователей
+	ScopeGlobal Scope = "global"
+	// ScopeUser считает активации одного пользователя
+	ScopeUser Scope = "user"
+)
+
+// Window календарное окно, в котором считаются активации. Окна выравниваются по UTC
+type Window string
+
+const (
+	WindowLifetime Window = "lifetime"
+	WindowDay      Window = "day"
+	WindowWeek     Window = "week"
+	WindowMonth    Window = "month"
+)
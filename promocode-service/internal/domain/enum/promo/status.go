@@ -0,0 +1,60 @@
+package promo
+
+import "time"
+
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusScheduled Status = "scheduled"
+	StatusActive    Status = "active"
+	StatusPaused    Status = "paused"
+	StatusExhausted Status = "exhausted"
+	StatusExpired   Status = "expired"
+	StatusArchived  Status = "archived"
+)
+
+// transitions описывает допустимые переходы между статусами промокода
+var transitions = map[Status][]Status{
+	StatusDraft:     {StatusScheduled, StatusActive, StatusArchived},
+	StatusScheduled: {StatusActive, StatusPaused, StatusExpired, StatusArchived},
+	StatusActive:    {StatusPaused, StatusExhausted, StatusExpired, StatusArchived},
+	StatusPaused:    {StatusScheduled, StatusActive, StatusExpired, StatusArchived},
+	StatusExhausted: {StatusArchived},
+	StatusExpired:   {StatusArchived},
+	StatusArchived:  {},
+}
+
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// LiveStatus возвращает статус, в который переходит опубликованный промокод в момент now
+func LiveStatus(activeFrom time.Time, activeUntil time.Time, now time.Time) Status {
+	if !activeUntil.IsZero() && activeUntil.Before(now) {
+		return StatusExpired
+	}
+	if !activeFrom.IsZero() && activeFrom.After(now) {
+		return StatusScheduled
+	}
+	return StatusActive
+}
+
+// RescheduledStatus возвращает статус промокода после смены дат действия в момент now.
+// Пересчитываются только scheduled, active и expired; false означает, что новые даты
+// требуют недопустимого перехода
+func RescheduledStatus(current Status, activeFrom time.Time, activeUntil time.Time, now time.Time) (Status, bool) {
+	switch current {
+	case StatusScheduled, StatusActive, StatusExpired:
+	default:
+		return current, true
+	}
+
+	next := LiveStatus(activeFrom, activeUntil, now)
+	return next, next == current || current.CanTransitionTo(next)
+}
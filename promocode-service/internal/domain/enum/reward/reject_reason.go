@@ -0,0 +1,20 @@
+package reward
+
+// RejectReason объясняет, почему промокод не применим к корзине. Пустое значение означает, что скидка применима
+type RejectReason string
+
+const (
+	RejectReasonNone              RejectReason = ""
+	RejectReasonCodeNotFound      RejectReason = "code_not_found"
+	RejectReasonPromoNotActive    RejectReason = "promo_not_active"
+	RejectReasonNoActivationsLeft RejectReason = "no_activations_left"
+	RejectReasonNoReward          RejectReason = "no_reward"
+	RejectReasonCurrencyMismatch  RejectReason = "currency_mismatch"
+	RejectReasonMinOrderNotMet    RejectReason = "min_order_not_met"
+	RejectReasonNoEligibleItems   RejectReason = "no_eligible_items"
+	RejectReasonFreeItemNotInCart RejectReason = "free_item_not_in_cart"
+
+	RejectReasonDuplicateCode         RejectReason = "duplicate_code"
+	RejectReasonExclusiveConflict     RejectReason = "exclusive_conflict"
+	RejectReasonStackingGroupConflict RejectReason = "stacking_group_conflict"
+)
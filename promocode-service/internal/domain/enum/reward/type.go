@@ -0,0 +1,10 @@
+package reward
+
+type Type string
+
+const (
+	TypePercent      Type = "percent"
+	TypeFixedAmount  Type = "fixed_amount"
+	TypeFreeItem     Type = "free_item"
+	TypeFreeShipping Type = "free_shipping"
+)
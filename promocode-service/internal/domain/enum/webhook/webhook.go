@@ -0,0 +1,18 @@
+package webhook
+
+type Event string
+
+const (
+	EventPromoActivated Event = "promo.activated"
+	EventPromoExhausted Event = "promo.exhausted"
+	EventPromoExpired   Event = "promo.expired"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	// DeliveryStatusDead доставка исчерпала попытки и перенесена в dead-letter
+	DeliveryStatusDead DeliveryStatus = "dead"
+)
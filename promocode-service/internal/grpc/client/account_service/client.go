@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"gitlab.com/pisya-dev/account-service/pkg/api/account_service"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
 )
 
 // Client is a grpc Client for account service
@@ -30,3 +31,28 @@ func (c *Client) GetCompanyNameByCompanyID(ctx context.Context, companyID string
 	return resp.Name, nil
 
 }
+
+// ConvertReferral reports a promo activation so that the referee's invitation is converted
+// and the referrer is credited with the company's reward, if any
+func (c *Client) ConvertReferral(ctx context.Context, refereeId string, promoId string, companyId string, reward *referral.Reward) (bool, error) {
+
+	req := &account_service.ConvertReferralRequest{
+		RefereeId: refereeId,
+		PromoId:   promoId,
+		CompanyId: companyId,
+	}
+	if reward != nil {
+		req.Reward = &account_service.ReferrerReward{
+			Amount:   reward.Amount,
+			Currency: reward.Currency,
+		}
+	}
+
+	resp, err := c.Client.ConvertReferral(ctx, req)
+	if err != nil {
+		return false, fmt.Errorf("c.Client.ConvertReferral: %w", err)
+	}
+
+	return resp.Credited, nil
+
+}
@@ -0,0 +1,20 @@
+package account_service
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// serviceTokenHeader ключ metadata, из которого account-service читает сервисный токен
+const serviceTokenHeader = "x-service-token"
+
+// ServiceTokenInterceptor добавляет сервисный токен к каждому вызову account-service
+func ServiceTokenInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, serviceTokenHeader, token)
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,24 @@
+package account_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServiceTokenInterceptor(t *testing.T) {
+	interceptor := ServiceTokenInterceptor("secret")
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := interceptor(context.Background(), "/api.Account_Service/GetBuisness", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Equal(t, []string{"secret"}, md.Get(serviceTokenHeader))
+}
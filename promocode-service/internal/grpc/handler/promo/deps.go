@@ -3,16 +3,30 @@ package promo
 
 import (
 	"context"
+	"io"
 	"time"
 
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/userdata"
+	exportenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/export"
 	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/barcode"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/tabular"
 )
 
 type promoService interface {
 	Create(ctx context.Context, promoDto *promo.CreatePromoDTO) (id string, err error)
-	List(ctx context.Context, companyId string, countries []string, limit int, offset int, sortBy promoenum.SortBy) (promoDTOs []promo.DTO, err error)
-	Count(ctx context.Context, companyId string, countries []string) (count int, err error)
+	List(ctx context.Context, companyId string, countries []string, limit int, offset int, sortBy promoenum.SortBy, searchQuery string) (promoDTOs []promo.DTO, err error)
+	Count(ctx context.Context, companyId string, countries []string, searchQuery string) (count int, err error)
+	Feed(ctx context.Context, category string, limit int, offset int, searchQuery string) (promoDTOs []promo.DTO, err error)
+	FeedCount(ctx context.Context, category string, searchQuery string) (count int, err error)
 	GetById(ctx context.Context, promoId string, companyId string) (promoDTO *promo.DTO, err error)
 	Update(
 		ctx context.Context,
@@ -25,7 +39,26 @@ type promoService interface {
 		targetCountry string,
 		targetCategories []string,
 		activeFrom time.Time,
-		activeUntil time.Time) error
+		activeUntil time.Time,
+		reward *reward.DTO,
+		stacking *stacking.Settings,
+		activationLimits []limit.Policy,
+		referralReward *referral.Reward,
+		localization *localization.Localization) error
 	Delete(ctx context.Context, promoId string, companyId string) error
-	Activate(ctx context.Context, promoId string) (code string, err error)
+	Restore(ctx context.Context, promoId string, companyId string) error
+	SetImage(ctx context.Context, promoId string, companyId string, imageUrl string) error
+	RenderCode(ctx context.Context, promoId string, userId string, code string, opts barcode.Options) (activatedCode string, image []byte, contentType string, err error)
+	Export(ctx context.Context, promoId string, companyId string, dataset exportenum.Dataset, format tabular.Format, w io.Writer) error
+	Activate(ctx context.Context, promoId string, userId string) (code string, err error)
+	QuoteDiscount(ctx context.Context, code string, cart *cart.DTO) (quote *reward.Quote, err error)
+	ResolveApplicablePromos(ctx context.Context, codes []string) (applied []stacking.Candidate, rejected []stacking.Rejection, err error)
+	Publish(ctx context.Context, promoId string, companyId string) (promoenum.Status, error)
+	Pause(ctx context.Context, promoId string, companyId string) (promoenum.Status, error)
+	Resume(ctx context.Context, promoId string, companyId string) (promoenum.Status, error)
+	Archive(ctx context.Context, promoId string, companyId string) (promoenum.Status, error)
+	ListAuditLog(ctx context.Context, filter audit.Filter, limit int, offset int) (entries []audit.Entry, err error)
+	CountAuditLog(ctx context.Context, filter audit.Filter) (count int, err error)
+	ExportUserData(ctx context.Context, userId string) ([]userdata.Activation, error)
+	ForgetUser(ctx context.Context, userId string) (*userdata.Erasure, error)
 }
@@ -1,23 +1,41 @@
 package promo
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	adaptergrpc "gitlab.com/pisya-dev/promo-code-service/internal/adapter/grpc"
+	auditdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
 	promodto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/userdata"
 	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/functional"
 	promoservice "gitlab.com/pisya-dev/promo-code-service/internal/service/promo"
 	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/barcode"
 	"gitlab.com/pisya-dev/promo-code-service/pkg/pointer"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/tabular"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	defaultAuditLogLimit = 20
+	maxResolveCodes      = 20
+	exportChunkSize      = 64 << 10
+)
+
 type Handler struct {
 	promoService promoService
 }
@@ -43,9 +61,17 @@ func (h *Handler) CreatePromo(ctx context.Context, r *promopb.CreatePromoRequest
 			Country:    r.Target.GetCountry(),
 			Categories: r.Target.GetCategories(),
 		},
-		MaxCount:    r.GetMaxCount(),
-		ActiveFrom:  r.GetActiveFrom().AsTime(),
-		ActiveUntil: r.GetActiveUntil().AsTime(),
+		MaxCount:         r.GetMaxCount(),
+		ActiveFrom:       r.GetActiveFrom().AsTime(),
+		ActiveUntil:      r.GetActiveUntil().AsTime(),
+		Draft:            r.GetDraft(),
+		Reward:           adaptergrpc.MapPbRewardToDomain(r.GetReward()),
+		ActivationLimits: adaptergrpc.MapPbActivationLimitsToDomain(r.GetActivationLimits()),
+		ReferralReward:   adaptergrpc.MapPbReferralRewardToDomain(r.GetReferralReward()),
+		Localization:     adaptergrpc.MapPbLocalizationToDomain(r.GetLocalization()),
+	}
+	if stackingSettings := adaptergrpc.MapPbStackingToDomain(r.GetStacking()); stackingSettings != nil {
+		dto.Stacking = *stackingSettings
 	}
 	promoId, err := h.promoService.Create(ctx, dto)
 	if err != nil {
@@ -65,12 +91,76 @@ func (h *Handler) CreatePromo(ctx context.Context, r *promopb.CreatePromoRequest
 }
 
 func (h *Handler) ListPromo(ctx context.Context, r *promopb.ListPromoRequest) (*promopb.ListPromoResponse, error) {
-	promoDTOs, err := h.promoService.List(ctx, ctx.Value("company_id").(string), r.GetCountries(), int(r.GetLimit()), int(r.GetOffset()), adaptergrpc.MapPbSortByToDomain(r.GetSortBy()))
+	promoDTOs, err := h.promoService.List(ctx, ctx.Value("company_id").(string), r.GetCountries(), int(r.GetLimit()), int(r.GetOffset()), adaptergrpc.MapPbSortByToDomain(r.GetSortBy()), r.GetQuery())
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	promosGRPC, err := mapPromoDTOsToPb(promoDTOs, preferredLocales(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	promosCount, err := h.promoService.Count(ctx, ctx.Value("company_id").(string), r.GetCountries(), r.GetQuery())
+	if err != nil {
+		log.Println(err)
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &promopb.ListPromoResponse{
+		XTotalCount: int64(promosCount),
+		Promo:       promosGRPC,
+	}
+
+	return resp, nil
+}
+
+func (h *Handler) ListPromoFeed(ctx context.Context, r *promopb.ListPromoFeedRequest) (*promopb.ListPromoResponse, error) {
+	promoDTOs, err := h.promoService.Feed(ctx, r.GetCategory(), int(r.GetLimit()), int(r.GetOffset()), r.GetQuery())
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	promosGRPC, err := mapPromoDTOsToPb(promoDTOs, preferredLocales(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	promosCount, err := h.promoService.FeedCount(ctx, r.GetCategory(), r.GetQuery())
 	if err != nil {
 		log.Println(err)
+
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
+	resp := &promopb.ListPromoResponse{
+		XTotalCount: int64(promosCount),
+		Promo:       promosGRPC,
+	}
+
+	return resp, nil
+}
+
+// preferredLocales возвращает языки пользователя из метаданных accept-language. Шлюз grpc-gateway
+// передаёт одноимённый HTTP-заголовок с префиксом grpcgateway-
+func preferredLocales(ctx context.Context) []language.Tag {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.Get("accept-language")
+	if len(values) == 0 {
+		values = md.Get("grpcgateway-accept-language")
+	}
+
+	return localization.ParsePreferred(values)
+}
+
+func mapPromoDTOsToPb(promoDTOs []promodto.DTO, preferred []language.Tag) ([]*promopb.Promo, error) {
 	promosGRPC := make([]*promopb.Promo, len(promoDTOs))
 
 	for idx, promoDTO := range promoDTOs {
@@ -97,33 +187,37 @@ func (h *Handler) ListPromo(ctx context.Context, r *promopb.ListPromoRequest) (*
 					MaxCount:    code.MaxCount,
 				}
 			}),
-			Description: promoDTO.Description,
-			ImageUrl:    pointer.To(promoDTO.ImageURL),
+			ImageUrl: pointer.To(promoDTO.ImageURL),
 			Target: &promopb.Target{
 				AgeFrom:    pointer.ToInt64(promoDTO.Target.AgeFrom),
 				AgeUntil:   pointer.To(promoDTO.Target.AgeUntil),
 				Country:    pointer.To(promoDTO.Target.Country),
 				Categories: promoDTO.Target.Categories,
 			},
-			ActiveFrom:  timestamppb.New(promoDTO.ActiveFrom),
-			ActiveUntil: timestamppb.New(promoDTO.ActiveUntil),
+			ActiveFrom:       timestamppb.New(promoDTO.ActiveFrom),
+			ActiveUntil:      timestamppb.New(promoDTO.ActiveUntil),
+			Status:           adaptergrpc.MapDomainStatusToPb(promoDTO.Status),
+			Active:           promoDTO.Active,
+			Reward:           adaptergrpc.MapDomainRewardToPb(promoDTO.Reward),
+			Stacking:         adaptergrpc.MapDomainStackingToPb(promoDTO.Stacking),
+			ActivationLimits: adaptergrpc.MapDomainActivationLimitsToPb(promoDTO.ActivationLimits),
+			ReferralReward:   adaptergrpc.MapDomainReferralRewardToPb(promoDTO.ReferralReward),
+			Localization:     adaptergrpc.MapDomainLocalizationToPb(promoDTO.Localization),
 		}
 
-	}
-
-	promosCount, err := h.promoService.Count(ctx, ctx.Value("company_id").(string), r.GetCountries())
-	if err != nil {
-		log.Println(err)
-
-		return nil, status.Error(codes.Internal, "internal server error")
-	}
+		description, locale := promoDTO.Localization.Negotiate(promoDTO.Description, preferred)
+		promosGRPC[idx].Description = description
+		if locale != "" {
+			promosGRPC[idx].Locale = pointer.To(locale)
+		}
 
-	resp := &promopb.ListPromoResponse{
-		XTotalCount: int64(promosCount),
-		Promo:       promosGRPC,
+		if promoDTO.Highlight != "" {
+			promosGRPC[idx].Highlight = pointer.To(promoDTO.Highlight)
+			promosGRPC[idx].SearchRank = pointer.To(promoDTO.SearchRank)
+		}
 	}
 
-	return resp, nil
+	return promosGRPC, nil
 }
 
 func (h *Handler) GetById(ctx context.Context, r *promopb.GetPromoRequest) (*promopb.GetPromoResponse, error) {
@@ -166,16 +260,28 @@ func (h *Handler) GetById(ctx context.Context, r *promopb.GetPromoRequest) (*pro
 				MaxCount:    code.MaxCount,
 			}
 		}),
-		Description: promoDTO.Description,
-		ImageUrl:    pointer.To(promoDTO.ImageURL),
+		ImageUrl: pointer.To(promoDTO.ImageURL),
 		Target: &promopb.Target{
 			AgeFrom:    pointer.ToInt64(promoDTO.Target.AgeFrom),
 			AgeUntil:   pointer.To(promoDTO.Target.AgeUntil),
 			Country:    pointer.To(promoDTO.Target.Country),
 			Categories: promoDTO.Target.Categories,
 		},
-		ActiveFrom:  timestamppb.New(promoDTO.ActiveFrom),
-		ActiveUntil: timestamppb.New(promoDTO.ActiveUntil),
+		ActiveFrom:       timestamppb.New(promoDTO.ActiveFrom),
+		ActiveUntil:      timestamppb.New(promoDTO.ActiveUntil),
+		Status:           adaptergrpc.MapDomainStatusToPb(promoDTO.Status),
+		Active:           promoDTO.Active,
+		Reward:           adaptergrpc.MapDomainRewardToPb(promoDTO.Reward),
+		Stacking:         adaptergrpc.MapDomainStackingToPb(promoDTO.Stacking),
+		ActivationLimits: adaptergrpc.MapDomainActivationLimitsToPb(promoDTO.ActivationLimits),
+		ReferralReward:   adaptergrpc.MapDomainReferralRewardToPb(promoDTO.ReferralReward),
+		Localization:     adaptergrpc.MapDomainLocalizationToPb(promoDTO.Localization),
+	}
+
+	description, locale := promoDTO.Localization.Negotiate(promoDTO.Description, preferredLocales(ctx))
+	promoGRPC.Description = description
+	if locale != "" {
+		promoGRPC.Locale = pointer.To(locale)
 	}
 
 	return &promopb.GetPromoResponse{Promo: promoGRPC}, nil
@@ -183,6 +289,11 @@ func (h *Handler) GetById(ctx context.Context, r *promopb.GetPromoRequest) (*pro
 }
 
 func (h *Handler) Update(ctx context.Context, r *promopb.UpdatePromoRequest) (*promopb.UpdatePromoResponse, error) {
+	var activationLimits []limit.Policy
+	if r.ActivationLimits != nil {
+		activationLimits = adaptergrpc.MapPbActivationLimitsToDomain(r.GetActivationLimits().GetLimits())
+	}
+
 	err := h.promoService.Update(
 		ctx,
 		r.GetPromoId(),
@@ -195,6 +306,11 @@ func (h *Handler) Update(ctx context.Context, r *promopb.UpdatePromoRequest) (*p
 		r.Target.GetCategories(),
 		r.GetActiveFrom().AsTime(),
 		r.GetActiveUntil().AsTime(),
+		adaptergrpc.MapPbRewardToDomain(r.GetReward()),
+		adaptergrpc.MapPbStackingToDomain(r.GetStacking()),
+		activationLimits,
+		adaptergrpc.MapPbReferralRewardToDomain(r.GetReferralReward()),
+		adaptergrpc.MapPbLocalizationToDomain(r.GetLocalization()),
 	)
 	if err != nil {
 		log.Println(err)
@@ -208,6 +324,9 @@ func (h *Handler) Update(ctx context.Context, r *promopb.UpdatePromoRequest) (*p
 		if errors.As(err, &domainerrors.ValidationError{}) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, promoservice.ErrInvalidTransition) {
+			return nil, status.Error(codes.FailedPrecondition, "active dates require invalid status transition")
+		}
 
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
@@ -234,12 +353,153 @@ func (h *Handler) Delete(ctx context.Context, r *promopb.DeletePromoRequest) (*p
 	return &promopb.DeletePromoResponse{}, nil
 }
 
+func (h *Handler) Restore(ctx context.Context, r *promopb.RestorePromoRequest) (*promopb.RestorePromoResponse, error) {
+
+	err := h.promoService.Restore(ctx, r.GetPromoId(), ctx.Value("company_id").(string))
+	if err != nil {
+		log.Println(err)
+
+		if errors.Is(err, promoservice.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "promo not found")
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.RestorePromoResponse{}, nil
+}
+
+func (h *Handler) RenderCode(ctx context.Context, r *promopb.RenderPromoCodeRequest) (*promopb.RenderPromoCodeResponse, error) {
+
+	code, image, contentType, err := h.promoService.RenderCode(ctx, r.GetPromoId(), r.GetUserId(), r.GetCode(), adaptergrpc.MapPbRenderOptions(r))
+	if err != nil {
+		log.Println(err)
+
+		if errors.Is(err, promoservice.ErrUserRequired) {
+			return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		}
+		if errors.Is(err, promoservice.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "activated code not found")
+		}
+		if errors.Is(err, barcode.ErrInvalidOptions) || errors.Is(err, barcode.ErrUnsupportedContent) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.RenderPromoCodeResponse{
+		Code:        code,
+		ContentType: contentType,
+		Image:       image,
+	}, nil
+}
+
+func (h *Handler) SetImage(ctx context.Context, r *promopb.SetPromoImageRequest) (*promopb.SetPromoImageResponse, error) {
+
+	err := h.promoService.SetImage(ctx, r.GetPromoId(), ctx.Value("company_id").(string), r.GetImageUrl())
+	if err != nil {
+		log.Println(err)
+
+		if errors.Is(err, promoservice.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+		if errors.Is(err, promoservice.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "promo not found")
+		}
+		if errors.As(err, &domainerrors.ValidationError{}) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.SetPromoImageResponse{}, nil
+}
+
+// Export отправляет выгрузку чанками до exportChunkSize байт. Первый чанк содержит content_type и filename
+func (h *Handler) Export(r *promopb.ExportPromoDataRequest, stream grpc.ServerStreamingServer[promopb.ExportPromoDataChunk]) error {
+	ctx := stream.Context()
+
+	dataset := adaptergrpc.MapPbExportDataset(r.GetDataset())
+	format := adaptergrpc.MapPbExportFormat(r.GetFormat())
+
+	cw := &chunkWriter{
+		stream: stream,
+		header: &promopb.ExportPromoDataChunk{
+			ContentType: pointer.To(format.ContentType()),
+			Filename:    pointer.To(fmt.Sprintf("promo-%s-%s.%s", r.GetPromoId(), dataset, format.Extension())),
+		},
+	}
+	bw := bufio.NewWriterSize(cw, exportChunkSize)
+
+	err := h.promoService.Export(ctx, r.GetPromoId(), ctx.Value("company_id").(string), dataset, format, bw)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil && cw.header != nil {
+		err = stream.Send(cw.header)
+	}
+	if err != nil {
+		log.Println(err)
+
+		if errors.Is(err, promoservice.ErrPermissionDenied) {
+			return status.Error(codes.PermissionDenied, "permission denied")
+		}
+		if errors.Is(err, promoservice.ErrNotFound) {
+			return status.Error(codes.NotFound, "promo not found")
+		}
+		if errors.Is(err, tabular.ErrUnsupportedFormat) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	return nil
+}
+
+// chunkWriter отправляет данные чанками не больше exportChunkSize, добавляя header к первому из них
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[promopb.ExportPromoDataChunk]
+	header *promopb.ExportPromoDataChunk
+}
+
+func (w *chunkWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		size := min(len(p), exportChunkSize)
+
+		chunk := &promopb.ExportPromoDataChunk{Data: p[:size]}
+		if w.header != nil {
+			chunk.ContentType = w.header.ContentType
+			chunk.Filename = w.header.Filename
+			w.header = nil
+		}
+
+		if err = w.stream.Send(chunk); err != nil {
+			return n, err
+		}
+
+		n += size
+		p = p[size:]
+	}
+
+	return n, nil
+}
+
 func (h *Handler) Activate(ctx context.Context, r *promopb.ActivatePromoRequest) (*promopb.ActivatePromoResponse, error) {
 
-	code, err := h.promoService.Activate(ctx, r.GetPromoId())
+	code, err := h.promoService.Activate(ctx, r.GetPromoId(), r.GetUserId())
 	if err != nil {
 		log.Println(err)
 
+		var exceeded *limit.ExceededError
+		if errors.As(err, &exceeded) {
+			return nil, status.Error(codes.ResourceExhausted, exceeded.Error())
+		}
+		if errors.Is(err, promoservice.ErrUserRequired) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		if errors.Is(err, promoservice.ErrFraudDetected) {
 			return &promopb.ActivatePromoResponse{
 				SuccessActivation: false,
@@ -258,6 +518,12 @@ func (h *Handler) Activate(ctx context.Context, r *promopb.ActivatePromoRequest)
 				Reason:            promopb.Reason_NO_ACTIVATIONS_LEFT,
 			}, nil
 		}
+		if errors.Is(err, promoservice.ErrPromoNotActive) {
+			return &promopb.ActivatePromoResponse{
+				SuccessActivation: false,
+				Reason:            promopb.Reason_PROMO_NOT_ACTIVE,
+			}, nil
+		}
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
@@ -268,3 +534,210 @@ func (h *Handler) Activate(ctx context.Context, r *promopb.ActivatePromoRequest)
 	}, nil
 
 }
+
+func (h *Handler) QuoteDiscount(ctx context.Context, r *promopb.QuoteDiscountRequest) (*promopb.QuoteDiscountResponse, error) {
+	quote, err := h.promoService.QuoteDiscount(ctx, r.GetCode(), adaptergrpc.MapPbCartToDomain(r.GetCart()))
+	if err != nil {
+		log.Println(err)
+
+		if errors.As(err, &domainerrors.ValidationError{}) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &promopb.QuoteDiscountResponse{
+		Applicable:     quote.Applicable(),
+		DiscountAmount: quote.Discount,
+		Currency:       quote.Currency,
+		RejectReason:   adaptergrpc.MapDomainRejectReasonToPb(quote.RejectReason),
+	}
+	if quote.PromoId != "" {
+		resp.PromoId = pointer.To(quote.PromoId)
+	}
+	if quote.RewardType != "" {
+		resp.RewardType = adaptergrpc.MapDomainRewardTypeToPb(quote.RewardType).Enum()
+	}
+
+	return resp, nil
+}
+
+func (h *Handler) ResolveApplicablePromos(ctx context.Context, r *promopb.ResolveApplicablePromosRequest) (*promopb.ResolveApplicablePromosResponse, error) {
+	if len(r.GetCodes()) == 0 || len(r.GetCodes()) > maxResolveCodes {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("codes: expected from 1 to %d codes", maxResolveCodes))
+	}
+
+	applied, rejected, err := h.promoService.ResolveApplicablePromos(ctx, r.GetCodes())
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.ResolveApplicablePromosResponse{
+		Applied: functional.Map(applied, func(candidate stacking.Candidate) *promopb.AppliedPromo {
+			return &promopb.AppliedPromo{
+				Code:      candidate.Code,
+				PromoId:   candidate.PromoId,
+				CompanyId: candidate.CompanyId,
+				Stacking:  adaptergrpc.MapDomainStackingToPb(candidate.Settings),
+			}
+		}),
+		Rejected: functional.Map(rejected, func(rejection stacking.Rejection) *promopb.RejectedPromoCode {
+			rejectedCode := &promopb.RejectedPromoCode{
+				Code:        rejection.Code,
+				Reason:      adaptergrpc.MapDomainRejectReasonToPb(rejection.Reason),
+				Explanation: rejection.Explanation(),
+			}
+			if rejection.PromoId != "" {
+				rejectedCode.PromoId = pointer.To(rejection.PromoId)
+			}
+			if rejection.ConflictingPromoId != "" {
+				rejectedCode.ConflictingPromoId = pointer.To(rejection.ConflictingPromoId)
+			}
+			return rejectedCode
+		}),
+	}, nil
+}
+
+func (h *Handler) Publish(ctx context.Context, r *promopb.PublishPromoRequest) (*promopb.PublishPromoResponse, error) {
+	promoStatus, err := h.promoService.Publish(ctx, r.GetPromoId(), ctx.Value("company_id").(string))
+	if err != nil {
+		return nil, mapTransitionError(err)
+	}
+
+	return &promopb.PublishPromoResponse{Status: adaptergrpc.MapDomainStatusToPb(promoStatus)}, nil
+}
+
+func (h *Handler) Pause(ctx context.Context, r *promopb.PausePromoRequest) (*promopb.PausePromoResponse, error) {
+	promoStatus, err := h.promoService.Pause(ctx, r.GetPromoId(), ctx.Value("company_id").(string))
+	if err != nil {
+		return nil, mapTransitionError(err)
+	}
+
+	return &promopb.PausePromoResponse{Status: adaptergrpc.MapDomainStatusToPb(promoStatus)}, nil
+}
+
+func (h *Handler) Resume(ctx context.Context, r *promopb.ResumePromoRequest) (*promopb.ResumePromoResponse, error) {
+	promoStatus, err := h.promoService.Resume(ctx, r.GetPromoId(), ctx.Value("company_id").(string))
+	if err != nil {
+		return nil, mapTransitionError(err)
+	}
+
+	return &promopb.ResumePromoResponse{Status: adaptergrpc.MapDomainStatusToPb(promoStatus)}, nil
+}
+
+func (h *Handler) Archive(ctx context.Context, r *promopb.ArchivePromoRequest) (*promopb.ArchivePromoResponse, error) {
+	promoStatus, err := h.promoService.Archive(ctx, r.GetPromoId(), ctx.Value("company_id").(string))
+	if err != nil {
+		return nil, mapTransitionError(err)
+	}
+
+	return &promopb.ArchivePromoResponse{Status: adaptergrpc.MapDomainStatusToPb(promoStatus)}, nil
+}
+
+func (h *Handler) ListPromoAuditLog(ctx context.Context, r *promopb.ListPromoAuditLogRequest) (*promopb.ListPromoAuditLogResponse, error) {
+	filter := auditdto.Filter{
+		PromoId:   r.GetPromoId(),
+		CompanyId: ctx.Value("company_id").(string),
+	}
+	if r.Operation != nil {
+		filter.Operation = adaptergrpc.MapPbAuditOperationToDomain(r.GetOperation())
+		if filter.Operation == "" {
+			return nil, status.Error(codes.InvalidArgument, "unknown audit operation")
+		}
+	}
+	if r.From != nil {
+		filter.From = r.GetFrom().AsTime()
+	}
+	if r.Until != nil {
+		filter.Until = r.GetUntil().AsTime()
+	}
+
+	limit := int(r.GetLimit())
+	if r.Limit == nil {
+		limit = defaultAuditLogLimit
+	}
+
+	entries, err := h.promoService.ListAuditLog(ctx, filter, limit, int(r.GetOffset()))
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	entriesCount, err := h.promoService.CountAuditLog(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.ListPromoAuditLogResponse{
+		XTotalCount: int64(entriesCount),
+		Entries: functional.Map(entries, func(entry auditdto.Entry) *promopb.AuditLogEntry {
+			return &promopb.AuditLogEntry{
+				Id:          entry.Id,
+				PromoId:     entry.PromoId,
+				ActorId:     entry.ActorId,
+				ActorUserId: entry.ActorUserId,
+				Operation:   adaptergrpc.MapDomainAuditOperationToPb(entry.Operation),
+				Changes:     string(entry.Changes),
+				CreatedAt:   timestamppb.New(entry.CreatedAt),
+			}
+		}),
+	}, nil
+}
+
+func (h *Handler) ExportUserData(ctx context.Context, r *promopb.ExportUserDataRequest) (*promopb.ExportUserDataResponse, error) {
+	if r.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	activations, err := h.promoService.ExportUserData(ctx, r.GetUserId())
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.ExportUserDataResponse{
+		Activations: functional.Map(activations, func(activation userdata.Activation) *promopb.UserActivation {
+			return &promopb.UserActivation{
+				PromoId:     activation.PromoId,
+				CompanyId:   activation.CompanyId,
+				Code:        activation.Code,
+				Description: activation.Description,
+				ActivatedAt: timestamppb.New(activation.ActivatedAt),
+			}
+		}),
+	}, nil
+}
+
+func (h *Handler) ForgetUser(ctx context.Context, r *promopb.ForgetUserRequest) (*promopb.ForgetUserResponse, error) {
+	if r.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	erasure, err := h.promoService.ForgetUser(ctx, r.GetUserId())
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.ForgetUserResponse{
+		AnonymizedActivations:     erasure.AnonymizedActivations,
+		ScrubbedWebhookDeliveries: int64(erasure.ScrubbedWebhookDeliveries),
+	}, nil
+}
+
+func mapTransitionError(err error) error {
+	log.Println(err)
+
+	if errors.Is(err, promoservice.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if errors.Is(err, promoservice.ErrNotFound) {
+		return status.Error(codes.NotFound, "promo not found")
+	}
+	if errors.Is(err, promoservice.ErrInvalidTransition) {
+		return status.Error(codes.FailedPrecondition, "invalid status transition")
+	}
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -1,21 +1,38 @@
 package promo
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	adaptergrpc "gitlab.com/pisya-dev/promo-code-service/internal/adapter/grpc"
+	auditdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
+	auditenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/audit"
+	exportenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/export"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
+	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
 	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 	promoservice "gitlab.com/pisya-dev/promo-code-service/internal/service/promo"
 	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
 	"gitlab.com/pisya-dev/promo-code-service/pkg/pointer"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/tabular"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -48,6 +65,9 @@ func TestHandler_CreatePromo(t *testing.T) {
 		MaxCount:    5,
 		ActiveFrom:  timestamppb.New(activeFrom),
 		ActiveUntil: timestamppb.New(activeUntil),
+		ActivationLimits: []*promopb.ActivationLimit{
+			{Scope: promopb.LimitScope_LIMIT_PER_USER, Window: promopb.LimitWindow_WINDOW_LIFETIME, Max: 1},
+		},
 	}
 
 	tests := []struct {
@@ -82,6 +102,9 @@ func TestHandler_CreatePromo(t *testing.T) {
 					MaxCount:    rq.GetMaxCount(),
 					ActiveFrom:  rqActiveFrom,
 					ActiveUntil: rqActiveUntil,
+					ActivationLimits: []limit.Policy{
+						{Scope: limitenum.ScopeUser, Window: limitenum.WindowLifetime, Max: 1},
+					},
 				}
 
 				f.promoService.EXPECT().Create(gomock.Any(), dto).Return(wantCreatedId, nil)
@@ -132,6 +155,7 @@ func TestHandler_Activate(t *testing.T) {
 	promoId := "testPromo123"
 	companyId := "company123"
 	testCode := "TESTCODE123"
+	userId := "user123"
 
 	tests := []struct {
 		name        string
@@ -144,7 +168,7 @@ func TestHandler_Activate(t *testing.T) {
 			name: "successful activation",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					Activate(gomock.Any(), promoId).
+					Activate(gomock.Any(), promoId, userId).
 					Return(testCode, nil)
 			},
 			want: &promopb.ActivatePromoResponse{
@@ -158,7 +182,7 @@ func TestHandler_Activate(t *testing.T) {
 			name: "fraud detected",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					Activate(gomock.Any(), promoId).
+					Activate(gomock.Any(), promoId, userId).
 					Return("", promoservice.ErrFraudDetected)
 			},
 			want: &promopb.ActivatePromoResponse{
@@ -171,7 +195,7 @@ func TestHandler_Activate(t *testing.T) {
 			name: "no activations left",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					Activate(gomock.Any(), promoId).
+					Activate(gomock.Any(), promoId, userId).
 					Return("", promoservice.ErrNoActivations)
 			},
 			want: &promopb.ActivatePromoResponse{
@@ -180,11 +204,49 @@ func TestHandler_Activate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "promo not active",
+			prepare: func(f *fields) {
+				f.promoService.EXPECT().
+					Activate(gomock.Any(), promoId, userId).
+					Return("", promoservice.ErrPromoNotActive)
+			},
+			want: &promopb.ActivatePromoResponse{
+				SuccessActivation: false,
+				Reason:            promopb.Reason_PROMO_NOT_ACTIVE,
+			},
+			wantErr: false,
+		},
+		{
+			name: "activation limit exceeded",
+			prepare: func(f *fields) {
+				f.promoService.EXPECT().
+					Activate(gomock.Any(), promoId, userId).
+					Return("", &limit.ExceededError{
+						Policy:  limit.Policy{Scope: limitenum.ScopeUser, Window: limitenum.WindowMonth, Max: 3},
+						ResetAt: time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC),
+					})
+			},
+			want:        nil,
+			wantErr:     true,
+			wantErrCode: codes.ResourceExhausted,
+		},
+		{
+			name: "user required for per-user limit",
+			prepare: func(f *fields) {
+				f.promoService.EXPECT().
+					Activate(gomock.Any(), promoId, userId).
+					Return("", promoservice.ErrUserRequired)
+			},
+			want:        nil,
+			wantErr:     true,
+			wantErrCode: codes.InvalidArgument,
+		},
 		{
 			name: "permission denied",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					Activate(gomock.Any(), promoId).
+					Activate(gomock.Any(), promoId, userId).
 					Return("", promoservice.ErrPermissionDenied)
 			},
 			want:        nil,
@@ -195,7 +257,7 @@ func TestHandler_Activate(t *testing.T) {
 			name: "promo not found",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					Activate(gomock.Any(), promoId).
+					Activate(gomock.Any(), promoId, userId).
 					Return("", promoservice.ErrNotFound)
 			},
 			want:        nil,
@@ -206,7 +268,7 @@ func TestHandler_Activate(t *testing.T) {
 			name: "internal error",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					Activate(gomock.Any(), promoId).
+					Activate(gomock.Any(), promoId, userId).
 					Return("", errors.New("some internal error"))
 			},
 			want:        nil,
@@ -230,7 +292,7 @@ func TestHandler_Activate(t *testing.T) {
 			}
 
 			ctx := context.WithValue(context.Background(), "company_id", companyId)
-			got, err := h.Activate(ctx, &promopb.ActivatePromoRequest{PromoId: promoId})
+			got, err := h.Activate(ctx, &promopb.ActivatePromoRequest{PromoId: promoId, UserId: &userId})
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -415,10 +477,10 @@ func TestHandler_ListPromo(t *testing.T) {
 			name: "success",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy)).
+					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy), "").
 					Return(promoDTOs, nil)
 				f.promoService.EXPECT().
-					Count(gomock.Any(), companyId, countries).
+					Count(gomock.Any(), companyId, countries, "").
 					Return(len(promoDTOs), nil)
 			},
 			want: &promopb.ListPromoResponse{
@@ -478,10 +540,10 @@ func TestHandler_ListPromo(t *testing.T) {
 			name: "empty list",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy)).
+					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy), "").
 					Return([]promo.DTO{}, nil)
 				f.promoService.EXPECT().
-					Count(gomock.Any(), companyId, countries).
+					Count(gomock.Any(), companyId, countries, "").
 					Return(0, nil)
 			},
 			want: &promopb.ListPromoResponse{
@@ -494,7 +556,7 @@ func TestHandler_ListPromo(t *testing.T) {
 			name: "list error",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy)).
+					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy), "").
 					Return(nil, errors.New("some error"))
 			},
 			want:    nil,
@@ -504,10 +566,10 @@ func TestHandler_ListPromo(t *testing.T) {
 			name: "count error",
 			prepare: func(f *fields) {
 				f.promoService.EXPECT().
-					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy)).
+					List(gomock.Any(), companyId, countries, int(limit), int(offset), adaptergrpc.MapPbSortByToDomain(sortBy), "").
 					Return(promoDTOs, nil)
 				f.promoService.EXPECT().
-					Count(gomock.Any(), companyId, countries).
+					Count(gomock.Any(), companyId, countries, "").
 					Return(0, errors.New("some error"))
 			},
 			want:    nil,
@@ -548,6 +610,75 @@ func TestHandler_ListPromo(t *testing.T) {
 	}
 }
 
+func TestHandler_ListPromoFeed(t *testing.T) {
+	promoDTOs := []promo.DTO{
+		{
+			PromoId:     "promo1",
+			CompanyId:   "someCompanyId",
+			CompanyName: "Company1",
+			Mode:        promo.COMMON,
+			Description: "Скидка на кошачий корм",
+			Target:      &target.DTO{Categories: []string{"коты"}},
+			Highlight:   "Скидка на <b>кошачий</b> корм",
+			SearchRank:  0.5,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(f *MockpromoService)
+		wantErr bool
+	}{
+		{
+			name: "success",
+			prepare: func(f *MockpromoService) {
+				f.EXPECT().Feed(gomock.Any(), "коты", 10, 0, "кошачий").Return(promoDTOs, nil)
+				f.EXPECT().FeedCount(gomock.Any(), "коты", "кошачий").Return(len(promoDTOs), nil)
+			},
+		},
+		{
+			name: "feed error",
+			prepare: func(f *MockpromoService) {
+				f.EXPECT().Feed(gomock.Any(), "коты", 10, 0, "кошачий").Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			promoService := NewMockpromoService(ctrl)
+			tt.prepare(promoService)
+
+			h := &Handler{
+				promoService: promoService,
+			}
+
+			resp, err := h.ListPromoFeed(context.Background(), &promopb.ListPromoFeedRequest{
+				Limit:    pointer.ToInt64(10),
+				Offset:   pointer.ToInt64(0),
+				Category: pointer.To("коты"),
+				Query:    pointer.To("кошачий"),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListPromoFeed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			require.Len(t, resp.GetPromo(), 1)
+			require.Equal(t, int64(1), resp.GetXTotalCount())
+			require.Equal(t, "Скидка на <b>кошачий</b> корм", resp.GetPromo()[0].GetHighlight())
+			require.Equal(t, 0.5, resp.GetPromo()[0].GetSearchRank())
+		})
+	}
+}
+
 func TestHandler_Delete(t *testing.T) {
 	type fields struct {
 		promoService *MockpromoService
@@ -670,6 +801,11 @@ func TestHandler_Update(t *testing.T) {
 					targetCategories,
 					activeFrom,
 					activeUntil,
+					&reward.DTO{Type: rewardenum.TypeFreeShipping, Conditions: reward.Conditions{MinOrderAmount: 300000}, Currency: "RUB"},
+					(*stacking.Settings)(nil),
+					([]limit.Policy)(nil),
+					&referral.Reward{Amount: 50000, Currency: "RUB"},
+					(*localization.Localization)(nil),
 				).Return(nil)
 			},
 			wantErr: false,
@@ -677,7 +813,7 @@ func TestHandler_Update(t *testing.T) {
 		{
 			name: "permission denied",
 			prepare: func(f *fields) {
-				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(promoservice.ErrPermissionDenied)
 			},
 			wantErr:     true,
@@ -686,7 +822,7 @@ func TestHandler_Update(t *testing.T) {
 		{
 			name: "not found",
 			prepare: func(f *fields) {
-				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(promoservice.ErrNotFound)
 			},
 			wantErr:     true,
@@ -695,7 +831,7 @@ func TestHandler_Update(t *testing.T) {
 		{
 			name: "validation error",
 			prepare: func(f *fields) {
-				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(domainerrors.ValidationError{
 						Field:   "field",
 						Message: "invalid",
@@ -707,7 +843,7 @@ func TestHandler_Update(t *testing.T) {
 		{
 			name: "internal error",
 			prepare: func(f *fields) {
-				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				f.promoService.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("internal error"))
 			},
 			wantErr:     true,
@@ -740,6 +876,12 @@ func TestHandler_Update(t *testing.T) {
 				},
 				ActiveFrom:  timestamppb.New(activeFrom),
 				ActiveUntil: timestamppb.New(activeUntil),
+				Reward: &promopb.Reward{
+					Type:       promopb.RewardType_FREE_SHIPPING,
+					Currency:   pointer.To("RUB"),
+					Conditions: &promopb.RewardConditions{MinOrderAmount: pointer.ToInt64(300000)},
+				},
+				ReferralReward: &promopb.ReferralReward{Amount: 50000, Currency: "RUB"},
 			}
 
 			_, err := h.Update(ctx, req)
@@ -755,3 +897,482 @@ func TestHandler_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_Publish(t *testing.T) {
+	promoId := "testPromo123"
+	companyId := "company123"
+
+	tests := []struct {
+		name        string
+		prepare     func(f *MockpromoServiceMockRecorder)
+		want        *promopb.PublishPromoResponse
+		wantErr     bool
+		wantErrCode codes.Code
+	}{
+		{
+			name: "success",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.Publish(gomock.Any(), promoId, companyId).Return(promoenum.StatusScheduled, nil)
+			},
+			want: &promopb.PublishPromoResponse{Status: promopb.PromoStatus_SCHEDULED},
+		},
+		{
+			name: "invalid transition",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.Publish(gomock.Any(), promoId, companyId).Return(promoenum.Status(""), promoservice.ErrInvalidTransition)
+			},
+			wantErr:     true,
+			wantErrCode: codes.FailedPrecondition,
+		},
+		{
+			name: "permission denied",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.Publish(gomock.Any(), promoId, companyId).Return(promoenum.Status(""), promoservice.ErrPermissionDenied)
+			},
+			wantErr:     true,
+			wantErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "not found",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.Publish(gomock.Any(), promoId, companyId).Return(promoenum.Status(""), promoservice.ErrNotFound)
+			},
+			wantErr:     true,
+			wantErrCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			promoService := NewMockpromoService(ctrl)
+			tt.prepare(promoService.EXPECT())
+
+			h := &Handler{
+				promoService: promoService,
+			}
+
+			ctx := context.WithValue(context.Background(), "company_id", companyId)
+			got, err := h.Publish(ctx, &promopb.PublishPromoRequest{PromoId: promoId})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.wantErrCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandler_Restore(t *testing.T) {
+	companyId := "testCompany"
+	promoId := "testPromo"
+
+	tests := []struct {
+		name      string
+		prepare   func(f *MockpromoServiceMockRecorder)
+		wantError codes.Code
+	}{
+		{
+			name: "successful restore",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.Restore(gomock.Any(), promoId, companyId).Return(nil)
+			},
+			wantError: codes.OK,
+		},
+		{
+			name: "not found or retention expired",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.Restore(gomock.Any(), promoId, companyId).Return(promoservice.ErrNotFound)
+			},
+			wantError: codes.NotFound,
+		},
+		{
+			name: "internal error",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.Restore(gomock.Any(), promoId, companyId).Return(errors.New("database error"))
+			},
+			wantError: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			promoService := NewMockpromoService(ctrl)
+			tt.prepare(promoService.EXPECT())
+
+			h := &Handler{promoService: promoService}
+			ctx := context.WithValue(context.Background(), "company_id", companyId)
+
+			_, err := h.Restore(ctx, &promopb.RestorePromoRequest{PromoId: promoId})
+
+			if tt.wantError == codes.OK {
+				require.NoError(t, err)
+				return
+			}
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.wantError, st.Code())
+		})
+	}
+}
+
+func TestHandler_ListPromoAuditLog(t *testing.T) {
+	companyId := "testCompany"
+	promoId := "testPromo"
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		request   *promopb.ListPromoAuditLogRequest
+		prepare   func(f *MockpromoServiceMockRecorder)
+		wantError codes.Code
+	}{
+		{
+			name: "filtered by operation and time range",
+			request: &promopb.ListPromoAuditLogRequest{
+				PromoId:   promoId,
+				Limit:     pointer.To(int64(5)),
+				Operation: promopb.AuditOperation_UPDATE.Enum(),
+				From:      timestamppb.New(from),
+			},
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				filter := auditdto.Filter{
+					PromoId:   promoId,
+					CompanyId: companyId,
+					Operation: auditenum.OperationUpdate,
+					From:      from,
+				}
+				f.ListAuditLog(gomock.Any(), filter, 5, 0).Return([]auditdto.Entry{
+					{
+						Id:          "entry-1",
+						PromoId:     promoId,
+						ActorId:     companyId,
+						ActorUserId: "user-1",
+						Operation:   auditenum.OperationUpdate,
+						Changes:     []byte(`{"description":{"before":"old","after":"new"}}`),
+						CreatedAt:   createdAt,
+					},
+				}, nil)
+				f.CountAuditLog(gomock.Any(), filter).Return(1, nil)
+			},
+			wantError: codes.OK,
+		},
+		{
+			name:    "default limit",
+			request: &promopb.ListPromoAuditLogRequest{PromoId: promoId},
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				filter := auditdto.Filter{PromoId: promoId, CompanyId: companyId}
+				f.ListAuditLog(gomock.Any(), filter, defaultAuditLogLimit, 0).Return(nil, errors.New("db error"))
+			},
+			wantError: codes.Internal,
+		},
+		{
+			name: "unknown operation",
+			request: &promopb.ListPromoAuditLogRequest{
+				PromoId:   promoId,
+				Operation: promopb.AuditOperation(999).Enum(),
+			},
+			prepare:   func(f *MockpromoServiceMockRecorder) {},
+			wantError: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			promoService := NewMockpromoService(ctrl)
+			tt.prepare(promoService.EXPECT())
+
+			h := &Handler{promoService: promoService}
+			ctx := context.WithValue(context.Background(), "company_id", companyId)
+
+			resp, err := h.ListPromoAuditLog(ctx, tt.request)
+
+			if tt.wantError != codes.OK {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.wantError, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, int64(1), resp.GetXTotalCount())
+			require.Len(t, resp.GetEntries(), 1)
+			require.Equal(t, promopb.AuditOperation_UPDATE, resp.GetEntries()[0].GetOperation())
+			require.Equal(t, companyId, resp.GetEntries()[0].GetActorId())
+			require.Equal(t, "user-1", resp.GetEntries()[0].GetActorUserId())
+			require.JSONEq(t, `{"description":{"before":"old","after":"new"}}`, resp.GetEntries()[0].GetChanges())
+			require.Equal(t, createdAt, resp.GetEntries()[0].GetCreatedAt().AsTime())
+		})
+	}
+}
+
+func TestHandler_QuoteDiscount(t *testing.T) {
+	request := &promopb.QuoteDiscountRequest{
+		Code: "SUMMER25",
+		Cart: &promopb.Cart{
+			Currency: "RUB",
+			Items: []*promopb.CartItem{
+				{Sku: "coffee-250", Category: pointer.To("coffee"), UnitPrice: 45000, Quantity: 2},
+			},
+			ShippingAmount: 25000,
+		},
+	}
+	wantCart := &cart.DTO{
+		Currency:       "RUB",
+		Items:          []cart.Item{{Sku: "coffee-250", Category: "coffee", UnitPrice: 45000, Quantity: 2}},
+		ShippingAmount: 25000,
+	}
+
+	tests := []struct {
+		name      string
+		prepare   func(f *MockpromoServiceMockRecorder)
+		want      *promopb.QuoteDiscountResponse
+		wantError codes.Code
+	}{
+		{
+			name: "applicable",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.QuoteDiscount(gomock.Any(), "SUMMER25", wantCart).Return(&reward.Quote{
+					PromoId:    "promo-1",
+					RewardType: rewardenum.TypePercent,
+					Discount:   9000,
+					Currency:   "RUB",
+				}, nil)
+			},
+			want: &promopb.QuoteDiscountResponse{
+				Applicable:     true,
+				DiscountAmount: 9000,
+				Currency:       "RUB",
+				PromoId:        pointer.To("promo-1"),
+				RewardType:     promopb.RewardType_PERCENT.Enum(),
+			},
+			wantError: codes.OK,
+		},
+		{
+			name: "rejected",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.QuoteDiscount(gomock.Any(), "SUMMER25", wantCart).Return(&reward.Quote{
+					Currency:     "RUB",
+					RejectReason: rewardenum.RejectReasonCodeNotFound,
+				}, nil)
+			},
+			want: &promopb.QuoteDiscountResponse{
+				Currency:     "RUB",
+				RejectReason: promopb.QuoteRejectReason_REJECT_CODE_NOT_FOUND,
+			},
+			wantError: codes.OK,
+		},
+		{
+			name: "invalid cart",
+			prepare: func(f *MockpromoServiceMockRecorder) {
+				f.QuoteDiscount(gomock.Any(), "SUMMER25", wantCart).Return(nil, domainerrors.ValidationError{Field: "Currency"})
+			},
+			wantError: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			promoService := NewMockpromoService(ctrl)
+			tt.prepare(promoService.EXPECT())
+
+			h := &Handler{promoService: promoService}
+
+			resp, err := h.QuoteDiscount(context.Background(), request)
+
+			if tt.wantError != codes.OK {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, tt.wantError, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want.GetApplicable(), resp.GetApplicable())
+			require.Equal(t, tt.want.GetDiscountAmount(), resp.GetDiscountAmount())
+			require.Equal(t, tt.want.GetCurrency(), resp.GetCurrency())
+			require.Equal(t, tt.want.PromoId, resp.PromoId)
+			require.Equal(t, tt.want.RewardType, resp.RewardType)
+			require.Equal(t, tt.want.GetRejectReason(), resp.GetRejectReason())
+		})
+	}
+}
+
+func TestHandler_ResolveApplicablePromos(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	promoService := NewMockpromoService(ctrl)
+	promoService.EXPECT().ResolveApplicablePromos(gomock.Any(), []string{"SUMMER25", "FREESHIP", "UNKNOWN"}).Return(
+		[]stacking.Candidate{
+			{Code: "SUMMER25", PromoId: "p1", CompanyId: "c1", Settings: stacking.Settings{Group: "seasonal", Priority: 5}},
+		},
+		[]stacking.Rejection{
+			{Code: "UNKNOWN", Reason: rewardenum.RejectReasonCodeNotFound},
+			{Code: "FREESHIP", PromoId: "p2", Reason: rewardenum.RejectReasonExclusiveConflict, ConflictingPromoId: "p1"},
+		},
+		nil,
+	)
+
+	h := &Handler{promoService: promoService}
+
+	resp, err := h.ResolveApplicablePromos(context.Background(), &promopb.ResolveApplicablePromosRequest{
+		Codes: []string{"SUMMER25", "FREESHIP", "UNKNOWN"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.GetApplied(), 1)
+	require.Equal(t, "p1", resp.GetApplied()[0].GetPromoId())
+	require.Equal(t, "seasonal", resp.GetApplied()[0].GetStacking().GetGroup())
+	require.Equal(t, int64(5), resp.GetApplied()[0].GetStacking().GetPriority())
+
+	require.Len(t, resp.GetRejected(), 2)
+	require.Equal(t, promopb.QuoteRejectReason_REJECT_CODE_NOT_FOUND, resp.GetRejected()[0].GetReason())
+	require.Nil(t, resp.GetRejected()[0].PromoId)
+	require.Equal(t, promopb.QuoteRejectReason_REJECT_EXCLUSIVE_CONFLICT, resp.GetRejected()[1].GetReason())
+	require.Equal(t, "p1", resp.GetRejected()[1].GetConflictingPromoId())
+	require.NotEmpty(t, resp.GetRejected()[1].GetExplanation())
+}
+
+func TestHandler_ResolveApplicablePromos_NoCodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := &Handler{promoService: NewMockpromoService(ctrl)}
+
+	_, err := h.ResolveApplicablePromos(context.Background(), &promopb.ResolveApplicablePromosRequest{})
+
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+type exportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*promopb.ExportPromoDataChunk
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(chunk *promopb.ExportPromoDataChunk) error {
+	s.chunks = append(s.chunks, &promopb.ExportPromoDataChunk{
+		ContentType: chunk.ContentType,
+		Filename:    chunk.Filename,
+		Data:        append([]byte(nil), chunk.Data...),
+	})
+	return nil
+}
+
+func TestHandler_Export(t *testing.T) {
+	const (
+		promoId   = "promoId"
+		companyId = "companyId"
+	)
+
+	ctx := context.WithValue(context.Background(), "company_id", companyId)
+
+	t.Run("large export is split into chunks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		data := bytes.Repeat([]byte("a"), exportChunkSize*2+10)
+
+		promoService := NewMockpromoService(ctrl)
+		promoService.EXPECT().Export(gomock.Any(), promoId, companyId, exportenum.DatasetActivations, tabular.FormatXLSX, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ string, _ exportenum.Dataset, _ tabular.Format, w io.Writer) error {
+				_, err := w.Write(data)
+				return err
+			})
+
+		h := &Handler{promoService: promoService}
+		stream := &exportStream{ctx: ctx}
+
+		err := h.Export(&promopb.ExportPromoDataRequest{
+			PromoId: promoId,
+			Dataset: promopb.ExportDataset_EXPORT_ACTIVATIONS,
+			Format:  promopb.ExportFormat_EXPORT_XLSX,
+		}, stream)
+		require.NoError(t, err)
+
+		require.Len(t, stream.chunks, 3)
+		require.Equal(t, tabular.FormatXLSX.ContentType(), stream.chunks[0].GetContentType())
+		require.Equal(t, "promo-promoId-activations.xlsx", stream.chunks[0].GetFilename())
+
+		var got []byte
+		for i, chunk := range stream.chunks {
+			require.LessOrEqual(t, len(chunk.GetData()), exportChunkSize)
+			if i > 0 {
+				require.Nil(t, chunk.ContentType)
+				require.Nil(t, chunk.Filename)
+			}
+			got = append(got, chunk.GetData()...)
+		}
+		require.Equal(t, data, got)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		promoService := NewMockpromoService(ctrl)
+		promoService.EXPECT().Export(gomock.Any(), promoId, companyId, exportenum.DatasetCodes, tabular.FormatCSV, gomock.Any()).
+			Return(promoservice.ErrPermissionDenied)
+
+		h := &Handler{promoService: promoService}
+		stream := &exportStream{ctx: ctx}
+
+		err := h.Export(&promopb.ExportPromoDataRequest{PromoId: promoId}, stream)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.Empty(t, stream.chunks)
+	})
+}
+
+func TestHandler_GetById_Localized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	promoService := NewMockpromoService(ctrl)
+	promoService.EXPECT().GetById(gomock.Any(), "promoId", "companyId").Return(&promo.DTO{
+		PromoId:     "promoId",
+		CompanyId:   "companyId",
+		Mode:        promo.COMMON,
+		Description: "Скидка десять процентов",
+		Target:      &target.DTO{},
+		Localization: localization.Localization{
+			DefaultLocale: "ru",
+			Translations:  map[string]string{"de": "Zehn Prozent auf alles"},
+		},
+	}, nil).Times(2)
+
+	h := &Handler{promoService: promoService}
+
+	ctx := context.WithValue(context.Background(), "company_id", "companyId")
+
+	got, err := h.GetById(metadata.NewIncomingContext(ctx, metadata.Pairs("accept-language", "de-AT", "accept-language", "en")), &promopb.GetPromoRequest{PromoId: "promoId"})
+	require.NoError(t, err)
+	require.Equal(t, "Zehn Prozent auf alles", got.GetPromo().GetDescription())
+	require.Equal(t, "de", got.GetPromo().GetLocale())
+	require.Equal(t, map[string]string{"de": "Zehn Prozent auf alles"}, got.GetPromo().GetLocalization().GetTranslations())
+
+	got, err = h.GetById(ctx, &promopb.GetPromoRequest{PromoId: "promoId"})
+	require.NoError(t, err)
+	require.Equal(t, "Скидка десять процентов", got.GetPromo().GetDescription())
+	require.Equal(t, "ru", got.GetPromo().GetLocale())
+}
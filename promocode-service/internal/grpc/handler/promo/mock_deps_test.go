@@ -11,11 +11,23 @@ package promo
 
 import (
 	context "context"
-	promo "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
-	promo0 "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	io "io"
 	reflect "reflect"
 	time "time"
 
+	audit "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	cart "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	limit "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	localization "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
+	promo "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	referral "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	reward "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	stacking "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
+	userdata "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/userdata"
+	export "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/export"
+	promo0 "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	barcode "gitlab.com/pisya-dev/promo-code-service/pkg/barcode"
+	tabular "gitlab.com/pisya-dev/promo-code-service/pkg/tabular"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -44,18 +56,18 @@ func (m *MockpromoService) EXPECT() *MockpromoServiceMockRecorder {
 }
 
 // Activate mocks base method.
-func (m *MockpromoService) Activate(ctx context.Context, promoId string) (string, error) {
+func (m *MockpromoService) Activate(ctx context.Context, promoId, userId string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Activate", ctx, promoId)
+	ret := m.ctrl.Call(m, "Activate", ctx, promoId, userId)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Activate indicates an expected call of Activate.
-func (mr *MockpromoServiceMockRecorder) Activate(ctx, promoId any) *MockpromoServiceActivateCall {
+func (mr *MockpromoServiceMockRecorder) Activate(ctx, promoId, userId any) *MockpromoServiceActivateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockpromoService)(nil).Activate), ctx, promoId)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockpromoService)(nil).Activate), ctx, promoId, userId)
 	return &MockpromoServiceActivateCall{Call: call}
 }
 
@@ -71,30 +83,69 @@ func (c *MockpromoServiceActivateCall) Return(code string, err error) *Mockpromo
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoServiceActivateCall) Do(f func(context.Context, string) (string, error)) *MockpromoServiceActivateCall {
+func (c *MockpromoServiceActivateCall) Do(f func(context.Context, string, string) (string, error)) *MockpromoServiceActivateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceActivateCall) DoAndReturn(f func(context.Context, string, string) (string, error)) *MockpromoServiceActivateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Archive mocks base method.
+func (m *MockpromoService) Archive(ctx context.Context, promoId, companyId string) (promo0.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, promoId, companyId)
+	ret0, _ := ret[0].(promo0.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockpromoServiceMockRecorder) Archive(ctx, promoId, companyId any) *MockpromoServiceArchiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockpromoService)(nil).Archive), ctx, promoId, companyId)
+	return &MockpromoServiceArchiveCall{Call: call}
+}
+
+// MockpromoServiceArchiveCall wrap *gomock.Call
+type MockpromoServiceArchiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceArchiveCall) Return(arg0 promo0.Status, arg1 error) *MockpromoServiceArchiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceArchiveCall) Do(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServiceArchiveCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoServiceActivateCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockpromoServiceActivateCall {
+func (c *MockpromoServiceArchiveCall) DoAndReturn(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServiceArchiveCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Count mocks base method.
-func (m *MockpromoService) Count(ctx context.Context, companyId string, countries []string) (int, error) {
+func (m *MockpromoService) Count(ctx context.Context, companyId string, countries []string, searchQuery string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Count", ctx, companyId, countries)
+	ret := m.ctrl.Call(m, "Count", ctx, companyId, countries, searchQuery)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Count indicates an expected call of Count.
-func (mr *MockpromoServiceMockRecorder) Count(ctx, companyId, countries any) *MockpromoServiceCountCall {
+func (mr *MockpromoServiceMockRecorder) Count(ctx, companyId, countries, searchQuery any) *MockpromoServiceCountCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockpromoService)(nil).Count), ctx, companyId, countries)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockpromoService)(nil).Count), ctx, companyId, countries, searchQuery)
 	return &MockpromoServiceCountCall{Call: call}
 }
 
@@ -110,13 +161,52 @@ func (c *MockpromoServiceCountCall) Return(count int, err error) *MockpromoServi
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoServiceCountCall) Do(f func(context.Context, string, []string) (int, error)) *MockpromoServiceCountCall {
+func (c *MockpromoServiceCountCall) Do(f func(context.Context, string, []string, string) (int, error)) *MockpromoServiceCountCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoServiceCountCall) DoAndReturn(f func(context.Context, string, []string) (int, error)) *MockpromoServiceCountCall {
+func (c *MockpromoServiceCountCall) DoAndReturn(f func(context.Context, string, []string, string) (int, error)) *MockpromoServiceCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountAuditLog mocks base method.
+func (m *MockpromoService) CountAuditLog(ctx context.Context, filter audit.Filter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAuditLog", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAuditLog indicates an expected call of CountAuditLog.
+func (mr *MockpromoServiceMockRecorder) CountAuditLog(ctx, filter any) *MockpromoServiceCountAuditLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAuditLog", reflect.TypeOf((*MockpromoService)(nil).CountAuditLog), ctx, filter)
+	return &MockpromoServiceCountAuditLogCall{Call: call}
+}
+
+// MockpromoServiceCountAuditLogCall wrap *gomock.Call
+type MockpromoServiceCountAuditLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceCountAuditLogCall) Return(count int, err error) *MockpromoServiceCountAuditLogCall {
+	c.Call = c.Call.Return(count, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceCountAuditLogCall) Do(f func(context.Context, audit.Filter) (int, error)) *MockpromoServiceCountAuditLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceCountAuditLogCall) DoAndReturn(f func(context.Context, audit.Filter) (int, error)) *MockpromoServiceCountAuditLogCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -198,6 +288,200 @@ func (c *MockpromoServiceDeleteCall) DoAndReturn(f func(context.Context, string,
 	return c
 }
 
+// Export mocks base method.
+func (m *MockpromoService) Export(ctx context.Context, promoId, companyId string, dataset export.Dataset, format tabular.Format, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, promoId, companyId, dataset, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockpromoServiceMockRecorder) Export(ctx, promoId, companyId, dataset, format, w any) *MockpromoServiceExportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockpromoService)(nil).Export), ctx, promoId, companyId, dataset, format, w)
+	return &MockpromoServiceExportCall{Call: call}
+}
+
+// MockpromoServiceExportCall wrap *gomock.Call
+type MockpromoServiceExportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceExportCall) Return(arg0 error) *MockpromoServiceExportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceExportCall) Do(f func(context.Context, string, string, export.Dataset, tabular.Format, io.Writer) error) *MockpromoServiceExportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceExportCall) DoAndReturn(f func(context.Context, string, string, export.Dataset, tabular.Format, io.Writer) error) *MockpromoServiceExportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportUserData mocks base method.
+func (m *MockpromoService) ExportUserData(ctx context.Context, userId string) ([]userdata.Activation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, userId)
+	ret0, _ := ret[0].([]userdata.Activation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockpromoServiceMockRecorder) ExportUserData(ctx, userId any) *MockpromoServiceExportUserDataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockpromoService)(nil).ExportUserData), ctx, userId)
+	return &MockpromoServiceExportUserDataCall{Call: call}
+}
+
+// MockpromoServiceExportUserDataCall wrap *gomock.Call
+type MockpromoServiceExportUserDataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceExportUserDataCall) Return(arg0 []userdata.Activation, arg1 error) *MockpromoServiceExportUserDataCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceExportUserDataCall) Do(f func(context.Context, string) ([]userdata.Activation, error)) *MockpromoServiceExportUserDataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceExportUserDataCall) DoAndReturn(f func(context.Context, string) ([]userdata.Activation, error)) *MockpromoServiceExportUserDataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Feed mocks base method.
+func (m *MockpromoService) Feed(ctx context.Context, category string, limit, offset int, searchQuery string) ([]promo.DTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, category, limit, offset, searchQuery)
+	ret0, _ := ret[0].([]promo.DTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockpromoServiceMockRecorder) Feed(ctx, category, limit, offset, searchQuery any) *MockpromoServiceFeedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockpromoService)(nil).Feed), ctx, category, limit, offset, searchQuery)
+	return &MockpromoServiceFeedCall{Call: call}
+}
+
+// MockpromoServiceFeedCall wrap *gomock.Call
+type MockpromoServiceFeedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceFeedCall) Return(promoDTOs []promo.DTO, err error) *MockpromoServiceFeedCall {
+	c.Call = c.Call.Return(promoDTOs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceFeedCall) Do(f func(context.Context, string, int, int, string) ([]promo.DTO, error)) *MockpromoServiceFeedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceFeedCall) DoAndReturn(f func(context.Context, string, int, int, string) ([]promo.DTO, error)) *MockpromoServiceFeedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FeedCount mocks base method.
+func (m *MockpromoService) FeedCount(ctx context.Context, category, searchQuery string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeedCount", ctx, category, searchQuery)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeedCount indicates an expected call of FeedCount.
+func (mr *MockpromoServiceMockRecorder) FeedCount(ctx, category, searchQuery any) *MockpromoServiceFeedCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedCount", reflect.TypeOf((*MockpromoService)(nil).FeedCount), ctx, category, searchQuery)
+	return &MockpromoServiceFeedCountCall{Call: call}
+}
+
+// MockpromoServiceFeedCountCall wrap *gomock.Call
+type MockpromoServiceFeedCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceFeedCountCall) Return(count int, err error) *MockpromoServiceFeedCountCall {
+	c.Call = c.Call.Return(count, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceFeedCountCall) Do(f func(context.Context, string, string) (int, error)) *MockpromoServiceFeedCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceFeedCountCall) DoAndReturn(f func(context.Context, string, string) (int, error)) *MockpromoServiceFeedCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ForgetUser mocks base method.
+func (m *MockpromoService) ForgetUser(ctx context.Context, userId string) (*userdata.Erasure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgetUser", ctx, userId)
+	ret0, _ := ret[0].(*userdata.Erasure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgetUser indicates an expected call of ForgetUser.
+func (mr *MockpromoServiceMockRecorder) ForgetUser(ctx, userId any) *MockpromoServiceForgetUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetUser", reflect.TypeOf((*MockpromoService)(nil).ForgetUser), ctx, userId)
+	return &MockpromoServiceForgetUserCall{Call: call}
+}
+
+// MockpromoServiceForgetUserCall wrap *gomock.Call
+type MockpromoServiceForgetUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceForgetUserCall) Return(arg0 *userdata.Erasure, arg1 error) *MockpromoServiceForgetUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceForgetUserCall) Do(f func(context.Context, string) (*userdata.Erasure, error)) *MockpromoServiceForgetUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceForgetUserCall) DoAndReturn(f func(context.Context, string) (*userdata.Erasure, error)) *MockpromoServiceForgetUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetById mocks base method.
 func (m *MockpromoService) GetById(ctx context.Context, promoId, companyId string) (*promo.DTO, error) {
 	m.ctrl.T.Helper()
@@ -238,18 +522,18 @@ func (c *MockpromoServiceGetByIdCall) DoAndReturn(f func(context.Context, string
 }
 
 // List mocks base method.
-func (m *MockpromoService) List(ctx context.Context, companyId string, countries []string, limit, offset int, sortBy promo0.SortBy) ([]promo.DTO, error) {
+func (m *MockpromoService) List(ctx context.Context, companyId string, countries []string, limit, offset int, sortBy promo0.SortBy, searchQuery string) ([]promo.DTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, companyId, countries, limit, offset, sortBy)
+	ret := m.ctrl.Call(m, "List", ctx, companyId, countries, limit, offset, sortBy, searchQuery)
 	ret0, _ := ret[0].([]promo.DTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockpromoServiceMockRecorder) List(ctx, companyId, countries, limit, offset, sortBy any) *MockpromoServiceListCall {
+func (mr *MockpromoServiceMockRecorder) List(ctx, companyId, countries, limit, offset, sortBy, searchQuery any) *MockpromoServiceListCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpromoService)(nil).List), ctx, companyId, countries, limit, offset, sortBy)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpromoService)(nil).List), ctx, companyId, countries, limit, offset, sortBy, searchQuery)
 	return &MockpromoServiceListCall{Call: call}
 }
 
@@ -265,29 +549,381 @@ func (c *MockpromoServiceListCall) Return(promoDTOs []promo.DTO, err error) *Moc
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoServiceListCall) Do(f func(context.Context, string, []string, int, int, promo0.SortBy) ([]promo.DTO, error)) *MockpromoServiceListCall {
+func (c *MockpromoServiceListCall) Do(f func(context.Context, string, []string, int, int, promo0.SortBy, string) ([]promo.DTO, error)) *MockpromoServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceListCall) DoAndReturn(f func(context.Context, string, []string, int, int, promo0.SortBy, string) ([]promo.DTO, error)) *MockpromoServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAuditLog mocks base method.
+func (m *MockpromoService) ListAuditLog(ctx context.Context, filter audit.Filter, limit, offset int) ([]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockpromoServiceMockRecorder) ListAuditLog(ctx, filter, limit, offset any) *MockpromoServiceListAuditLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockpromoService)(nil).ListAuditLog), ctx, filter, limit, offset)
+	return &MockpromoServiceListAuditLogCall{Call: call}
+}
+
+// MockpromoServiceListAuditLogCall wrap *gomock.Call
+type MockpromoServiceListAuditLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceListAuditLogCall) Return(entries []audit.Entry, err error) *MockpromoServiceListAuditLogCall {
+	c.Call = c.Call.Return(entries, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceListAuditLogCall) Do(f func(context.Context, audit.Filter, int, int) ([]audit.Entry, error)) *MockpromoServiceListAuditLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceListAuditLogCall) DoAndReturn(f func(context.Context, audit.Filter, int, int) ([]audit.Entry, error)) *MockpromoServiceListAuditLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Pause mocks base method.
+func (m *MockpromoService) Pause(ctx context.Context, promoId, companyId string) (promo0.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, promoId, companyId)
+	ret0, _ := ret[0].(promo0.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockpromoServiceMockRecorder) Pause(ctx, promoId, companyId any) *MockpromoServicePauseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockpromoService)(nil).Pause), ctx, promoId, companyId)
+	return &MockpromoServicePauseCall{Call: call}
+}
+
+// MockpromoServicePauseCall wrap *gomock.Call
+type MockpromoServicePauseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServicePauseCall) Return(arg0 promo0.Status, arg1 error) *MockpromoServicePauseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServicePauseCall) Do(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServicePauseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServicePauseCall) DoAndReturn(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServicePauseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Publish mocks base method.
+func (m *MockpromoService) Publish(ctx context.Context, promoId, companyId string) (promo0.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, promoId, companyId)
+	ret0, _ := ret[0].(promo0.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockpromoServiceMockRecorder) Publish(ctx, promoId, companyId any) *MockpromoServicePublishCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockpromoService)(nil).Publish), ctx, promoId, companyId)
+	return &MockpromoServicePublishCall{Call: call}
+}
+
+// MockpromoServicePublishCall wrap *gomock.Call
+type MockpromoServicePublishCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServicePublishCall) Return(arg0 promo0.Status, arg1 error) *MockpromoServicePublishCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServicePublishCall) Do(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServicePublishCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServicePublishCall) DoAndReturn(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServicePublishCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// QuoteDiscount mocks base method.
+func (m *MockpromoService) QuoteDiscount(ctx context.Context, code string, cart *cart.DTO) (*reward.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteDiscount", ctx, code, cart)
+	ret0, _ := ret[0].(*reward.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteDiscount indicates an expected call of QuoteDiscount.
+func (mr *MockpromoServiceMockRecorder) QuoteDiscount(ctx, code, cart any) *MockpromoServiceQuoteDiscountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteDiscount", reflect.TypeOf((*MockpromoService)(nil).QuoteDiscount), ctx, code, cart)
+	return &MockpromoServiceQuoteDiscountCall{Call: call}
+}
+
+// MockpromoServiceQuoteDiscountCall wrap *gomock.Call
+type MockpromoServiceQuoteDiscountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceQuoteDiscountCall) Return(quote *reward.Quote, err error) *MockpromoServiceQuoteDiscountCall {
+	c.Call = c.Call.Return(quote, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceQuoteDiscountCall) Do(f func(context.Context, string, *cart.DTO) (*reward.Quote, error)) *MockpromoServiceQuoteDiscountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceQuoteDiscountCall) DoAndReturn(f func(context.Context, string, *cart.DTO) (*reward.Quote, error)) *MockpromoServiceQuoteDiscountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RenderCode mocks base method.
+func (m *MockpromoService) RenderCode(ctx context.Context, promoId, userId, code string, opts barcode.Options) (string, []byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderCode", ctx, promoId, userId, code, opts)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// RenderCode indicates an expected call of RenderCode.
+func (mr *MockpromoServiceMockRecorder) RenderCode(ctx, promoId, userId, code, opts any) *MockpromoServiceRenderCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderCode", reflect.TypeOf((*MockpromoService)(nil).RenderCode), ctx, promoId, userId, code, opts)
+	return &MockpromoServiceRenderCodeCall{Call: call}
+}
+
+// MockpromoServiceRenderCodeCall wrap *gomock.Call
+type MockpromoServiceRenderCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceRenderCodeCall) Return(activatedCode string, image []byte, contentType string, err error) *MockpromoServiceRenderCodeCall {
+	c.Call = c.Call.Return(activatedCode, image, contentType, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceRenderCodeCall) Do(f func(context.Context, string, string, string, barcode.Options) (string, []byte, string, error)) *MockpromoServiceRenderCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceRenderCodeCall) DoAndReturn(f func(context.Context, string, string, string, barcode.Options) (string, []byte, string, error)) *MockpromoServiceRenderCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResolveApplicablePromos mocks base method.
+func (m *MockpromoService) ResolveApplicablePromos(ctx context.Context, codes []string) ([]stacking.Candidate, []stacking.Rejection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveApplicablePromos", ctx, codes)
+	ret0, _ := ret[0].([]stacking.Candidate)
+	ret1, _ := ret[1].([]stacking.Rejection)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolveApplicablePromos indicates an expected call of ResolveApplicablePromos.
+func (mr *MockpromoServiceMockRecorder) ResolveApplicablePromos(ctx, codes any) *MockpromoServiceResolveApplicablePromosCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveApplicablePromos", reflect.TypeOf((*MockpromoService)(nil).ResolveApplicablePromos), ctx, codes)
+	return &MockpromoServiceResolveApplicablePromosCall{Call: call}
+}
+
+// MockpromoServiceResolveApplicablePromosCall wrap *gomock.Call
+type MockpromoServiceResolveApplicablePromosCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceResolveApplicablePromosCall) Return(applied []stacking.Candidate, rejected []stacking.Rejection, err error) *MockpromoServiceResolveApplicablePromosCall {
+	c.Call = c.Call.Return(applied, rejected, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceResolveApplicablePromosCall) Do(f func(context.Context, []string) ([]stacking.Candidate, []stacking.Rejection, error)) *MockpromoServiceResolveApplicablePromosCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceResolveApplicablePromosCall) DoAndReturn(f func(context.Context, []string) ([]stacking.Candidate, []stacking.Rejection, error)) *MockpromoServiceResolveApplicablePromosCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Restore mocks base method.
+func (m *MockpromoService) Restore(ctx context.Context, promoId, companyId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, promoId, companyId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockpromoServiceMockRecorder) Restore(ctx, promoId, companyId any) *MockpromoServiceRestoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockpromoService)(nil).Restore), ctx, promoId, companyId)
+	return &MockpromoServiceRestoreCall{Call: call}
+}
+
+// MockpromoServiceRestoreCall wrap *gomock.Call
+type MockpromoServiceRestoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceRestoreCall) Return(arg0 error) *MockpromoServiceRestoreCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceRestoreCall) Do(f func(context.Context, string, string) error) *MockpromoServiceRestoreCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceRestoreCall) DoAndReturn(f func(context.Context, string, string) error) *MockpromoServiceRestoreCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Resume mocks base method.
+func (m *MockpromoService) Resume(ctx context.Context, promoId, companyId string) (promo0.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, promoId, companyId)
+	ret0, _ := ret[0].(promo0.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockpromoServiceMockRecorder) Resume(ctx, promoId, companyId any) *MockpromoServiceResumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockpromoService)(nil).Resume), ctx, promoId, companyId)
+	return &MockpromoServiceResumeCall{Call: call}
+}
+
+// MockpromoServiceResumeCall wrap *gomock.Call
+type MockpromoServiceResumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceResumeCall) Return(arg0 promo0.Status, arg1 error) *MockpromoServiceResumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceResumeCall) Do(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServiceResumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoServiceResumeCall) DoAndReturn(f func(context.Context, string, string) (promo0.Status, error)) *MockpromoServiceResumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetImage mocks base method.
+func (m *MockpromoService) SetImage(ctx context.Context, promoId, companyId, imageUrl string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImage", ctx, promoId, companyId, imageUrl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImage indicates an expected call of SetImage.
+func (mr *MockpromoServiceMockRecorder) SetImage(ctx, promoId, companyId, imageUrl any) *MockpromoServiceSetImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImage", reflect.TypeOf((*MockpromoService)(nil).SetImage), ctx, promoId, companyId, imageUrl)
+	return &MockpromoServiceSetImageCall{Call: call}
+}
+
+// MockpromoServiceSetImageCall wrap *gomock.Call
+type MockpromoServiceSetImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoServiceSetImageCall) Return(arg0 error) *MockpromoServiceSetImageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoServiceSetImageCall) Do(f func(context.Context, string, string, string) error) *MockpromoServiceSetImageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoServiceListCall) DoAndReturn(f func(context.Context, string, []string, int, int, promo0.SortBy) ([]promo.DTO, error)) *MockpromoServiceListCall {
+func (c *MockpromoServiceSetImageCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockpromoServiceSetImageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Update mocks base method.
-func (m *MockpromoService) Update(ctx context.Context, promoId, companyId, description, imageUrl string, targetAgeFrom, targetAgeUntil int64, targetCountry string, targetCategories []string, activeFrom, activeUntil time.Time) error {
+func (m *MockpromoService) Update(ctx context.Context, promoId, companyId, description, imageUrl string, targetAgeFrom, targetAgeUntil int64, targetCountry string, targetCategories []string, activeFrom, activeUntil time.Time, reward *reward.DTO, stacking *stacking.Settings, activationLimits []limit.Policy, referralReward *referral.Reward, localization *localization.Localization) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, promoId, companyId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil)
+	ret := m.ctrl.Call(m, "Update", ctx, promoId, companyId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil, reward, stacking, activationLimits, referralReward, localization)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockpromoServiceMockRecorder) Update(ctx, promoId, companyId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil any) *MockpromoServiceUpdateCall {
+func (mr *MockpromoServiceMockRecorder) Update(ctx, promoId, companyId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil, reward, stacking, activationLimits, referralReward, localization any) *MockpromoServiceUpdateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpromoService)(nil).Update), ctx, promoId, companyId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpromoService)(nil).Update), ctx, promoId, companyId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil, reward, stacking, activationLimits, referralReward, localization)
 	return &MockpromoServiceUpdateCall{Call: call}
 }
 
@@ -303,13 +939,13 @@ func (c *MockpromoServiceUpdateCall) Return(arg0 error) *MockpromoServiceUpdateC
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoServiceUpdateCall) Do(f func(context.Context, string, string, string, string, int64, int64, string, []string, time.Time, time.Time) error) *MockpromoServiceUpdateCall {
+func (c *MockpromoServiceUpdateCall) Do(f func(context.Context, string, string, string, string, int64, int64, string, []string, time.Time, time.Time, *reward.DTO, *stacking.Settings, []limit.Policy, *referral.Reward, *localization.Localization) error) *MockpromoServiceUpdateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoServiceUpdateCall) DoAndReturn(f func(context.Context, string, string, string, string, int64, int64, string, []string, time.Time, time.Time) error) *MockpromoServiceUpdateCall {
+func (c *MockpromoServiceUpdateCall) DoAndReturn(f func(context.Context, string, string, string, string, int64, int64, string, []string, time.Time, time.Time, *reward.DTO, *stacking.Settings, []limit.Policy, *referral.Reward, *localization.Localization) error) *MockpromoServiceUpdateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
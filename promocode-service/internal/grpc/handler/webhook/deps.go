@@ -0,0 +1,16 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook
+
+import (
+	"context"
+
+	webhookdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+)
+
+type webhookService interface {
+	CreateEndpoint(ctx context.Context, endpoint *webhookdto.Endpoint) (*webhookdto.Endpoint, error)
+	ListEndpoints(ctx context.Context, companyId string) ([]webhookdto.Endpoint, error)
+	DeleteEndpoint(ctx context.Context, endpointId string, companyId string) error
+	ListDeliveries(ctx context.Context, filter webhookdto.Filter, limit int, offset int) ([]webhookdto.Delivery, error)
+	CountDeliveries(ctx context.Context, filter webhookdto.Filter) (int, error)
+}
@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	adaptergrpc "gitlab.com/pisya-dev/promo-code-service/internal/adapter/grpc"
+	webhookdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
+	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/functional"
+	webhookservice "gitlab.com/pisya-dev/promo-code-service/internal/service/webhook"
+	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/pointer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultDeliveriesLimit = 20
+
+type Handler struct {
+	webhookService webhookService
+}
+
+func New(webhookService webhookService) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *Handler) CreateEndpoint(ctx context.Context, r *promopb.CreateWebhookEndpointRequest) (*promopb.CreateWebhookEndpointResponse, error) {
+	endpoint, err := h.webhookService.CreateEndpoint(ctx, &webhookdto.Endpoint{
+		CompanyId: ctx.Value("company_id").(string),
+		Url:       r.GetUrl(),
+		Events:    functional.Map(r.GetEvents(), adaptergrpc.MapPbWebhookEventToDomain),
+	})
+	if err != nil {
+		log.Println(err)
+
+		if errors.As(err, &domainerrors.ValidationError{}) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, webhookservice.ErrTooManyEndpoints) {
+			return nil, status.Errorf(codes.ResourceExhausted, "at most %d webhook endpoints are allowed", webhookdto.MaxEndpoints)
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.CreateWebhookEndpointResponse{
+		Endpoint: mapEndpointToPb(*endpoint),
+		Secret:   endpoint.Secret,
+	}, nil
+}
+
+func (h *Handler) ListEndpoints(ctx context.Context, r *promopb.ListWebhookEndpointsRequest) (*promopb.ListWebhookEndpointsResponse, error) {
+	endpoints, err := h.webhookService.ListEndpoints(ctx, ctx.Value("company_id").(string))
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.ListWebhookEndpointsResponse{
+		Endpoints: functional.Map(endpoints, mapEndpointToPb),
+	}, nil
+}
+
+func (h *Handler) DeleteEndpoint(ctx context.Context, r *promopb.DeleteWebhookEndpointRequest) (*promopb.DeleteWebhookEndpointResponse, error) {
+	err := h.webhookService.DeleteEndpoint(ctx, r.GetEndpointId(), ctx.Value("company_id").(string))
+	if err != nil {
+		log.Println(err)
+
+		if errors.Is(err, webhookservice.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "webhook endpoint not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.DeleteWebhookEndpointResponse{}, nil
+}
+
+func (h *Handler) ListDeliveries(ctx context.Context, r *promopb.ListWebhookDeliveriesRequest) (*promopb.ListWebhookDeliveriesResponse, error) {
+	filter := webhookdto.Filter{
+		CompanyId:  ctx.Value("company_id").(string),
+		EndpointId: r.GetEndpointId(),
+	}
+	if r.Status != nil {
+		filter.Status = adaptergrpc.MapPbWebhookDeliveryStatusToDomain(r.GetStatus())
+		if filter.Status == "" {
+			return nil, status.Error(codes.InvalidArgument, "unknown delivery status")
+		}
+	}
+
+	limit := int(r.GetLimit())
+	if r.Limit == nil {
+		limit = defaultDeliveriesLimit
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(ctx, filter, limit, int(r.GetOffset()))
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	deliveriesCount, err := h.webhookService.CountDeliveries(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &promopb.ListWebhookDeliveriesResponse{
+		XTotalCount: int64(deliveriesCount),
+		Deliveries:  functional.Map(deliveries, mapDeliveryToPb),
+	}, nil
+}
+
+func mapEndpointToPb(endpoint webhookdto.Endpoint) *promopb.WebhookEndpoint {
+	return &promopb.WebhookEndpoint{
+		Id:        endpoint.Id,
+		Url:       endpoint.Url,
+		Events:    functional.Map(endpoint.Events, adaptergrpc.MapDomainWebhookEventToPb),
+		CreatedAt: timestamppb.New(endpoint.CreatedAt),
+	}
+}
+
+func mapDeliveryToPb(delivery webhookdto.Delivery) *promopb.WebhookDelivery {
+	pbDelivery := &promopb.WebhookDelivery{
+		Id:            delivery.Id,
+		EndpointId:    delivery.EndpointId,
+		Event:         adaptergrpc.MapDomainWebhookEventToPb(delivery.Event),
+		Status:        adaptergrpc.MapDomainWebhookDeliveryStatusToPb(delivery.Status),
+		Attempts:      int64(delivery.Attempts),
+		NextAttemptAt: timestamppb.New(delivery.NextAttemptAt),
+		CreatedAt:     timestamppb.New(delivery.CreatedAt),
+		Payload:       string(delivery.Payload),
+	}
+	if delivery.LastStatusCode != 0 {
+		pbDelivery.LastStatusCode = pointer.ToInt64(int64(delivery.LastStatusCode))
+	}
+	if delivery.LastError != "" {
+		pbDelivery.LastError = &delivery.LastError
+	}
+	if delivery.Status == webhookenum.DeliveryStatusDelivered {
+		pbDelivery.DeliveredAt = timestamppb.New(delivery.DeliveredAt)
+	}
+	return pbDelivery
+}
@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	webhookdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	webhookservice "gitlab.com/pisya-dev/promo-code-service/internal/service/webhook"
+	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/pointer"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const companyId = "someCompanyId"
+
+func TestHandler_CreateEndpoint(t *testing.T) {
+	type fields struct {
+		webhookService *MockwebhookService
+	}
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rq := &promopb.CreateWebhookEndpointRequest{
+		Url:    "https://example.com/hook",
+		Events: []promopb.WebhookEvent{promopb.WebhookEvent_WEBHOOK_PROMO_ACTIVATED},
+	}
+
+	endpoint := &webhookdto.Endpoint{
+		CompanyId: companyId,
+		Url:       rq.GetUrl(),
+		Events:    []webhookenum.Event{webhookenum.EventPromoActivated},
+	}
+
+	tests := []struct {
+		name     string
+		prepare  func(f *fields)
+		want     *promopb.CreateWebhookEndpointResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			prepare: func(f *fields) {
+				f.webhookService.EXPECT().CreateEndpoint(gomock.Any(), endpoint).Return(&webhookdto.Endpoint{
+					Id:        "endpointId",
+					CompanyId: companyId,
+					Url:       rq.GetUrl(),
+					Events:    []webhookenum.Event{webhookenum.EventPromoActivated},
+					Secret:    "whsec_secret",
+					CreatedAt: createdAt,
+				}, nil)
+			},
+			want: &promopb.CreateWebhookEndpointResponse{
+				Endpoint: &promopb.WebhookEndpoint{
+					Id:        "endpointId",
+					Url:       rq.GetUrl(),
+					Events:    []promopb.WebhookEvent{promopb.WebhookEvent_WEBHOOK_PROMO_ACTIVATED},
+					CreatedAt: timestamppb.New(createdAt),
+				},
+				Secret: "whsec_secret",
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "too many endpoints",
+			prepare: func(f *fields) {
+				f.webhookService.EXPECT().CreateEndpoint(gomock.Any(), endpoint).Return(nil, webhookservice.ErrTooManyEndpoints)
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			f := &fields{
+				webhookService: NewMockwebhookService(ctrl),
+			}
+
+			tt.prepare(f)
+
+			h := &Handler{
+				webhookService: f.webhookService,
+			}
+
+			ctx := context.WithValue(context.Background(), "company_id", companyId)
+
+			got, err := h.CreateEndpoint(ctx, rq)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandler_DeleteEndpoint_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	webhookService := NewMockwebhookService(ctrl)
+	webhookService.EXPECT().DeleteEndpoint(gomock.Any(), "endpointId", companyId).Return(webhookservice.ErrNotFound)
+
+	h := &Handler{
+		webhookService: webhookService,
+	}
+
+	ctx := context.WithValue(context.Background(), "company_id", companyId)
+
+	_, err := h.DeleteEndpoint(ctx, &promopb.DeleteWebhookEndpointRequest{EndpointId: "endpointId"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestHandler_ListDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	filter := webhookdto.Filter{
+		CompanyId: companyId,
+		Status:    webhookenum.DeliveryStatusDead,
+	}
+
+	webhookService := NewMockwebhookService(ctrl)
+	webhookService.EXPECT().ListDeliveries(gomock.Any(), filter, defaultDeliveriesLimit, 0).Return([]webhookdto.Delivery{
+		{
+			Id:             "deliveryId",
+			EndpointId:     "endpointId",
+			Event:          webhookenum.EventPromoExpired,
+			Status:         webhookenum.DeliveryStatusDead,
+			Attempts:       webhookdto.MaxAttempts,
+			LastStatusCode: 500,
+			LastError:      "unexpected status code",
+			NextAttemptAt:  createdAt,
+			CreatedAt:      createdAt,
+			Payload:        []byte(`{}`),
+		},
+	}, nil)
+	webhookService.EXPECT().CountDeliveries(gomock.Any(), filter).Return(1, nil)
+
+	h := &Handler{
+		webhookService: webhookService,
+	}
+
+	ctx := context.WithValue(context.Background(), "company_id", companyId)
+
+	got, err := h.ListDeliveries(ctx, &promopb.ListWebhookDeliveriesRequest{
+		Status: promopb.WebhookDeliveryStatus_DELIVERY_DEAD.Enum(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &promopb.ListWebhookDeliveriesResponse{
+		XTotalCount: 1,
+		Deliveries: []*promopb.WebhookDelivery{
+			{
+				Id:             "deliveryId",
+				EndpointId:     "endpointId",
+				Event:          promopb.WebhookEvent_WEBHOOK_PROMO_EXPIRED,
+				Status:         promopb.WebhookDeliveryStatus_DELIVERY_DEAD,
+				Attempts:       webhookdto.MaxAttempts,
+				LastStatusCode: pointer.ToInt64(500),
+				LastError:      pointer.To("unexpected status code"),
+				NextAttemptAt:  timestamppb.New(createdAt),
+				CreatedAt:      timestamppb.New(createdAt),
+				Payload:        `{}`,
+			},
+		},
+	}, got)
+}
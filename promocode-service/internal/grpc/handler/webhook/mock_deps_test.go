@@ -0,0 +1,236 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook -typed -destination mock_deps_test.go
+//
+
+// Package webhook is a generated GoMock package.
+package webhook
+
+import (
+	context "context"
+	reflect "reflect"
+
+	webhook "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookService is a mock of webhookService interface.
+type MockwebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookServiceMockRecorder is the mock recorder for MockwebhookService.
+type MockwebhookServiceMockRecorder struct {
+	mock *MockwebhookService
+}
+
+// NewMockwebhookService creates a new mock instance.
+func NewMockwebhookService(ctrl *gomock.Controller) *MockwebhookService {
+	mock := &MockwebhookService{ctrl: ctrl}
+	mock.recorder = &MockwebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookService) EXPECT() *MockwebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// CountDeliveries mocks base method.
+func (m *MockwebhookService) CountDeliveries(ctx context.Context, filter webhook.Filter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeliveries", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeliveries indicates an expected call of CountDeliveries.
+func (mr *MockwebhookServiceMockRecorder) CountDeliveries(ctx, filter any) *MockwebhookServiceCountDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeliveries", reflect.TypeOf((*MockwebhookService)(nil).CountDeliveries), ctx, filter)
+	return &MockwebhookServiceCountDeliveriesCall{Call: call}
+}
+
+// MockwebhookServiceCountDeliveriesCall wrap *gomock.Call
+type MockwebhookServiceCountDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookServiceCountDeliveriesCall) Return(arg0 int, arg1 error) *MockwebhookServiceCountDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookServiceCountDeliveriesCall) Do(f func(context.Context, webhook.Filter) (int, error)) *MockwebhookServiceCountDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookServiceCountDeliveriesCall) DoAndReturn(f func(context.Context, webhook.Filter) (int, error)) *MockwebhookServiceCountDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateEndpoint mocks base method.
+func (m *MockwebhookService) CreateEndpoint(ctx context.Context, endpoint *webhook.Endpoint) (*webhook.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(*webhook.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEndpoint indicates an expected call of CreateEndpoint.
+func (mr *MockwebhookServiceMockRecorder) CreateEndpoint(ctx, endpoint any) *MockwebhookServiceCreateEndpointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpoint", reflect.TypeOf((*MockwebhookService)(nil).CreateEndpoint), ctx, endpoint)
+	return &MockwebhookServiceCreateEndpointCall{Call: call}
+}
+
+// MockwebhookServiceCreateEndpointCall wrap *gomock.Call
+type MockwebhookServiceCreateEndpointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookServiceCreateEndpointCall) Return(arg0 *webhook.Endpoint, arg1 error) *MockwebhookServiceCreateEndpointCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookServiceCreateEndpointCall) Do(f func(context.Context, *webhook.Endpoint) (*webhook.Endpoint, error)) *MockwebhookServiceCreateEndpointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookServiceCreateEndpointCall) DoAndReturn(f func(context.Context, *webhook.Endpoint) (*webhook.Endpoint, error)) *MockwebhookServiceCreateEndpointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockwebhookService) DeleteEndpoint(ctx context.Context, endpointId, companyId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", ctx, endpointId, companyId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockwebhookServiceMockRecorder) DeleteEndpoint(ctx, endpointId, companyId any) *MockwebhookServiceDeleteEndpointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockwebhookService)(nil).DeleteEndpoint), ctx, endpointId, companyId)
+	return &MockwebhookServiceDeleteEndpointCall{Call: call}
+}
+
+// MockwebhookServiceDeleteEndpointCall wrap *gomock.Call
+type MockwebhookServiceDeleteEndpointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookServiceDeleteEndpointCall) Return(arg0 error) *MockwebhookServiceDeleteEndpointCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookServiceDeleteEndpointCall) Do(f func(context.Context, string, string) error) *MockwebhookServiceDeleteEndpointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookServiceDeleteEndpointCall) DoAndReturn(f func(context.Context, string, string) error) *MockwebhookServiceDeleteEndpointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeliveries mocks base method.
+func (m *MockwebhookService) ListDeliveries(ctx context.Context, filter webhook.Filter, limit, offset int) ([]webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]webhook.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockwebhookServiceMockRecorder) ListDeliveries(ctx, filter, limit, offset any) *MockwebhookServiceListDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockwebhookService)(nil).ListDeliveries), ctx, filter, limit, offset)
+	return &MockwebhookServiceListDeliveriesCall{Call: call}
+}
+
+// MockwebhookServiceListDeliveriesCall wrap *gomock.Call
+type MockwebhookServiceListDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookServiceListDeliveriesCall) Return(arg0 []webhook.Delivery, arg1 error) *MockwebhookServiceListDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookServiceListDeliveriesCall) Do(f func(context.Context, webhook.Filter, int, int) ([]webhook.Delivery, error)) *MockwebhookServiceListDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookServiceListDeliveriesCall) DoAndReturn(f func(context.Context, webhook.Filter, int, int) ([]webhook.Delivery, error)) *MockwebhookServiceListDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListEndpoints mocks base method.
+func (m *MockwebhookService) ListEndpoints(ctx context.Context, companyId string) ([]webhook.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpoints", ctx, companyId)
+	ret0, _ := ret[0].([]webhook.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpoints indicates an expected call of ListEndpoints.
+func (mr *MockwebhookServiceMockRecorder) ListEndpoints(ctx, companyId any) *MockwebhookServiceListEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpoints", reflect.TypeOf((*MockwebhookService)(nil).ListEndpoints), ctx, companyId)
+	return &MockwebhookServiceListEndpointsCall{Call: call}
+}
+
+// MockwebhookServiceListEndpointsCall wrap *gomock.Call
+type MockwebhookServiceListEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookServiceListEndpointsCall) Return(arg0 []webhook.Endpoint, arg1 error) *MockwebhookServiceListEndpointsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookServiceListEndpointsCall) Do(f func(context.Context, string) ([]webhook.Endpoint, error)) *MockwebhookServiceListEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookServiceListEndpointsCall) DoAndReturn(f func(context.Context, string) ([]webhook.Endpoint, error)) *MockwebhookServiceListEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -2,46 +2,153 @@ package interceptor
 
 import (
 	"context"
+	"crypto/subtle"
 
 	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// ServiceTokenHeader содержит токен доверенного сервиса из SERVICE_TOKENS
+const ServiceTokenHeader = "x-service-token"
+
+// UserIdHeader содержит id пользователя компании, которого аутентифицировал доверенный сервис.
+// Без действительного сервисного токена заголовок игнорируется
+const UserIdHeader = "x-user-id"
+
 type RequestWithCompanyID interface {
 	GetCompanyId() string
 }
 
-func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+// AuthInterceptor проверяет company_id для методов компании и кладёт его в контекст вместе с user_id
+// пользователя, от имени которого вызывает доверенный сервис. Методы с данными пользователя
+// доступны только сервисам из serviceTokens (name -> token)
+func AuthInterceptor(serviceTokens map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := req.(*promopb.ActivatePromoRequest); ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := req.(*promopb.RenderPromoCodeRequest); ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := req.(*promopb.QuoteDiscountRequest); ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := req.(*promopb.ResolveApplicablePromosRequest); ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := req.(*promopb.ListPromoFeedRequest); ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := req.(*promopb.PromoPingRequest); ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := req.(*healthpb.HealthCheckRequest); ok {
+			return handler(ctx, req)
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "metadata missing")
+		}
+
+		// данные пользователя не принадлежат компании, эти методы вызывает auth-service от имени пользователя
+		switch req.(type) {
+		case *promopb.ExportUserDataRequest, *promopb.ForgetUserRequest:
+			if !validServiceToken(md.Get(ServiceTokenHeader), serviceTokens) {
+				return nil, status.Error(codes.Unauthenticated, "invalid service token")
+			}
+			return handler(ctx, req)
+		}
+
+		companyIDs := md.Get("company_id")
+		if len(companyIDs) == 0 {
+
+			if reqWithCompanyID, ok := req.(RequestWithCompanyID); ok {
+				companyIDs = []string{reqWithCompanyID.GetCompanyId()}
+			}
+
+		}
+
+		if len(companyIDs) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "company_id missing")
+		}
+
+		ctx = context.WithValue(ctx, "company_id", companyIDs[0])
+
+		if userIDs := md.Get(UserIdHeader); len(userIDs) > 0 && userIDs[0] != "" && validServiceToken(md.Get(ServiceTokenHeader), serviceTokens) {
+			ctx = context.WithValue(ctx, "user_id", userIDs[0])
+		}
 
-	if _, ok := req.(*promopb.ActivatePromoRequest); ok {
 		return handler(ctx, req)
 	}
+}
+
+func validServiceToken(values []string, serviceTokens map[string]string) bool {
+	if len(values) == 0 || values[0] == "" {
+		return false
+	}
 
-	if _, ok := req.(*promopb.PromoPingRequest); ok {
-		return handler(ctx, req)
+	for _, token := range serviceTokens {
+		if subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) == 1 {
+			return true
+		}
 	}
+	return false
+}
 
-	md, ok := metadata.FromIncomingContext(ctx)
+// StreamAuthInterceptor проверяет company_id для потоковых методов. Если company_id нет в метаданных,
+// он берётся из первого сообщения клиента, как и в AuthInterceptor
+func StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.FullMethod == healthpb.Health_Watch_FullMethodName {
+		return handler(srv, ss)
+	}
+
+	md, ok := metadata.FromIncomingContext(ss.Context())
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "metadata missing")
+		return status.Error(codes.Unauthenticated, "metadata missing")
 	}
 
-	companyIDs := md.Get("company_id")
-	if len(companyIDs) == 0 {
+	stream := &authServerStream{ServerStream: ss, ctx: ss.Context()}
 
-		if reqWithCompanyID, ok := req.(RequestWithCompanyID); ok {
-			companyIDs = []string{reqWithCompanyID.GetCompanyId()}
-		}
+	if companyIDs := md.Get("company_id"); len(companyIDs) > 0 {
+		stream.ctx = context.WithValue(stream.ctx, "company_id", companyIDs[0])
+	}
+
+	return handler(srv, stream)
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
 
+	if s.ctx.Value("company_id") != nil {
+		return nil
 	}
 
-	if len(companyIDs) == 0 {
-		return nil, status.Error(codes.Unauthenticated, "company_id missing")
+	if reqWithCompanyID, ok := m.(RequestWithCompanyID); ok && reqWithCompanyID.GetCompanyId() != "" {
+		s.ctx = context.WithValue(s.ctx, "company_id", reqWithCompanyID.GetCompanyId())
+		return nil
 	}
 
-	ctx = context.WithValue(ctx, "company_id", companyIDs[0])
-	return handler(ctx, req)
+	return status.Error(codes.Unauthenticated, "company_id missing")
 }
@@ -0,0 +1,73 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor_UserDataRequiresServiceToken(t *testing.T) {
+	auth := AuthInterceptor(map[string]string{"auth-service": "secret"})
+	info := &grpc.UnaryServerInfo{FullMethod: promopb.PromoService_ForgetUser_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &promopb.ForgetUserResponse{}, nil
+	}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		code codes.Code
+	}{
+		{name: "без токена", md: metadata.MD{}, code: codes.Unauthenticated},
+		{name: "только company_id", md: metadata.Pairs("company_id", "company-1"), code: codes.Unauthenticated},
+		{name: "чужой токен", md: metadata.Pairs(ServiceTokenHeader, "other"), code: codes.Unauthenticated},
+		{name: "токен сервиса", md: metadata.Pairs(ServiceTokenHeader, "secret"), code: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			_, err := auth(ctx, &promopb.ForgetUserRequest{UserId: "user-1"}, info, handler)
+			require.Equal(t, tt.code, status.Code(err))
+
+			_, err = auth(ctx, &promopb.ExportUserDataRequest{UserId: "user-1"}, info, handler)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+// Тест: user_id попадает в контекст только вместе с действительным сервисным токеном
+func TestAuthInterceptor_UserIdRequiresServiceToken(t *testing.T) {
+	auth := AuthInterceptor(map[string]string{"auth-service": "secret"})
+	info := &grpc.UnaryServerInfo{FullMethod: promopb.PromoService_DeletePromo_FullMethodName}
+
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		wantUserId any
+	}{
+		{name: "без токена", md: metadata.Pairs("company_id", "company-1", UserIdHeader, "user-1"), wantUserId: nil},
+		{name: "чужой токен", md: metadata.Pairs("company_id", "company-1", UserIdHeader, "user-1", ServiceTokenHeader, "other"), wantUserId: nil},
+		{name: "токен сервиса", md: metadata.Pairs("company_id", "company-1", UserIdHeader, "user-1", ServiceTokenHeader, "secret"), wantUserId: "user-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			_, err := auth(ctx, &promopb.DeletePromoRequest{PromoId: "promo-1"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				require.Equal(t, "company-1", ctx.Value("company_id"))
+				require.Equal(t, tt.wantUserId, ctx.Value("user_id"))
+				return &promopb.DeletePromoResponse{}, nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/requestid"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// IdempotencyKeyHeader метаданные, в которых auth-service передаёт Idempotency-Key клиента
+	IdempotencyKeyHeader = "idempotency-key"
+	// IdempotentReplayedHeader выставляется в ответе, возвращённом из сохранённого результата
+	IdempotentReplayedHeader = "idempotent-replayed"
+
+	idempotencyKeyMaxLen = 255
+
+	// idempotencyStoreTimeout время на сохранение результата после того, как клиент отключился
+	idempotencyStoreTimeout = 5 * time.Second
+
+	// IdempotencyErrorDomain домен errdetails.ErrorInfo в ошибках самого интерсептора. По нему
+	// auth-service отличает их от ошибок обработчика с теми же кодами
+	IdempotencyErrorDomain = "idempotency"
+)
+
+// Причины errdetails.ErrorInfo в ошибках интерсептора
+const (
+	IdempotencyReasonInvalidKey         = "INVALID_KEY"
+	IdempotencyReasonKeyReused          = "KEY_REUSED"
+	IdempotencyReasonInProgress         = "IN_PROGRESS"
+	IdempotencyReasonStorageUnavailable = "STORAGE_UNAVAILABLE"
+)
+
+// releasableCodes ошибки, которые обработчики возвращают до записи в базу: проверка запроса, прав,
+// существования промокода и лимитов активации в откатываемой транзакции. После них ключ освобождается,
+// и запрос можно повторить. Остальные ошибки сохраняются под ключом: Create мог сохранить промокод
+// и упасть на кодах, и повтор создал бы второй промокод
+var releasableCodes = map[codes.Code]bool{
+	codes.InvalidArgument:   true,
+	codes.Unauthenticated:   true,
+	codes.PermissionDenied:  true,
+	codes.NotFound:          true,
+	codes.ResourceExhausted: true,
+}
+
+type idempotencyRepository interface {
+	Begin(ctx context.Context, scope string, fingerprint string) (*model.IdempotencyRecord, error)
+	Complete(ctx context.Context, scope string, record model.IdempotencyRecord) error
+	Release(ctx context.Context, scope string) error
+}
+
+type requestWithUserID interface {
+	GetUserId() string
+}
+
+// IdempotencyInterceptor повтор вызова methods с тем же idempotency-key возвращает сохранённый ответ
+// первого вызова, а не выполняет его снова. Ключ с другим телом запроса отклоняется. Ключ действует
+// в пределах метода, компании и пользователя, вызовы без ключа выполняются как обычно.
+// Стоит после AuthInterceptor, чтобы company_id уже был в контексте
+func IdempotencyInterceptor(log *zap.Logger, repository idempotencyRepository, methods ...string) grpc.UnaryServerInterceptor {
+	idempotent := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		idempotent[method] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		message, ok := req.(proto.Message)
+		if !idempotent[info.FullMethod] || !ok {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(IdempotencyKeyHeader)
+		if len(keys) == 0 {
+			return handler(ctx, req)
+		}
+		if keys[0] == "" || len(keys[0]) > idempotencyKeyMaxLen {
+			return nil, idempotencyError(codes.InvalidArgument, IdempotencyReasonInvalidKey, "invalid idempotency key")
+		}
+
+		fingerprint, err := requestFingerprint(info.FullMethod, message)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		scope := idempotencyScope(ctx, info.FullMethod, req, keys[0])
+		log := log.With(append(requestid.LogFields(ctx), zap.String("method", info.FullMethod))...)
+
+		record, err := repository.Begin(ctx, scope, fingerprint)
+		if err != nil {
+			log.Error("idempotencyRepository.Begin: failed to reserve idempotency key", zap.Error(err))
+			return nil, idempotencyError(codes.Unavailable, IdempotencyReasonStorageUnavailable, "idempotency storage unavailable")
+		}
+
+		if record != nil {
+			return replay(ctx, record, fingerprint)
+		}
+
+		resp, err := handler(ctx, req)
+
+		// клиент, не дождавшийся ответа, отменяет ctx и повторяет запрос с тем же ключом, поэтому
+		// результат сохраняется без отмены: иначе ключ остался бы pending, и повтор после lockTTL
+		// выполнил бы запрос второй раз
+		storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), idempotencyStoreTimeout)
+		defer cancel()
+
+		if err != nil {
+			if releasableCodes[status.Code(err)] {
+				if releaseErr := repository.Release(storeCtx, scope); releaseErr != nil {
+					log.Error("idempotencyRepository.Release: failed to release idempotency key", zap.Error(releaseErr))
+				}
+			} else if storeErr := completeWithError(storeCtx, repository, scope, fingerprint, err); storeErr != nil {
+				log.Error("failed to save idempotent error", zap.Error(storeErr))
+			}
+			return resp, err
+		}
+
+		if err := complete(storeCtx, repository, scope, fingerprint, resp); err != nil {
+			log.Error("failed to save idempotent response", zap.Error(err))
+		}
+
+		return resp, nil
+	}
+}
+
+func replay(ctx context.Context, record *model.IdempotencyRecord, fingerprint string) (interface{}, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, idempotencyError(codes.FailedPrecondition, IdempotencyReasonKeyReused, "idempotency key was used with a different request")
+	}
+	if !record.Done {
+		return nil, idempotencyError(codes.Aborted, IdempotencyReasonInProgress, "request with this idempotency key is in progress")
+	}
+
+	if len(record.Error) > 0 {
+		saved := &spb.Status{}
+		if err := proto.Unmarshal(record.Error, saved); err != nil {
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedHeader, "true"))
+
+		return nil, status.ErrorProto(saved)
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(record.ResponseType))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := messageType.New().Interface()
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedHeader, "true"))
+
+	return resp, nil
+}
+
+func complete(ctx context.Context, repository idempotencyRepository, scope string, fingerprint string, resp interface{}) error {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return repository.Release(ctx, scope)
+	}
+
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return repository.Complete(ctx, scope, model.IdempotencyRecord{
+		Fingerprint:  fingerprint,
+		ResponseType: string(message.ProtoReflect().Descriptor().FullName()),
+		Response:     data,
+	})
+}
+
+// idempotencyError ошибка интерсептора, помеченная IdempotencyErrorDomain
+func idempotencyError(code codes.Code, reason string, message string) error {
+	st, err := status.New(code, message).WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: IdempotencyErrorDomain})
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+func completeWithError(ctx context.Context, repository idempotencyRepository, scope string, fingerprint string, handlerErr error) error {
+	data, err := proto.Marshal(status.Convert(handlerErr).Proto())
+	if err != nil {
+		return err
+	}
+
+	return repository.Complete(ctx, scope, model.IdempotencyRecord{
+		Fingerprint: fingerprint,
+		Error:       data,
+	})
+}
+
+// requestFingerprint хеш тела запроса. Deterministic нужен, чтобы одинаковые map давали одинаковые байты
+func requestFingerprint(method string, message proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(append([]byte(method+"\x00"), data...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// idempotencyScope ключ хранения. Хешируется, чтобы длина ключа клиента не влияла на ключ redis
+func idempotencyScope(ctx context.Context, method string, req interface{}, key string) string {
+	companyID, _ := ctx.Value("company_id").(string)
+
+	var userID string
+	if withUser, ok := req.(requestWithUserID); ok {
+		userID = withUser.GetUserId()
+	}
+
+	sum := sha256.Sum256([]byte(method + "\x00" + companyID + "\x00" + userID + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,211 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type memoryIdempotencyRepository struct {
+	records map[string]model.IdempotencyRecord
+}
+
+func (r *memoryIdempotencyRepository) Begin(_ context.Context, scope string, fingerprint string) (*model.IdempotencyRecord, error) {
+	if record, ok := r.records[scope]; ok {
+		return &record, nil
+	}
+	r.records[scope] = model.IdempotencyRecord{Fingerprint: fingerprint}
+	return nil, nil
+}
+
+func (r *memoryIdempotencyRepository) Complete(ctx context.Context, scope string, record model.IdempotencyRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	record.Done = true
+	r.records[scope] = record
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) Release(ctx context.Context, scope string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	delete(r.records, scope)
+	return nil
+}
+
+func withIdempotencyKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+}
+
+func requireIdempotencyReason(t *testing.T, err error, reason string) {
+	t.Helper()
+
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	info, ok := details[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, IdempotencyErrorDomain, info.GetDomain())
+	require.Equal(t, reason, info.GetReason())
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	activate := &grpc.UnaryServerInfo{FullMethod: promopb.PromoService_ActivatePromo_FullMethodName}
+	userId := "user-1"
+
+	newInterceptor := func() (grpc.UnaryServerInterceptor, *memoryIdempotencyRepository) {
+		repository := &memoryIdempotencyRepository{records: map[string]model.IdempotencyRecord{}}
+		return IdempotencyInterceptor(zap.NewNop(), repository, promopb.PromoService_ActivatePromo_FullMethodName), repository
+	}
+
+	countingHandler := func(calls *int) grpc.UnaryHandler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			*calls++
+			return &promopb.ActivatePromoResponse{Code: "CODE-1", SuccessActivation: true}, nil
+		}
+	}
+
+	t.Run("repeat returns original response", func(t *testing.T) {
+		interceptor, _ := newInterceptor()
+		calls := 0
+		req := &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}
+
+		first, err := interceptor(withIdempotencyKey("key-1"), req, activate, countingHandler(&calls))
+		require.NoError(t, err)
+
+		second, err := interceptor(withIdempotencyKey("key-1"), req, activate, countingHandler(&calls))
+		require.NoError(t, err)
+
+		require.Equal(t, 1, calls)
+		require.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+	})
+
+	t.Run("different payload under same key is rejected", func(t *testing.T) {
+		interceptor, _ := newInterceptor()
+		calls := 0
+
+		_, err := interceptor(withIdempotencyKey("key-1"), &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}, activate, countingHandler(&calls))
+		require.NoError(t, err)
+
+		_, err = interceptor(withIdempotencyKey("key-1"), &promopb.ActivatePromoRequest{PromoId: "promo-2", UserId: &userId}, activate, countingHandler(&calls))
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		requireIdempotencyReason(t, err, IdempotencyReasonKeyReused)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("request in progress is not repeated", func(t *testing.T) {
+		interceptor, _ := newInterceptor()
+		req := &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}
+
+		var nestedErr error
+		_, err := interceptor(withIdempotencyKey("key-1"), req, activate, func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, nestedErr = interceptor(withIdempotencyKey("key-1"), req, activate, func(ctx context.Context, req interface{}) (interface{}, error) {
+				t.Fatal("handler must not run while the first request is in progress")
+				return nil, nil
+			})
+			return &promopb.ActivatePromoResponse{}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, codes.Aborted, status.Code(nestedErr))
+		requireIdempotencyReason(t, nestedErr, IdempotencyReasonInProgress)
+	})
+
+	t.Run("request rejected before any write releases key", func(t *testing.T) {
+		interceptor, repository := newInterceptor()
+		req := &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}
+
+		_, err := interceptor(withIdempotencyKey("key-1"), req, activate, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "promo not found")
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Empty(t, repository.records)
+
+		calls := 0
+		_, err = interceptor(withIdempotencyKey("key-1"), req, activate, countingHandler(&calls))
+		require.NoError(t, err)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("failed request is not repeated", func(t *testing.T) {
+		interceptor, _ := newInterceptor()
+		calls := 0
+		req := &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}
+		failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return nil, errors.New("codes inserted partially")
+		}
+
+		_, err := interceptor(withIdempotencyKey("key-1"), req, activate, failing)
+		require.Error(t, err)
+
+		// обработчик мог записать часть данных до ошибки, поэтому повтор возвращает ту же ошибку
+		_, replayErr := interceptor(withIdempotencyKey("key-1"), req, activate, failing)
+		require.Equal(t, 1, calls)
+		require.Equal(t, status.Code(err), status.Code(replayErr))
+		require.Equal(t, status.Convert(err).Message(), status.Convert(replayErr).Message())
+		// ошибка обработчика не помечается как ошибка ключа
+		require.Empty(t, status.Convert(replayErr).Details())
+	})
+
+	t.Run("response is saved after client cancels", func(t *testing.T) {
+		interceptor, _ := newInterceptor()
+		req := &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}
+
+		ctx, cancel := context.WithCancel(withIdempotencyKey("key-1"))
+		_, err := interceptor(ctx, req, activate, func(ctx context.Context, req interface{}) (interface{}, error) {
+			// клиент отключился по таймауту, пока обработчик выполнялся
+			cancel()
+			return &promopb.ActivatePromoResponse{Code: "CODE-1", SuccessActivation: true}, nil
+		})
+		require.NoError(t, err)
+
+		calls := 0
+		replayed, err := interceptor(withIdempotencyKey("key-1"), req, activate, countingHandler(&calls))
+		require.NoError(t, err)
+		require.Zero(t, calls)
+		require.Equal(t, "CODE-1", replayed.(*promopb.ActivatePromoResponse).GetCode())
+	})
+
+	t.Run("keys are scoped by user", func(t *testing.T) {
+		interceptor, _ := newInterceptor()
+		calls := 0
+		otherUserId := "user-2"
+
+		_, err := interceptor(withIdempotencyKey("key-1"), &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}, activate, countingHandler(&calls))
+		require.NoError(t, err)
+
+		_, err = interceptor(withIdempotencyKey("key-1"), &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &otherUserId}, activate, countingHandler(&calls))
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("requests without key and other methods are not tracked", func(t *testing.T) {
+		interceptor, repository := newInterceptor()
+		calls := 0
+		req := &promopb.ActivatePromoRequest{PromoId: "promo-1", UserId: &userId}
+
+		for range 2 {
+			_, err := interceptor(context.Background(), req, activate, countingHandler(&calls))
+			require.NoError(t, err)
+		}
+
+		other := &grpc.UnaryServerInfo{FullMethod: promopb.PromoService_GetPromo_FullMethodName}
+		_, err := interceptor(withIdempotencyKey("key-1"), &promopb.GetPromoRequest{PromoId: "promo-1"}, other, countingHandler(&calls))
+		require.NoError(t, err)
+
+		require.Equal(t, 3, calls)
+		require.Empty(t, repository.records)
+	})
+}
@@ -2,37 +2,66 @@ package interceptor
 
 import (
 	"context"
+
+	"gitlab.com/pisya-dev/account-service/pkg/reqlog"
+	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/requestid"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
-func LogRequestUnaryInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
+// LogRequestUnaryInterceptor принимает x-request-id из метаданных или создаёт новый, кладёт его
+// в контекст, возвращает в заголовках ответа и пишет в лог метод, код, длительность вызова и тело
+// запроса со скрытыми полями. Уровень и семплирование успешных вызовов задаёт policy
+func LogRequestUnaryInterceptor(log *zap.Logger, policy *reqlog.Policy) grpc.UnaryServerInterceptor {
+	logRequest := policy.UnaryServerInterceptor(logFunc(log))
+
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp any, err error) {
+		return logRequest(withRequestID(ctx, log), req, info, handler)
+	}
+}
+
+// LogRequestStreamInterceptor то же для потоковых методов, без тела запроса
+func LogRequestStreamInterceptor(log *zap.Logger, policy *reqlog.Policy) grpc.StreamServerInterceptor {
+	logRequest := policy.StreamServerInterceptor(logFunc(log))
 
-		log.Info("Handling request",
-			zap.String("method", info.FullMethod),
-			zap.Any("request", req),
-		)
-
-		resp, err = handler(ctx, req)
-
-		if err != nil {
-			log.Info("Error handling request",
-				zap.String("method", info.FullMethod),
-				zap.Error(err),
-			)
-			return nil, err
-		}
-
-		log.Info("Successfully handled request",
-			zap.String("method", info.FullMethod),
-			zap.Any("response", resp),
-		)
-		return resp, err
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := &requestIDServerStream{ServerStream: ss, ctx: withRequestID(ss.Context(), log)}
+
+		return logRequest(srv, stream, info, handler)
 	}
 }
+
+func withRequestID(ctx context.Context, log *zap.Logger) context.Context {
+	requestID := requestid.FromIncoming(ctx)
+
+	ctx = requestid.NewContext(ctx, requestID)
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestid.Header, requestID)); err != nil {
+		log.Warn("failed to set request id header", zap.String("request_id", requestID), zap.Error(err))
+	}
+
+	return ctx
+}
+
+// logFunc пишет строки reqlog с request_id текущего запроса
+func logFunc(log *zap.Logger) reqlog.LogFunc {
+	return func(ctx context.Context, level zapcore.Level, msg string, fields ...zap.Field) {
+		log.Log(level, msg, append(requestid.LogFields(ctx), fields...)...)
+	}
+}
+
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
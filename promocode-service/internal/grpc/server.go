@@ -3,21 +3,27 @@ package promogrpc
 import (
 	"context"
 
+	"google.golang.org/grpc"
+
 	handlerPromo "gitlab.com/pisya-dev/promo-code-service/internal/grpc/handler/promo"
+	handlerWebhook "gitlab.com/pisya-dev/promo-code-service/internal/grpc/handler/webhook"
 	promopb "gitlab.com/pisya-dev/promo-code-service/pkg/api/pb"
 )
 
 type ServerAPI struct {
 	promopb.UnimplementedPromoServiceServer
-	promoHandler *handlerPromo.Handler
+	promoHandler   *handlerPromo.Handler
+	webhookHandler *handlerWebhook.Handler
 }
 
 func New(
 	promoHandler *handlerPromo.Handler,
+	webhookHandler *handlerWebhook.Handler,
 ) *ServerAPI {
 	return &ServerAPI{
 		UnimplementedPromoServiceServer: promopb.UnimplementedPromoServiceServer{},
 		promoHandler:                    promoHandler,
+		webhookHandler:                  webhookHandler,
 	}
 }
 
@@ -33,6 +39,10 @@ func (s *ServerAPI) ListPromo(ctx context.Context, request *promopb.ListPromoReq
 	return s.promoHandler.ListPromo(ctx, request)
 }
 
+func (s *ServerAPI) ListPromoFeed(ctx context.Context, request *promopb.ListPromoFeedRequest) (*promopb.ListPromoResponse, error) {
+	return s.promoHandler.ListPromoFeed(ctx, request)
+}
+
 func (s *ServerAPI) GetPromo(ctx context.Context, request *promopb.GetPromoRequest) (*promopb.GetPromoResponse, error) {
 	return s.promoHandler.GetById(ctx, request)
 }
@@ -45,6 +55,74 @@ func (s *ServerAPI) DeletePromo(ctx context.Context, request *promopb.DeleteProm
 	return s.promoHandler.Delete(ctx, request)
 }
 
+func (s *ServerAPI) RestorePromo(ctx context.Context, request *promopb.RestorePromoRequest) (*promopb.RestorePromoResponse, error) {
+	return s.promoHandler.Restore(ctx, request)
+}
+
+func (s *ServerAPI) RenderPromoCode(ctx context.Context, request *promopb.RenderPromoCodeRequest) (*promopb.RenderPromoCodeResponse, error) {
+	return s.promoHandler.RenderCode(ctx, request)
+}
+
+func (s *ServerAPI) ExportPromoData(request *promopb.ExportPromoDataRequest, stream grpc.ServerStreamingServer[promopb.ExportPromoDataChunk]) error {
+	return s.promoHandler.Export(request, stream)
+}
+
+func (s *ServerAPI) SetPromoImage(ctx context.Context, request *promopb.SetPromoImageRequest) (*promopb.SetPromoImageResponse, error) {
+	return s.promoHandler.SetImage(ctx, request)
+}
+
+func (s *ServerAPI) ExportUserData(ctx context.Context, request *promopb.ExportUserDataRequest) (*promopb.ExportUserDataResponse, error) {
+	return s.promoHandler.ExportUserData(ctx, request)
+}
+
+func (s *ServerAPI) ForgetUser(ctx context.Context, request *promopb.ForgetUserRequest) (*promopb.ForgetUserResponse, error) {
+	return s.promoHandler.ForgetUser(ctx, request)
+}
+
+func (s *ServerAPI) QuoteDiscount(ctx context.Context, r *promopb.QuoteDiscountRequest) (*promopb.QuoteDiscountResponse, error) {
+	return s.promoHandler.QuoteDiscount(ctx, r)
+}
+
+func (s *ServerAPI) ResolveApplicablePromos(ctx context.Context, r *promopb.ResolveApplicablePromosRequest) (*promopb.ResolveApplicablePromosResponse, error) {
+	return s.promoHandler.ResolveApplicablePromos(ctx, r)
+}
+
 func (s *ServerAPI) ActivatePromo(ctx context.Context, r *promopb.ActivatePromoRequest) (*promopb.ActivatePromoResponse, error) {
 	return s.promoHandler.Activate(ctx, r)
 }
+
+func (s *ServerAPI) PublishPromo(ctx context.Context, r *promopb.PublishPromoRequest) (*promopb.PublishPromoResponse, error) {
+	return s.promoHandler.Publish(ctx, r)
+}
+
+func (s *ServerAPI) PausePromo(ctx context.Context, r *promopb.PausePromoRequest) (*promopb.PausePromoResponse, error) {
+	return s.promoHandler.Pause(ctx, r)
+}
+
+func (s *ServerAPI) ResumePromo(ctx context.Context, r *promopb.ResumePromoRequest) (*promopb.ResumePromoResponse, error) {
+	return s.promoHandler.Resume(ctx, r)
+}
+
+func (s *ServerAPI) ArchivePromo(ctx context.Context, r *promopb.ArchivePromoRequest) (*promopb.ArchivePromoResponse, error) {
+	return s.promoHandler.Archive(ctx, r)
+}
+
+func (s *ServerAPI) ListPromoAuditLog(ctx context.Context, r *promopb.ListPromoAuditLogRequest) (*promopb.ListPromoAuditLogResponse, error) {
+	return s.promoHandler.ListPromoAuditLog(ctx, r)
+}
+
+func (s *ServerAPI) CreateWebhookEndpoint(ctx context.Context, r *promopb.CreateWebhookEndpointRequest) (*promopb.CreateWebhookEndpointResponse, error) {
+	return s.webhookHandler.CreateEndpoint(ctx, r)
+}
+
+func (s *ServerAPI) ListWebhookEndpoints(ctx context.Context, r *promopb.ListWebhookEndpointsRequest) (*promopb.ListWebhookEndpointsResponse, error) {
+	return s.webhookHandler.ListEndpoints(ctx, r)
+}
+
+func (s *ServerAPI) DeleteWebhookEndpoint(ctx context.Context, r *promopb.DeleteWebhookEndpointRequest) (*promopb.DeleteWebhookEndpointResponse, error) {
+	return s.webhookHandler.DeleteEndpoint(ctx, r)
+}
+
+func (s *ServerAPI) ListWebhookDeliveries(ctx context.Context, r *promopb.ListWebhookDeliveriesRequest) (*promopb.ListWebhookDeliveriesResponse, error) {
+	return s.webhookHandler.ListDeliveries(ctx, r)
+}
@@ -0,0 +1,76 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header метаданные со сквозным идентификатором запроса. Его выдаёт auth-service,
+// остальные сервисы передают его дальше и возвращают в заголовках ответа
+const Header = "x-request-id"
+
+// maxLen ограничивает длину принятого идентификатора, чтобы он не раздувал логи
+const maxLen = 128
+
+type key struct{}
+
+func NewContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, key{}, requestID)
+}
+
+func FromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(key{}).(string)
+	return requestID
+}
+
+// LogFields request_id текущего запроса для строк лога
+func LogFields(ctx context.Context) []zap.Field {
+	requestID := FromContext(ctx)
+	if requestID == "" {
+		return nil
+	}
+
+	return []zap.Field{zap.String("request_id", requestID)}
+}
+
+// FromIncoming берёт идентификатор вызывающего сервиса или создаёт новый, если его нет
+// или он не похож на идентификатор
+func FromIncoming(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(Header); len(values) > 0 && valid(values[0]) {
+		return values[0]
+	}
+
+	return uuid.NewString()
+}
+
+// UnaryClientInterceptor передаёт идентификатор запроса из контекста в исходящие метаданные
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(outgoing(ctx), method, req, reply, cc, opts...)
+}
+
+func outgoing(ctx context.Context) context.Context {
+	if requestID := FromContext(ctx); requestID != "" {
+		return metadata.AppendToOutgoingContext(ctx, Header, requestID)
+	}
+
+	return ctx
+}
+
+func valid(id string) bool {
+	if id == "" || len(id) > maxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.' || c == ':') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,51 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFromIncoming(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(Header, "req-42"))
+	require.Equal(t, "req-42", FromIncoming(ctx))
+
+	for _, invalid := range []string{"", "with space", "line\nbreak", strings.Repeat("a", maxLen+1)} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(Header, invalid))
+
+		generated := FromIncoming(ctx)
+		require.NotEqual(t, invalid, generated)
+		require.True(t, valid(generated))
+	}
+
+	require.NotEmpty(t, FromIncoming(context.Background()))
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := UnaryClientInterceptor(NewContext(context.Background(), "req-42"), "/api.Account_Service/GetBuisness", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Equal(t, []string{"req-42"}, md.Get(Header))
+
+	err = UnaryClientInterceptor(context.Background(), "/api.Account_Service/GetBuisness", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Empty(t, md.Get(Header))
+}
+
+func TestLogFields(t *testing.T) {
+	require.Empty(t, LogFields(context.Background()))
+
+	fields := LogFields(NewContext(context.Background(), "req-42"))
+	require.Len(t, fields, 1)
+	require.Equal(t, "request_id", fields[0].Key)
+	require.Equal(t, "req-42", fields[0].String)
+}
@@ -6,15 +6,20 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
 	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
 	promoStorage "gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
 )
 
 type promoRepository interface {
 	Create(ctx context.Context, promoModel *model.Promo) (id string, err error)
-	List(ctx context.Context, companyId string, countries []string, offset int, sortBy promo.SortBy, limit int) (promoModels []promoStorage.PromoDetails, err error)
-	Count(ctx context.Context, companyId string, countries []string) (count int, err error)
+	List(ctx context.Context, companyId string, countries []string, offset int, sortBy promo.SortBy, limit int, searchQuery string) (promoModels []promoStorage.PromoDetails, err error)
+	Count(ctx context.Context, companyId string, countries []string, searchQuery string) (count int, err error)
+	Feed(ctx context.Context, category string, offset int, limit int, searchQuery string) (promoModels []promoStorage.PromoDetails, err error)
+	FeedCount(ctx context.Context, category string, searchQuery string) (count int, err error)
 	GetById(ctx context.Context, promoId string) (promoModel *promoStorage.PromoDetails, err error)
 	Update(
 		ctx context.Context,
@@ -27,17 +32,49 @@ type promoRepository interface {
 		targetCategories []string,
 		activeFrom time.Time,
 		activeUntil time.Time,
-	) error
+		reward *model.Reward,
+		stacking *model.Stacking,
+		activationLimits *model.ActivationLimits,
+		referralReward *model.ReferralReward,
+		localization *model.Localization,
+		from promo.Status,
+		to promo.Status,
+	) (updated bool, err error)
+	GetCompanyId(ctx context.Context, promoId string) (companyId string, err error)
+	SetImage(ctx context.Context, promoId string, imageUrl string) error
 	Delete(ctx context.Context, promoId string) error
+	Restore(ctx context.Context, promoId string, companyId string, deletedAfter time.Time) (restored bool, err error)
+	Purge(ctx context.Context, deletedBefore time.Time) (purged []promoStorage.PurgedPromo, err error)
+	UpdateStatus(ctx context.Context, promoId string, from promo.Status, to promo.Status) (updated bool, err error)
+	AdvanceStatuses(ctx context.Context, now time.Time) (changes []promoStorage.StatusChange, err error)
 }
 
 type promoCodeRepository interface {
 	Create(ctx context.Context, promoCodeModel *model.PromoCode) (id string, err error)
-	Activate(ctx context.Context, promoId string) (code string, err error)
+	Activate(ctx context.Context, promoId string, userId string) (code string, exhausted bool, err error)
+	GetByCode(ctx context.Context, code string) (promoCodeModel *model.PromoCode, err error)
+	GetActivatedCode(ctx context.Context, promoId string, userId string, code string) (string, error)
+	ExportCodes(ctx context.Context, promoId string, fn func(promoCode *model.PromoCode) error) error
+	ExportActivations(ctx context.Context, promoId string, fn func(activation *model.PromoActivation) error) error
+	ListUserActivations(ctx context.Context, userId string) (activations []model.UserActivation, err error)
+	AnonymizeActivations(ctx context.Context, userId string) (anonymized int64, err error)
+}
+
+type auditRepository interface {
+	Create(ctx context.Context, entry *model.AuditEntry) error
+	List(ctx context.Context, filter audit.Filter, offset int, limit int) (entries []model.AuditEntry, err error)
+	Count(ctx context.Context, filter audit.Filter) (count int, err error)
 }
 
 type accountServiceClient interface {
 	GetCompanyNameByCompanyID(ctx context.Context, companyID string) (companyName string, err error)
+	ConvertReferral(ctx context.Context, refereeId string, promoId string, companyId string, reward *referral.Reward) (credited bool, err error)
+}
+
+type webhookPublisher interface {
+	Publish(ctx context.Context, companyId string, event webhookenum.Event, data any) error
+	// ForgetUser удаляет идентификатор пользователя из сохранённых событий
+	ForgetUser(ctx context.Context, userId string) (scrubbed int, err error)
 }
 
 type redisDb interface {
@@ -0,0 +1,60 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	exportenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/export"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/tabular"
+)
+
+// Export выгружает коды или активации промокода компании в w в формате format.
+// Права проверяются до записи первого байта, после этого ошибка означает оборванную выгрузку
+func (s *Service) Export(ctx context.Context, promoId string, companyId string, dataset exportenum.Dataset, format tabular.Format, w io.Writer) error {
+	promoCompanyId, err := s.promoRepository.GetCompanyId(ctx, promoId)
+	if err != nil {
+		return fmt.Errorf("promoRepository.GetCompanyId: %w", err)
+	}
+
+	if promoCompanyId == "" {
+		return ErrNotFound
+	}
+
+	if promoCompanyId != companyId {
+		return ErrPermissionDenied
+	}
+
+	tw, err := tabular.NewWriter(w, format)
+	if err != nil {
+		return fmt.Errorf("tabular.NewWriter: %w", err)
+	}
+
+	switch dataset {
+	case exportenum.DatasetActivations:
+		if err = tw.WriteRow("code", "user_id", "activated_at"); err != nil {
+			return err
+		}
+
+		err = s.promoCodeRepository.ExportActivations(ctx, promoId, func(activation *model.PromoActivation) error {
+			return tw.WriteRow(activation.Code, activation.UserId.String, activation.ActivatedAt)
+		})
+		if err != nil {
+			return fmt.Errorf("promoCodeRepository.ExportActivations: %w", err)
+		}
+	default:
+		if err = tw.WriteRow("code", "activations", "max_count"); err != nil {
+			return err
+		}
+
+		err = s.promoCodeRepository.ExportCodes(ctx, promoId, func(promoCode *model.PromoCode) error {
+			return tw.WriteRow(promoCode.Code, promoCode.Activations, promoCode.MaxCount)
+		})
+		if err != nil {
+			return fmt.Errorf("promoCodeRepository.ExportCodes: %w", err)
+		}
+	}
+
+	return tw.Close()
+}
@@ -0,0 +1,30 @@
+package promo
+
+import (
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+)
+
+func activationLimitsToModel(policies []limit.Policy) model.ActivationLimits {
+	limitModels := make(model.ActivationLimits, 0, len(policies))
+	for _, policy := range policies {
+		limitModels = append(limitModels, model.ActivationLimit{
+			Scope:  policy.Scope,
+			Window: policy.Window,
+			Max:    policy.Max,
+		})
+	}
+	return limitModels
+}
+
+func activationLimitsFromModel(limitModels model.ActivationLimits) []limit.Policy {
+	policies := make([]limit.Policy, 0, len(limitModels))
+	for _, limitModel := range limitModels {
+		policies = append(policies, limit.Policy{
+			Scope:  limitModel.Scope,
+			Window: limitModel.Window,
+			Max:    limitModel.Max,
+		})
+	}
+	return policies
+}
@@ -0,0 +1,29 @@
+package promo
+
+import (
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+)
+
+func localizationToModel(l *localization.Localization) model.Localization {
+	if l == nil {
+		return model.Localization{DescriptionTranslations: model.DescriptionTranslations{}}
+	}
+
+	translations := make(model.DescriptionTranslations, len(l.Translations))
+	for locale, description := range l.Translations {
+		translations[locale] = description
+	}
+
+	return model.Localization{
+		DefaultLocale:           l.DefaultLocale,
+		DescriptionTranslations: translations,
+	}
+}
+
+func localizationFromModel(localizationModel model.Localization) localization.Localization {
+	return localization.Localization{
+		DefaultLocale: localizationModel.DefaultLocale,
+		Translations:  localizationModel.DescriptionTranslations,
+	}
+}
@@ -0,0 +1,36 @@
+package promo
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// MetricsNamespace префикс метрик promocode-service, общие метрики регистрируются с ним же
+const MetricsNamespace = "promo"
+
+// Бизнес-метрики промокодов
+var (
+	promosCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "created_total",
+		Help:      "Number of created promos by mode",
+	}, []string{"mode"})
+
+	activations = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "activations_total",
+		Help:      "Number of successful promo activations",
+	})
+
+	fraudRejections = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "fraud_rejections_total",
+		Help:      "Number of activations rejected by antifraud",
+	})
+
+	promosExhausted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "exhausted_total",
+		Help:      "Number of promos that ran out of activations",
+	})
+)
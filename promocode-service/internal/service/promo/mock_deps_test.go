@@ -11,13 +11,16 @@ package promo
 
 import (
 	context "context"
-	promo "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
-	model "gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
-	promo0 "gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
 	reflect "reflect"
 	time "time"
 
 	redis "github.com/redis/go-redis/v9"
+	audit "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	referral "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	promo "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	webhook "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	model "gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	promo0 "gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -45,19 +48,58 @@ func (m *MockpromoRepository) EXPECT() *MockpromoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AdvanceStatuses mocks base method.
+func (m *MockpromoRepository) AdvanceStatuses(ctx context.Context, now time.Time) ([]promo0.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceStatuses", ctx, now)
+	ret0, _ := ret[0].([]promo0.StatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceStatuses indicates an expected call of AdvanceStatuses.
+func (mr *MockpromoRepositoryMockRecorder) AdvanceStatuses(ctx, now any) *MockpromoRepositoryAdvanceStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceStatuses", reflect.TypeOf((*MockpromoRepository)(nil).AdvanceStatuses), ctx, now)
+	return &MockpromoRepositoryAdvanceStatusesCall{Call: call}
+}
+
+// MockpromoRepositoryAdvanceStatusesCall wrap *gomock.Call
+type MockpromoRepositoryAdvanceStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoRepositoryAdvanceStatusesCall) Return(changes []promo0.StatusChange, err error) *MockpromoRepositoryAdvanceStatusesCall {
+	c.Call = c.Call.Return(changes, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoRepositoryAdvanceStatusesCall) Do(f func(context.Context, time.Time) ([]promo0.StatusChange, error)) *MockpromoRepositoryAdvanceStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoRepositoryAdvanceStatusesCall) DoAndReturn(f func(context.Context, time.Time) ([]promo0.StatusChange, error)) *MockpromoRepositoryAdvanceStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Count mocks base method.
-func (m *MockpromoRepository) Count(ctx context.Context, companyId string, countries []string) (int, error) {
+func (m *MockpromoRepository) Count(ctx context.Context, companyId string, countries []string, searchQuery string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Count", ctx, companyId, countries)
+	ret := m.ctrl.Call(m, "Count", ctx, companyId, countries, searchQuery)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Count indicates an expected call of Count.
-func (mr *MockpromoRepositoryMockRecorder) Count(ctx, companyId, countries any) *MockpromoRepositoryCountCall {
+func (mr *MockpromoRepositoryMockRecorder) Count(ctx, companyId, countries, searchQuery any) *MockpromoRepositoryCountCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockpromoRepository)(nil).Count), ctx, companyId, countries)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockpromoRepository)(nil).Count), ctx, companyId, countries, searchQuery)
 	return &MockpromoRepositoryCountCall{Call: call}
 }
 
@@ -73,13 +115,13 @@ func (c *MockpromoRepositoryCountCall) Return(count int, err error) *MockpromoRe
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoRepositoryCountCall) Do(f func(context.Context, string, []string) (int, error)) *MockpromoRepositoryCountCall {
+func (c *MockpromoRepositoryCountCall) Do(f func(context.Context, string, []string, string) (int, error)) *MockpromoRepositoryCountCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoRepositoryCountCall) DoAndReturn(f func(context.Context, string, []string) (int, error)) *MockpromoRepositoryCountCall {
+func (c *MockpromoRepositoryCountCall) DoAndReturn(f func(context.Context, string, []string, string) (int, error)) *MockpromoRepositoryCountCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -161,6 +203,84 @@ func (c *MockpromoRepositoryDeleteCall) DoAndReturn(f func(context.Context, stri
 	return c
 }
 
+// Feed mocks base method.
+func (m *MockpromoRepository) Feed(ctx context.Context, category string, offset, limit int, searchQuery string) ([]promo0.PromoDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, category, offset, limit, searchQuery)
+	ret0, _ := ret[0].([]promo0.PromoDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockpromoRepositoryMockRecorder) Feed(ctx, category, offset, limit, searchQuery any) *MockpromoRepositoryFeedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockpromoRepository)(nil).Feed), ctx, category, offset, limit, searchQuery)
+	return &MockpromoRepositoryFeedCall{Call: call}
+}
+
+// MockpromoRepositoryFeedCall wrap *gomock.Call
+type MockpromoRepositoryFeedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoRepositoryFeedCall) Return(promoModels []promo0.PromoDetails, err error) *MockpromoRepositoryFeedCall {
+	c.Call = c.Call.Return(promoModels, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoRepositoryFeedCall) Do(f func(context.Context, string, int, int, string) ([]promo0.PromoDetails, error)) *MockpromoRepositoryFeedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoRepositoryFeedCall) DoAndReturn(f func(context.Context, string, int, int, string) ([]promo0.PromoDetails, error)) *MockpromoRepositoryFeedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FeedCount mocks base method.
+func (m *MockpromoRepository) FeedCount(ctx context.Context, category, searchQuery string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeedCount", ctx, category, searchQuery)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeedCount indicates an expected call of FeedCount.
+func (mr *MockpromoRepositoryMockRecorder) FeedCount(ctx, category, searchQuery any) *MockpromoRepositoryFeedCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedCount", reflect.TypeOf((*MockpromoRepository)(nil).FeedCount), ctx, category, searchQuery)
+	return &MockpromoRepositoryFeedCountCall{Call: call}
+}
+
+// MockpromoRepositoryFeedCountCall wrap *gomock.Call
+type MockpromoRepositoryFeedCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoRepositoryFeedCountCall) Return(count int, err error) *MockpromoRepositoryFeedCountCall {
+	c.Call = c.Call.Return(count, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoRepositoryFeedCountCall) Do(f func(context.Context, string, string) (int, error)) *MockpromoRepositoryFeedCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoRepositoryFeedCountCall) DoAndReturn(f func(context.Context, string, string) (int, error)) *MockpromoRepositoryFeedCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetById mocks base method.
 func (m *MockpromoRepository) GetById(ctx context.Context, promoId string) (*promo0.PromoDetails, error) {
 	m.ctrl.T.Helper()
@@ -200,370 +320,952 @@ func (c *MockpromoRepositoryGetByIdCall) DoAndReturn(f func(context.Context, str
 	return c
 }
 
-// List mocks base method.
-func (m *MockpromoRepository) List(ctx context.Context, companyId string, countries []string, offset int, sortBy promo.SortBy, limit int) ([]promo0.PromoDetails, error) {
+// GetCompanyId mocks base method.
+func (m *MockpromoRepository) GetCompanyId(ctx context.Context, promoId string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, companyId, countries, offset, sortBy, limit)
-	ret0, _ := ret[0].([]promo0.PromoDetails)
+	ret := m.ctrl.Call(m, "GetCompanyId", ctx, promoId)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// List indicates an expected call of List.
-func (mr *MockpromoRepositoryMockRecorder) List(ctx, companyId, countries, offset, sortBy, limit any) *MockpromoRepositoryListCall {
+// GetCompanyId indicates an expected call of GetCompanyId.
+func (mr *MockpromoRepositoryMockRecorder) GetCompanyId(ctx, promoId any) *MockpromoRepositoryGetCompanyIdCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpromoRepository)(nil).List), ctx, companyId, countries, offset, sortBy, limit)
-	return &MockpromoRepositoryListCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyId", reflect.TypeOf((*MockpromoRepository)(nil).GetCompanyId), ctx, promoId)
+	return &MockpromoRepositoryGetCompanyIdCall{Call: call}
 }
 
-// MockpromoRepositoryListCall wrap *gomock.Call
-type MockpromoRepositoryListCall struct {
+// MockpromoRepositoryGetCompanyIdCall wrap *gomock.Call
+type MockpromoRepositoryGetCompanyIdCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockpromoRepositoryListCall) Return(promoModels []promo0.PromoDetails, err error) *MockpromoRepositoryListCall {
-	c.Call = c.Call.Return(promoModels, err)
+func (c *MockpromoRepositoryGetCompanyIdCall) Return(companyId string, err error) *MockpromoRepositoryGetCompanyIdCall {
+	c.Call = c.Call.Return(companyId, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoRepositoryListCall) Do(f func(context.Context, string, []string, int, promo.SortBy, int) ([]promo0.PromoDetails, error)) *MockpromoRepositoryListCall {
+func (c *MockpromoRepositoryGetCompanyIdCall) Do(f func(context.Context, string) (string, error)) *MockpromoRepositoryGetCompanyIdCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoRepositoryListCall) DoAndReturn(f func(context.Context, string, []string, int, promo.SortBy, int) ([]promo0.PromoDetails, error)) *MockpromoRepositoryListCall {
+func (c *MockpromoRepositoryGetCompanyIdCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockpromoRepositoryGetCompanyIdCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Update mocks base method.
-func (m *MockpromoRepository) Update(ctx context.Context, promoId, description, imageUrl string, targetAgeFrom, targetAgeUntil int64, targetCountry string, targetCategories []string, activeFrom, activeUntil time.Time) error {
+// List mocks base method.
+func (m *MockpromoRepository) List(ctx context.Context, companyId string, countries []string, offset int, sortBy promo.SortBy, limit int, searchQuery string) ([]promo0.PromoDetails, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "List", ctx, companyId, countries, offset, sortBy, limit, searchQuery)
+	ret0, _ := ret[0].([]promo0.PromoDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Update indicates an expected call of Update.
-func (mr *MockpromoRepositoryMockRecorder) Update(ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil any) *MockpromoRepositoryUpdateCall {
+// List indicates an expected call of List.
+func (mr *MockpromoRepositoryMockRecorder) List(ctx, companyId, countries, offset, sortBy, limit, searchQuery any) *MockpromoRepositoryListCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpromoRepository)(nil).Update), ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil)
-	return &MockpromoRepositoryUpdateCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpromoRepository)(nil).List), ctx, companyId, countries, offset, sortBy, limit, searchQuery)
+	return &MockpromoRepositoryListCall{Call: call}
 }
 
-// MockpromoRepositoryUpdateCall wrap *gomock.Call
-type MockpromoRepositoryUpdateCall struct {
+// MockpromoRepositoryListCall wrap *gomock.Call
+type MockpromoRepositoryListCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockpromoRepositoryUpdateCall) Return(arg0 error) *MockpromoRepositoryUpdateCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockpromoRepositoryListCall) Return(promoModels []promo0.PromoDetails, err error) *MockpromoRepositoryListCall {
+	c.Call = c.Call.Return(promoModels, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoRepositoryUpdateCall) Do(f func(context.Context, string, string, string, int64, int64, string, []string, time.Time, time.Time) error) *MockpromoRepositoryUpdateCall {
+func (c *MockpromoRepositoryListCall) Do(f func(context.Context, string, []string, int, promo.SortBy, int, string) ([]promo0.PromoDetails, error)) *MockpromoRepositoryListCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoRepositoryUpdateCall) DoAndReturn(f func(context.Context, string, string, string, int64, int64, string, []string, time.Time, time.Time) error) *MockpromoRepositoryUpdateCall {
+func (c *MockpromoRepositoryListCall) DoAndReturn(f func(context.Context, string, []string, int, promo.SortBy, int, string) ([]promo0.PromoDetails, error)) *MockpromoRepositoryListCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockpromoCodeRepository is a mock of promoCodeRepository interface.
-type MockpromoCodeRepository struct {
-	ctrl     *gomock.Controller
-	recorder *MockpromoCodeRepositoryMockRecorder
-	isgomock struct{}
+// Purge mocks base method.
+func (m *MockpromoRepository) Purge(ctx context.Context, deletedBefore time.Time) ([]promo0.PurgedPromo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, deletedBefore)
+	ret0, _ := ret[0].([]promo0.PurgedPromo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockpromoCodeRepositoryMockRecorder is the mock recorder for MockpromoCodeRepository.
-type MockpromoCodeRepositoryMockRecorder struct {
-	mock *MockpromoCodeRepository
+// Purge indicates an expected call of Purge.
+func (mr *MockpromoRepositoryMockRecorder) Purge(ctx, deletedBefore any) *MockpromoRepositoryPurgeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockpromoRepository)(nil).Purge), ctx, deletedBefore)
+	return &MockpromoRepositoryPurgeCall{Call: call}
 }
 
-// NewMockpromoCodeRepository creates a new mock instance.
-func NewMockpromoCodeRepository(ctrl *gomock.Controller) *MockpromoCodeRepository {
-	mock := &MockpromoCodeRepository{ctrl: ctrl}
-	mock.recorder = &MockpromoCodeRepositoryMockRecorder{mock}
-	return mock
+// MockpromoRepositoryPurgeCall wrap *gomock.Call
+type MockpromoRepositoryPurgeCall struct {
+	*gomock.Call
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpromoCodeRepository) EXPECT() *MockpromoCodeRepositoryMockRecorder {
-	return m.recorder
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoRepositoryPurgeCall) Return(purged []promo0.PurgedPromo, err error) *MockpromoRepositoryPurgeCall {
+	c.Call = c.Call.Return(purged, err)
+	return c
 }
 
-// Activate mocks base method.
-func (m *MockpromoCodeRepository) Activate(ctx context.Context, promoId string) (string, error) {
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoRepositoryPurgeCall) Do(f func(context.Context, time.Time) ([]promo0.PurgedPromo, error)) *MockpromoRepositoryPurgeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoRepositoryPurgeCall) DoAndReturn(f func(context.Context, time.Time) ([]promo0.PurgedPromo, error)) *MockpromoRepositoryPurgeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Restore mocks base method.
+func (m *MockpromoRepository) Restore(ctx context.Context, promoId, companyId string, deletedAfter time.Time) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Activate", ctx, promoId)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Restore", ctx, promoId, companyId, deletedAfter)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Activate indicates an expected call of Activate.
-func (mr *MockpromoCodeRepositoryMockRecorder) Activate(ctx, promoId any) *MockpromoCodeRepositoryActivateCall {
+// Restore indicates an expected call of Restore.
+func (mr *MockpromoRepositoryMockRecorder) Restore(ctx, promoId, companyId, deletedAfter any) *MockpromoRepositoryRestoreCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockpromoCodeRepository)(nil).Activate), ctx, promoId)
-	return &MockpromoCodeRepositoryActivateCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockpromoRepository)(nil).Restore), ctx, promoId, companyId, deletedAfter)
+	return &MockpromoRepositoryRestoreCall{Call: call}
 }
 
-// MockpromoCodeRepositoryActivateCall wrap *gomock.Call
-type MockpromoCodeRepositoryActivateCall struct {
+// MockpromoRepositoryRestoreCall wrap *gomock.Call
+type MockpromoRepositoryRestoreCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockpromoCodeRepositoryActivateCall) Return(code string, err error) *MockpromoCodeRepositoryActivateCall {
-	c.Call = c.Call.Return(code, err)
+func (c *MockpromoRepositoryRestoreCall) Return(restored bool, err error) *MockpromoRepositoryRestoreCall {
+	c.Call = c.Call.Return(restored, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoCodeRepositoryActivateCall) Do(f func(context.Context, string) (string, error)) *MockpromoCodeRepositoryActivateCall {
+func (c *MockpromoRepositoryRestoreCall) Do(f func(context.Context, string, string, time.Time) (bool, error)) *MockpromoRepositoryRestoreCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoCodeRepositoryActivateCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockpromoCodeRepositoryActivateCall {
+func (c *MockpromoRepositoryRestoreCall) DoAndReturn(f func(context.Context, string, string, time.Time) (bool, error)) *MockpromoRepositoryRestoreCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Create mocks base method.
-func (m *MockpromoCodeRepository) Create(ctx context.Context, promoCodeModel *model.PromoCode) (string, error) {
+// SetImage mocks base method.
+func (m *MockpromoRepository) SetImage(ctx context.Context, promoId, imageUrl string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, promoCodeModel)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetImage", ctx, promoId, imageUrl)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockpromoCodeRepositoryMockRecorder) Create(ctx, promoCodeModel any) *MockpromoCodeRepositoryCreateCall {
+// SetImage indicates an expected call of SetImage.
+func (mr *MockpromoRepositoryMockRecorder) SetImage(ctx, promoId, imageUrl any) *MockpromoRepositorySetImageCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpromoCodeRepository)(nil).Create), ctx, promoCodeModel)
-	return &MockpromoCodeRepositoryCreateCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImage", reflect.TypeOf((*MockpromoRepository)(nil).SetImage), ctx, promoId, imageUrl)
+	return &MockpromoRepositorySetImageCall{Call: call}
 }
 
-// MockpromoCodeRepositoryCreateCall wrap *gomock.Call
-type MockpromoCodeRepositoryCreateCall struct {
+// MockpromoRepositorySetImageCall wrap *gomock.Call
+type MockpromoRepositorySetImageCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockpromoCodeRepositoryCreateCall) Return(id string, err error) *MockpromoCodeRepositoryCreateCall {
-	c.Call = c.Call.Return(id, err)
+func (c *MockpromoRepositorySetImageCall) Return(arg0 error) *MockpromoRepositorySetImageCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoCodeRepositoryCreateCall) Do(f func(context.Context, *model.PromoCode) (string, error)) *MockpromoCodeRepositoryCreateCall {
+func (c *MockpromoRepositorySetImageCall) Do(f func(context.Context, string, string) error) *MockpromoRepositorySetImageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoCodeRepositoryCreateCall) DoAndReturn(f func(context.Context, *model.PromoCode) (string, error)) *MockpromoCodeRepositoryCreateCall {
+func (c *MockpromoRepositorySetImageCall) DoAndReturn(f func(context.Context, string, string) error) *MockpromoRepositorySetImageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockaccountServiceClient is a mock of accountServiceClient interface.
-type MockaccountServiceClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockaccountServiceClientMockRecorder
-	isgomock struct{}
+// Update mocks base method.
+func (m *MockpromoRepository) Update(ctx context.Context, promoId, description, imageUrl string, targetAgeFrom, targetAgeUntil int64, targetCountry string, targetCategories []string, activeFrom, activeUntil time.Time, reward *model.Reward, stacking *model.Stacking, activationLimits *model.ActivationLimits, referralReward *model.ReferralReward, localization *model.Localization, from, to promo.Status) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil, reward, stacking, activationLimits, referralReward, localization, from, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockaccountServiceClientMockRecorder is the mock recorder for MockaccountServiceClient.
-type MockaccountServiceClientMockRecorder struct {
-	mock *MockaccountServiceClient
+// Update indicates an expected call of Update.
+func (mr *MockpromoRepositoryMockRecorder) Update(ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil, reward, stacking, activationLimits, referralReward, localization, from, to any) *MockpromoRepositoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpromoRepository)(nil).Update), ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil, reward, stacking, activationLimits, referralReward, localization, from, to)
+	return &MockpromoRepositoryUpdateCall{Call: call}
 }
 
-// NewMockaccountServiceClient creates a new mock instance.
-func NewMockaccountServiceClient(ctrl *gomock.Controller) *MockaccountServiceClient {
-	mock := &MockaccountServiceClient{ctrl: ctrl}
-	mock.recorder = &MockaccountServiceClientMockRecorder{mock}
-	return mock
+// MockpromoRepositoryUpdateCall wrap *gomock.Call
+type MockpromoRepositoryUpdateCall struct {
+	*gomock.Call
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockaccountServiceClient) EXPECT() *MockaccountServiceClientMockRecorder {
-	return m.recorder
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoRepositoryUpdateCall) Return(updated bool, err error) *MockpromoRepositoryUpdateCall {
+	c.Call = c.Call.Return(updated, err)
+	return c
 }
 
-// GetCompanyNameByCompanyID mocks base method.
-func (m *MockaccountServiceClient) GetCompanyNameByCompanyID(ctx context.Context, companyID string) (string, error) {
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoRepositoryUpdateCall) Do(f func(context.Context, string, string, string, int64, int64, string, []string, time.Time, time.Time, *model.Reward, *model.Stacking, *model.ActivationLimits, *model.ReferralReward, *model.Localization, promo.Status, promo.Status) (bool, error)) *MockpromoRepositoryUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoRepositoryUpdateCall) DoAndReturn(f func(context.Context, string, string, string, int64, int64, string, []string, time.Time, time.Time, *model.Reward, *model.Stacking, *model.ActivationLimits, *model.ReferralReward, *model.Localization, promo.Status, promo.Status) (bool, error)) *MockpromoRepositoryUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateStatus mocks base method.
+func (m *MockpromoRepository) UpdateStatus(ctx context.Context, promoId string, from, to promo.Status) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompanyNameByCompanyID", ctx, companyID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, promoId, from, to)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCompanyNameByCompanyID indicates an expected call of GetCompanyNameByCompanyID.
-func (mr *MockaccountServiceClientMockRecorder) GetCompanyNameByCompanyID(ctx, companyID any) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockpromoRepositoryMockRecorder) UpdateStatus(ctx, promoId, from, to any) *MockpromoRepositoryUpdateStatusCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyNameByCompanyID", reflect.TypeOf((*MockaccountServiceClient)(nil).GetCompanyNameByCompanyID), ctx, companyID)
-	return &MockaccountServiceClientGetCompanyNameByCompanyIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockpromoRepository)(nil).UpdateStatus), ctx, promoId, from, to)
+	return &MockpromoRepositoryUpdateStatusCall{Call: call}
 }
 
-// MockaccountServiceClientGetCompanyNameByCompanyIDCall wrap *gomock.Call
-type MockaccountServiceClientGetCompanyNameByCompanyIDCall struct {
+// MockpromoRepositoryUpdateStatusCall wrap *gomock.Call
+type MockpromoRepositoryUpdateStatusCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockaccountServiceClientGetCompanyNameByCompanyIDCall) Return(companyName string, err error) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
-	c.Call = c.Call.Return(companyName, err)
+func (c *MockpromoRepositoryUpdateStatusCall) Return(updated bool, err error) *MockpromoRepositoryUpdateStatusCall {
+	c.Call = c.Call.Return(updated, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockaccountServiceClientGetCompanyNameByCompanyIDCall) Do(f func(context.Context, string) (string, error)) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
+func (c *MockpromoRepositoryUpdateStatusCall) Do(f func(context.Context, string, promo.Status, promo.Status) (bool, error)) *MockpromoRepositoryUpdateStatusCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockaccountServiceClientGetCompanyNameByCompanyIDCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
+func (c *MockpromoRepositoryUpdateStatusCall) DoAndReturn(f func(context.Context, string, promo.Status, promo.Status) (bool, error)) *MockpromoRepositoryUpdateStatusCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockpromoLikeRepository is a mock of promoLikeRepository interface.
-type MockpromoLikeRepository struct {
+// MockpromoCodeRepository is a mock of promoCodeRepository interface.
+type MockpromoCodeRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockpromoLikeRepositoryMockRecorder
+	recorder *MockpromoCodeRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockpromoLikeRepositoryMockRecorder is the mock recorder for MockpromoLikeRepository.
-type MockpromoLikeRepositoryMockRecorder struct {
-	mock *MockpromoLikeRepository
+// MockpromoCodeRepositoryMockRecorder is the mock recorder for MockpromoCodeRepository.
+type MockpromoCodeRepositoryMockRecorder struct {
+	mock *MockpromoCodeRepository
 }
 
-// NewMockpromoLikeRepository creates a new mock instance.
-func NewMockpromoLikeRepository(ctrl *gomock.Controller) *MockpromoLikeRepository {
-	mock := &MockpromoLikeRepository{ctrl: ctrl}
-	mock.recorder = &MockpromoLikeRepositoryMockRecorder{mock}
+// NewMockpromoCodeRepository creates a new mock instance.
+func NewMockpromoCodeRepository(ctrl *gomock.Controller) *MockpromoCodeRepository {
+	mock := &MockpromoCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockpromoCodeRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpromoLikeRepository) EXPECT() *MockpromoLikeRepositoryMockRecorder {
+func (m *MockpromoCodeRepository) EXPECT() *MockpromoCodeRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetCountByPromoId mocks base method.
-func (m *MockpromoLikeRepository) GetCountByPromoId(ctx context.Context, promoId string) (int, error) {
+// Activate mocks base method.
+func (m *MockpromoCodeRepository) Activate(ctx context.Context, promoId, userId string) (string, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCountByPromoId", ctx, promoId)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Activate", ctx, promoId, userId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetCountByPromoId indicates an expected call of GetCountByPromoId.
-func (mr *MockpromoLikeRepositoryMockRecorder) GetCountByPromoId(ctx, promoId any) *MockpromoLikeRepositoryGetCountByPromoIdCall {
+// Activate indicates an expected call of Activate.
+func (mr *MockpromoCodeRepositoryMockRecorder) Activate(ctx, promoId, userId any) *MockpromoCodeRepositoryActivateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountByPromoId", reflect.TypeOf((*MockpromoLikeRepository)(nil).GetCountByPromoId), ctx, promoId)
-	return &MockpromoLikeRepositoryGetCountByPromoIdCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockpromoCodeRepository)(nil).Activate), ctx, promoId, userId)
+	return &MockpromoCodeRepositoryActivateCall{Call: call}
 }
 
-// MockpromoLikeRepositoryGetCountByPromoIdCall wrap *gomock.Call
-type MockpromoLikeRepositoryGetCountByPromoIdCall struct {
+// MockpromoCodeRepositoryActivateCall wrap *gomock.Call
+type MockpromoCodeRepositoryActivateCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockpromoLikeRepositoryGetCountByPromoIdCall) Return(count int, err error) *MockpromoLikeRepositoryGetCountByPromoIdCall {
-	c.Call = c.Call.Return(count, err)
+func (c *MockpromoCodeRepositoryActivateCall) Return(code string, exhausted bool, err error) *MockpromoCodeRepositoryActivateCall {
+	c.Call = c.Call.Return(code, exhausted, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoLikeRepositoryGetCountByPromoIdCall) Do(f func(context.Context, string) (int, error)) *MockpromoLikeRepositoryGetCountByPromoIdCall {
+func (c *MockpromoCodeRepositoryActivateCall) Do(f func(context.Context, string, string) (string, bool, error)) *MockpromoCodeRepositoryActivateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoLikeRepositoryGetCountByPromoIdCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockpromoLikeRepositoryGetCountByPromoIdCall {
+func (c *MockpromoCodeRepositoryActivateCall) DoAndReturn(f func(context.Context, string, string) (string, bool, error)) *MockpromoCodeRepositoryActivateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockpromoActivationRepository is a mock of promoActivationRepository interface.
-type MockpromoActivationRepository struct {
-	ctrl     *gomock.Controller
-	recorder *MockpromoActivationRepositoryMockRecorder
-	isgomock struct{}
+// AnonymizeActivations mocks base method.
+func (m *MockpromoCodeRepository) AnonymizeActivations(ctx context.Context, userId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeActivations", ctx, userId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockpromoActivationRepositoryMockRecorder is the mock recorder for MockpromoActivationRepository.
-type MockpromoActivationRepositoryMockRecorder struct {
-	mock *MockpromoActivationRepository
+// AnonymizeActivations indicates an expected call of AnonymizeActivations.
+func (mr *MockpromoCodeRepositoryMockRecorder) AnonymizeActivations(ctx, userId any) *MockpromoCodeRepositoryAnonymizeActivationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeActivations", reflect.TypeOf((*MockpromoCodeRepository)(nil).AnonymizeActivations), ctx, userId)
+	return &MockpromoCodeRepositoryAnonymizeActivationsCall{Call: call}
 }
 
-// NewMockpromoActivationRepository creates a new mock instance.
-func NewMockpromoActivationRepository(ctrl *gomock.Controller) *MockpromoActivationRepository {
-	mock := &MockpromoActivationRepository{ctrl: ctrl}
-	mock.recorder = &MockpromoActivationRepositoryMockRecorder{mock}
-	return mock
+// MockpromoCodeRepositoryAnonymizeActivationsCall wrap *gomock.Call
+type MockpromoCodeRepositoryAnonymizeActivationsCall struct {
+	*gomock.Call
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpromoActivationRepository) EXPECT() *MockpromoActivationRepositoryMockRecorder {
-	return m.recorder
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoCodeRepositoryAnonymizeActivationsCall) Return(anonymized int64, err error) *MockpromoCodeRepositoryAnonymizeActivationsCall {
+	c.Call = c.Call.Return(anonymized, err)
+	return c
 }
 
-// GetCountByPromoId mocks base method.
-func (m *MockpromoActivationRepository) GetCountByPromoId(ctx context.Context, promoId string) (int, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCountByPromoId", ctx, promoId)
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoCodeRepositoryAnonymizeActivationsCall) Do(f func(context.Context, string) (int64, error)) *MockpromoCodeRepositoryAnonymizeActivationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoCodeRepositoryAnonymizeActivationsCall) DoAndReturn(f func(context.Context, string) (int64, error)) *MockpromoCodeRepositoryAnonymizeActivationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Create mocks base method.
+func (m *MockpromoCodeRepository) Create(ctx context.Context, promoCodeModel *model.PromoCode) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, promoCodeModel)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockpromoCodeRepositoryMockRecorder) Create(ctx, promoCodeModel any) *MockpromoCodeRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpromoCodeRepository)(nil).Create), ctx, promoCodeModel)
+	return &MockpromoCodeRepositoryCreateCall{Call: call}
+}
+
+// MockpromoCodeRepositoryCreateCall wrap *gomock.Call
+type MockpromoCodeRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoCodeRepositoryCreateCall) Return(id string, err error) *MockpromoCodeRepositoryCreateCall {
+	c.Call = c.Call.Return(id, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoCodeRepositoryCreateCall) Do(f func(context.Context, *model.PromoCode) (string, error)) *MockpromoCodeRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoCodeRepositoryCreateCall) DoAndReturn(f func(context.Context, *model.PromoCode) (string, error)) *MockpromoCodeRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportActivations mocks base method.
+func (m *MockpromoCodeRepository) ExportActivations(ctx context.Context, promoId string, fn func(*model.PromoActivation) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportActivations", ctx, promoId, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportActivations indicates an expected call of ExportActivations.
+func (mr *MockpromoCodeRepositoryMockRecorder) ExportActivations(ctx, promoId, fn any) *MockpromoCodeRepositoryExportActivationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportActivations", reflect.TypeOf((*MockpromoCodeRepository)(nil).ExportActivations), ctx, promoId, fn)
+	return &MockpromoCodeRepositoryExportActivationsCall{Call: call}
+}
+
+// MockpromoCodeRepositoryExportActivationsCall wrap *gomock.Call
+type MockpromoCodeRepositoryExportActivationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoCodeRepositoryExportActivationsCall) Return(arg0 error) *MockpromoCodeRepositoryExportActivationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoCodeRepositoryExportActivationsCall) Do(f func(context.Context, string, func(*model.PromoActivation) error) error) *MockpromoCodeRepositoryExportActivationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoCodeRepositoryExportActivationsCall) DoAndReturn(f func(context.Context, string, func(*model.PromoActivation) error) error) *MockpromoCodeRepositoryExportActivationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportCodes mocks base method.
+func (m *MockpromoCodeRepository) ExportCodes(ctx context.Context, promoId string, fn func(*model.PromoCode) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCodes", ctx, promoId, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCodes indicates an expected call of ExportCodes.
+func (mr *MockpromoCodeRepositoryMockRecorder) ExportCodes(ctx, promoId, fn any) *MockpromoCodeRepositoryExportCodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCodes", reflect.TypeOf((*MockpromoCodeRepository)(nil).ExportCodes), ctx, promoId, fn)
+	return &MockpromoCodeRepositoryExportCodesCall{Call: call}
+}
+
+// MockpromoCodeRepositoryExportCodesCall wrap *gomock.Call
+type MockpromoCodeRepositoryExportCodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoCodeRepositoryExportCodesCall) Return(arg0 error) *MockpromoCodeRepositoryExportCodesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoCodeRepositoryExportCodesCall) Do(f func(context.Context, string, func(*model.PromoCode) error) error) *MockpromoCodeRepositoryExportCodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoCodeRepositoryExportCodesCall) DoAndReturn(f func(context.Context, string, func(*model.PromoCode) error) error) *MockpromoCodeRepositoryExportCodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetActivatedCode mocks base method.
+func (m *MockpromoCodeRepository) GetActivatedCode(ctx context.Context, promoId, userId, code string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivatedCode", ctx, promoId, userId, code)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivatedCode indicates an expected call of GetActivatedCode.
+func (mr *MockpromoCodeRepositoryMockRecorder) GetActivatedCode(ctx, promoId, userId, code any) *MockpromoCodeRepositoryGetActivatedCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivatedCode", reflect.TypeOf((*MockpromoCodeRepository)(nil).GetActivatedCode), ctx, promoId, userId, code)
+	return &MockpromoCodeRepositoryGetActivatedCodeCall{Call: call}
+}
+
+// MockpromoCodeRepositoryGetActivatedCodeCall wrap *gomock.Call
+type MockpromoCodeRepositoryGetActivatedCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoCodeRepositoryGetActivatedCodeCall) Return(arg0 string, arg1 error) *MockpromoCodeRepositoryGetActivatedCodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoCodeRepositoryGetActivatedCodeCall) Do(f func(context.Context, string, string, string) (string, error)) *MockpromoCodeRepositoryGetActivatedCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoCodeRepositoryGetActivatedCodeCall) DoAndReturn(f func(context.Context, string, string, string) (string, error)) *MockpromoCodeRepositoryGetActivatedCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByCode mocks base method.
+func (m *MockpromoCodeRepository) GetByCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*model.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockpromoCodeRepositoryMockRecorder) GetByCode(ctx, code any) *MockpromoCodeRepositoryGetByCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockpromoCodeRepository)(nil).GetByCode), ctx, code)
+	return &MockpromoCodeRepositoryGetByCodeCall{Call: call}
+}
+
+// MockpromoCodeRepositoryGetByCodeCall wrap *gomock.Call
+type MockpromoCodeRepositoryGetByCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoCodeRepositoryGetByCodeCall) Return(promoCodeModel *model.PromoCode, err error) *MockpromoCodeRepositoryGetByCodeCall {
+	c.Call = c.Call.Return(promoCodeModel, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoCodeRepositoryGetByCodeCall) Do(f func(context.Context, string) (*model.PromoCode, error)) *MockpromoCodeRepositoryGetByCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoCodeRepositoryGetByCodeCall) DoAndReturn(f func(context.Context, string) (*model.PromoCode, error)) *MockpromoCodeRepositoryGetByCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListUserActivations mocks base method.
+func (m *MockpromoCodeRepository) ListUserActivations(ctx context.Context, userId string) ([]model.UserActivation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserActivations", ctx, userId)
+	ret0, _ := ret[0].([]model.UserActivation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserActivations indicates an expected call of ListUserActivations.
+func (mr *MockpromoCodeRepositoryMockRecorder) ListUserActivations(ctx, userId any) *MockpromoCodeRepositoryListUserActivationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserActivations", reflect.TypeOf((*MockpromoCodeRepository)(nil).ListUserActivations), ctx, userId)
+	return &MockpromoCodeRepositoryListUserActivationsCall{Call: call}
+}
+
+// MockpromoCodeRepositoryListUserActivationsCall wrap *gomock.Call
+type MockpromoCodeRepositoryListUserActivationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpromoCodeRepositoryListUserActivationsCall) Return(activations []model.UserActivation, err error) *MockpromoCodeRepositoryListUserActivationsCall {
+	c.Call = c.Call.Return(activations, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpromoCodeRepositoryListUserActivationsCall) Do(f func(context.Context, string) ([]model.UserActivation, error)) *MockpromoCodeRepositoryListUserActivationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpromoCodeRepositoryListUserActivationsCall) DoAndReturn(f func(context.Context, string) ([]model.UserActivation, error)) *MockpromoCodeRepositoryListUserActivationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockauditRepository is a mock of auditRepository interface.
+type MockauditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockauditRepositoryMockRecorder is the mock recorder for MockauditRepository.
+type MockauditRepositoryMockRecorder struct {
+	mock *MockauditRepository
+}
+
+// NewMockauditRepository creates a new mock instance.
+func NewMockauditRepository(ctrl *gomock.Controller) *MockauditRepository {
+	mock := &MockauditRepository{ctrl: ctrl}
+	mock.recorder = &MockauditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRepository) EXPECT() *MockauditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockauditRepository) Count(ctx context.Context, filter audit.Filter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCountByPromoId indicates an expected call of GetCountByPromoId.
-func (mr *MockpromoActivationRepositoryMockRecorder) GetCountByPromoId(ctx, promoId any) *MockpromoActivationRepositoryGetCountByPromoIdCall {
+// Count indicates an expected call of Count.
+func (mr *MockauditRepositoryMockRecorder) Count(ctx, filter any) *MockauditRepositoryCountCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountByPromoId", reflect.TypeOf((*MockpromoActivationRepository)(nil).GetCountByPromoId), ctx, promoId)
-	return &MockpromoActivationRepositoryGetCountByPromoIdCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockauditRepository)(nil).Count), ctx, filter)
+	return &MockauditRepositoryCountCall{Call: call}
 }
 
-// MockpromoActivationRepositoryGetCountByPromoIdCall wrap *gomock.Call
-type MockpromoActivationRepositoryGetCountByPromoIdCall struct {
+// MockauditRepositoryCountCall wrap *gomock.Call
+type MockauditRepositoryCountCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockpromoActivationRepositoryGetCountByPromoIdCall) Return(count int, err error) *MockpromoActivationRepositoryGetCountByPromoIdCall {
+func (c *MockauditRepositoryCountCall) Return(count int, err error) *MockauditRepositoryCountCall {
 	c.Call = c.Call.Return(count, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpromoActivationRepositoryGetCountByPromoIdCall) Do(f func(context.Context, string) (int, error)) *MockpromoActivationRepositoryGetCountByPromoIdCall {
+func (c *MockauditRepositoryCountCall) Do(f func(context.Context, audit.Filter) (int, error)) *MockauditRepositoryCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditRepositoryCountCall) DoAndReturn(f func(context.Context, audit.Filter) (int, error)) *MockauditRepositoryCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Create mocks base method.
+func (m *MockauditRepository) Create(ctx context.Context, entry *model.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockauditRepositoryMockRecorder) Create(ctx, entry any) *MockauditRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockauditRepository)(nil).Create), ctx, entry)
+	return &MockauditRepositoryCreateCall{Call: call}
+}
+
+// MockauditRepositoryCreateCall wrap *gomock.Call
+type MockauditRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditRepositoryCreateCall) Return(arg0 error) *MockauditRepositoryCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditRepositoryCreateCall) Do(f func(context.Context, *model.AuditEntry) error) *MockauditRepositoryCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditRepositoryCreateCall) DoAndReturn(f func(context.Context, *model.AuditEntry) error) *MockauditRepositoryCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockauditRepository) List(ctx context.Context, filter audit.Filter, offset, limit int) ([]model.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, offset, limit)
+	ret0, _ := ret[0].([]model.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockauditRepositoryMockRecorder) List(ctx, filter, offset, limit any) *MockauditRepositoryListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockauditRepository)(nil).List), ctx, filter, offset, limit)
+	return &MockauditRepositoryListCall{Call: call}
+}
+
+// MockauditRepositoryListCall wrap *gomock.Call
+type MockauditRepositoryListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditRepositoryListCall) Return(entries []model.AuditEntry, err error) *MockauditRepositoryListCall {
+	c.Call = c.Call.Return(entries, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditRepositoryListCall) Do(f func(context.Context, audit.Filter, int, int) ([]model.AuditEntry, error)) *MockauditRepositoryListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditRepositoryListCall) DoAndReturn(f func(context.Context, audit.Filter, int, int) ([]model.AuditEntry, error)) *MockauditRepositoryListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockaccountServiceClient is a mock of accountServiceClient interface.
+type MockaccountServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockaccountServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockaccountServiceClientMockRecorder is the mock recorder for MockaccountServiceClient.
+type MockaccountServiceClientMockRecorder struct {
+	mock *MockaccountServiceClient
+}
+
+// NewMockaccountServiceClient creates a new mock instance.
+func NewMockaccountServiceClient(ctrl *gomock.Controller) *MockaccountServiceClient {
+	mock := &MockaccountServiceClient{ctrl: ctrl}
+	mock.recorder = &MockaccountServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaccountServiceClient) EXPECT() *MockaccountServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ConvertReferral mocks base method.
+func (m *MockaccountServiceClient) ConvertReferral(ctx context.Context, refereeId, promoId, companyId string, reward *referral.Reward) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertReferral", ctx, refereeId, promoId, companyId, reward)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertReferral indicates an expected call of ConvertReferral.
+func (mr *MockaccountServiceClientMockRecorder) ConvertReferral(ctx, refereeId, promoId, companyId, reward any) *MockaccountServiceClientConvertReferralCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertReferral", reflect.TypeOf((*MockaccountServiceClient)(nil).ConvertReferral), ctx, refereeId, promoId, companyId, reward)
+	return &MockaccountServiceClientConvertReferralCall{Call: call}
+}
+
+// MockaccountServiceClientConvertReferralCall wrap *gomock.Call
+type MockaccountServiceClientConvertReferralCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockaccountServiceClientConvertReferralCall) Return(credited bool, err error) *MockaccountServiceClientConvertReferralCall {
+	c.Call = c.Call.Return(credited, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockaccountServiceClientConvertReferralCall) Do(f func(context.Context, string, string, string, *referral.Reward) (bool, error)) *MockaccountServiceClientConvertReferralCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockaccountServiceClientConvertReferralCall) DoAndReturn(f func(context.Context, string, string, string, *referral.Reward) (bool, error)) *MockaccountServiceClientConvertReferralCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCompanyNameByCompanyID mocks base method.
+func (m *MockaccountServiceClient) GetCompanyNameByCompanyID(ctx context.Context, companyID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyNameByCompanyID", ctx, companyID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyNameByCompanyID indicates an expected call of GetCompanyNameByCompanyID.
+func (mr *MockaccountServiceClientMockRecorder) GetCompanyNameByCompanyID(ctx, companyID any) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyNameByCompanyID", reflect.TypeOf((*MockaccountServiceClient)(nil).GetCompanyNameByCompanyID), ctx, companyID)
+	return &MockaccountServiceClientGetCompanyNameByCompanyIDCall{Call: call}
+}
+
+// MockaccountServiceClientGetCompanyNameByCompanyIDCall wrap *gomock.Call
+type MockaccountServiceClientGetCompanyNameByCompanyIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockaccountServiceClientGetCompanyNameByCompanyIDCall) Return(companyName string, err error) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
+	c.Call = c.Call.Return(companyName, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockaccountServiceClientGetCompanyNameByCompanyIDCall) Do(f func(context.Context, string) (string, error)) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockaccountServiceClientGetCompanyNameByCompanyIDCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockaccountServiceClientGetCompanyNameByCompanyIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockwebhookPublisher is a mock of webhookPublisher interface.
+type MockwebhookPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookPublisherMockRecorder is the mock recorder for MockwebhookPublisher.
+type MockwebhookPublisherMockRecorder struct {
+	mock *MockwebhookPublisher
+}
+
+// NewMockwebhookPublisher creates a new mock instance.
+func NewMockwebhookPublisher(ctrl *gomock.Controller) *MockwebhookPublisher {
+	mock := &MockwebhookPublisher{ctrl: ctrl}
+	mock.recorder = &MockwebhookPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookPublisher) EXPECT() *MockwebhookPublisherMockRecorder {
+	return m.recorder
+}
+
+// ForgetUser mocks base method.
+func (m *MockwebhookPublisher) ForgetUser(ctx context.Context, userId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgetUser", ctx, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgetUser indicates an expected call of ForgetUser.
+func (mr *MockwebhookPublisherMockRecorder) ForgetUser(ctx, userId any) *MockwebhookPublisherForgetUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetUser", reflect.TypeOf((*MockwebhookPublisher)(nil).ForgetUser), ctx, userId)
+	return &MockwebhookPublisherForgetUserCall{Call: call}
+}
+
+// MockwebhookPublisherForgetUserCall wrap *gomock.Call
+type MockwebhookPublisherForgetUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookPublisherForgetUserCall) Return(scrubbed int, err error) *MockwebhookPublisherForgetUserCall {
+	c.Call = c.Call.Return(scrubbed, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookPublisherForgetUserCall) Do(f func(context.Context, string) (int, error)) *MockwebhookPublisherForgetUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookPublisherForgetUserCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockwebhookPublisherForgetUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Publish mocks base method.
+func (m *MockwebhookPublisher) Publish(ctx context.Context, companyId string, event webhook.Event, data any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, companyId, event, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockwebhookPublisherMockRecorder) Publish(ctx, companyId, event, data any) *MockwebhookPublisherPublishCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockwebhookPublisher)(nil).Publish), ctx, companyId, event, data)
+	return &MockwebhookPublisherPublishCall{Call: call}
+}
+
+// MockwebhookPublisherPublishCall wrap *gomock.Call
+type MockwebhookPublisherPublishCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookPublisherPublishCall) Return(arg0 error) *MockwebhookPublisherPublishCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookPublisherPublishCall) Do(f func(context.Context, string, webhook.Event, any) error) *MockwebhookPublisherPublishCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpromoActivationRepositoryGetCountByPromoIdCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockpromoActivationRepositoryGetCountByPromoIdCall {
+func (c *MockwebhookPublisherPublishCall) DoAndReturn(f func(context.Context, string, webhook.Event, any) error) *MockwebhookPublisherPublishCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,124 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	promoStorage "gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
+)
+
+// QuoteDiscount рассчитывает скидку по коду для корзины, не расходуя активацию.
+// Неприменимость кода возвращается причиной отказа в Quote, а не ошибкой
+func (s *Service) QuoteDiscount(ctx context.Context, code string, cartDto *cart.DTO) (quote *reward.Quote, err error) {
+	err = cartDto.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	quote = &reward.Quote{Currency: cartDto.Currency}
+
+	promoModel, reason, err := s.lookupCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if promoModel != nil {
+		quote.PromoId = promoModel.Id
+	}
+
+	if reason != rewardenum.RejectReasonNone {
+		quote.RejectReason = reason
+		return quote, nil
+	}
+
+	rewardDto := rewardFromModel(promoModel.Reward)
+	if rewardDto == nil {
+		quote.RejectReason = rewardenum.RejectReasonNoReward
+		return quote, nil
+	}
+
+	quote.RewardType = rewardDto.Type
+	quote.Discount, quote.RejectReason = rewardDto.Quote(cartDto)
+
+	return quote, nil
+}
+
+// lookupCode находит промокод по коду и проверяет, что код можно применить прямо сейчас.
+// Если нет, возвращает причину отказа
+func (s *Service) lookupCode(ctx context.Context, code string) (promoModel *promoStorage.PromoDetails, reason rewardenum.RejectReason, err error) {
+	promoCode, err := s.promoCodeRepository.GetByCode(ctx, code)
+	if err != nil {
+		return nil, "", fmt.Errorf("promoCodeRepository.GetByCode: %w", err)
+	}
+
+	if promoCode == nil {
+		return nil, rewardenum.RejectReasonCodeNotFound, nil
+	}
+
+	promoModel, err = s.promoRepository.GetById(ctx, promoCode.PromoId)
+	if err != nil {
+		return nil, "", fmt.Errorf("promoRepository.GetById: %w", err)
+	}
+
+	if promoModel == nil {
+		return nil, rewardenum.RejectReasonCodeNotFound, nil
+	}
+
+	window := promo.DTO{
+		Status:      promoModel.Status,
+		ActiveFrom:  promoModel.ActiveFrom,
+		ActiveUntil: promoModel.ActiveUntil,
+		Codes:       []promo.Code{{Code: promoCode.Code, MaxCount: 1}},
+	}
+	if !window.IsActive(time.Now()) {
+		return promoModel, rewardenum.RejectReasonPromoNotActive, nil
+	}
+
+	if promoCode.Activations >= promoCode.MaxCount {
+		return promoModel, rewardenum.RejectReasonNoActivationsLeft, nil
+	}
+
+	return promoModel, rewardenum.RejectReasonNone, nil
+}
+
+func rewardToModel(rewardDto *reward.DTO) *model.Reward {
+	if rewardDto == nil {
+		return nil
+	}
+
+	return &model.Reward{
+		Type:               rewardDto.Type,
+		Percent:            rewardDto.Percent,
+		Amount:             rewardDto.Amount,
+		Currency:           rewardDto.Currency,
+		ItemSku:            rewardDto.ItemSku,
+		MinOrderAmount:     rewardDto.Conditions.MinOrderAmount,
+		EligibleCategories: rewardDto.Conditions.EligibleCategories,
+		MaxDiscountAmount:  rewardDto.Conditions.MaxDiscountAmount,
+	}
+}
+
+func rewardFromModel(rewardModel *model.Reward) *reward.DTO {
+	if rewardModel == nil {
+		return nil
+	}
+
+	return &reward.DTO{
+		Type:     rewardModel.Type,
+		Percent:  rewardModel.Percent,
+		Amount:   rewardModel.Amount,
+		Currency: rewardModel.Currency,
+		ItemSku:  rewardModel.ItemSku,
+		Conditions: reward.Conditions{
+			MinOrderAmount:     rewardModel.MinOrderAmount,
+			EligibleCategories: rewardModel.EligibleCategories,
+			MaxDiscountAmount:  rewardModel.MaxDiscountAmount,
+		},
+	}
+}
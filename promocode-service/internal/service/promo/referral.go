@@ -0,0 +1,52 @@
+package promo
+
+import (
+	"context"
+
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	promoStorage "gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
+	"go.uber.org/zap"
+)
+
+// convertReferral сообщает account-service об активации промокода пользователем. Если пользователь был приглашён,
+// его приглашение считается конвертированным, а пригласившему начисляется награда компании.
+// Ошибка не отменяет активацию: начисление идемпотентно и может быть повторено при следующей активации
+func (s *Service) convertReferral(ctx context.Context, promoModel *promoStorage.PromoDetails, userId string) {
+	if userId == "" {
+		return
+	}
+
+	credited, err := s.accountServiceClient.ConvertReferral(ctx, userId, promoModel.Id, promoModel.CompanyId, referralRewardFromModel(promoModel.ReferralReward))
+	if err != nil {
+		s.logger(ctx).Warn("accountServiceClient.ConvertReferral: failed to convert referral",
+			zap.String("promo_id", promoModel.Id), zap.Error(err))
+		return
+	}
+
+	if credited {
+		s.logger(ctx).Info("referral reward credited", zap.String("promo_id", promoModel.Id), zap.String("referee_id", userId))
+	}
+}
+
+func referralRewardToModel(reward *referral.Reward) *model.ReferralReward {
+	if reward == nil {
+		return nil
+	}
+
+	return &model.ReferralReward{
+		Amount:   reward.Amount,
+		Currency: reward.Currency,
+	}
+}
+
+func referralRewardFromModel(rewardModel *model.ReferralReward) *referral.Reward {
+	if rewardModel == nil {
+		return nil
+	}
+
+	return &referral.Reward{
+		Amount:   rewardModel.Amount,
+		Currency: rewardModel.Currency,
+	}
+}
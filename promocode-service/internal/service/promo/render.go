@@ -0,0 +1,36 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/pisya-dev/promo-code-service/pkg/barcode"
+)
+
+// RenderCode отрисовывает код, который пользователь получил при активации промокода.
+// Код чужой активации неотличим от несуществующего, поэтому в обоих случаях возвращается ErrNotFound
+func (s *Service) RenderCode(ctx context.Context, promoId string, userId string, code string, opts barcode.Options) (activatedCode string, image []byte, contentType string, err error) {
+	if userId == "" {
+		return "", nil, "", ErrUserRequired
+	}
+
+	if err = opts.Validate(); err != nil {
+		return "", nil, "", err
+	}
+
+	activatedCode, err = s.promoCodeRepository.GetActivatedCode(ctx, promoId, userId, code)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("promoCodeRepository.GetActivatedCode: %w", err)
+	}
+
+	if activatedCode == "" {
+		return "", nil, "", ErrNotFound
+	}
+
+	image, contentType, err = barcode.Render(activatedCode, opts)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("barcode.Render: %w", err)
+	}
+
+	return activatedCode, image, contentType, nil
+}
@@ -6,18 +6,31 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"gitlab.com/pisya-dev/account-service/pkg/metrics"
+	"gitlab.com/pisya-dev/account-service/pkg/tracing"
+	auditdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
+	auditenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/audit"
 	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
 	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/functional"
+	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/requestid"
 	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
 	promoStorage "gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
 	"gitlab.com/pisya-dev/promo-code-service/internal/storage/promo_code"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/pointer"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,14 +42,24 @@ var (
 	ErrFraudDetected       = errors.New("fraud detected")
 	ErrNoActivations       = errors.New("no activations")
 	ErrCompanyDoesNotExist = errors.New("company does not exist")
+	ErrInvalidTransition   = errors.New("invalid status transition")
+	ErrPromoNotActive      = errors.New("promo is not active")
+	ErrUserRequired        = errors.New("user id is required for promo with per-user activation limits")
 )
 
+// promoCacheName метка кэша промокодов в redis для метрик попаданий
+const promoCacheName = "promo"
+
 type Service struct {
 	log                  *zap.Logger
 	promoRepository      promoRepository
 	promoCodeRepository  promoCodeRepository
 	redisDb              redisDb
 	accountServiceClient accountServiceClient
+	auditRepository      auditRepository
+	webhookPublisher     webhookPublisher
+
+	deletedPromoRetention time.Duration
 }
 
 func New(
@@ -45,16 +68,27 @@ func New(
 	promoCodeRepository promoCodeRepository,
 	redisDb redisDb,
 	accountServiceClient accountServiceClient,
+	auditRepository auditRepository,
+	webhookPublisher webhookPublisher,
+	deletedPromoRetention time.Duration,
 ) *Service {
 	return &Service{
-		log:                  log,
-		promoRepository:      promoRepository,
-		promoCodeRepository:  promoCodeRepository,
-		redisDb:              redisDb,
-		accountServiceClient: accountServiceClient,
+		log:                   log,
+		promoRepository:       promoRepository,
+		promoCodeRepository:   promoCodeRepository,
+		redisDb:               redisDb,
+		accountServiceClient:  accountServiceClient,
+		auditRepository:       auditRepository,
+		webhookPublisher:      webhookPublisher,
+		deletedPromoRetention: deletedPromoRetention,
 	}
 }
 
+// logger логгер с trace_id и span_id текущего запроса
+func (s *Service) logger(ctx context.Context) *zap.Logger {
+	return s.log.With(append(requestid.LogFields(ctx), tracing.LogFields(ctx)...)...)
+}
+
 func (s *Service) Create(ctx context.Context, promoDto *promo.CreatePromoDTO) (id string, err error) {
 	const op = "service.promo.Create"
 
@@ -63,6 +97,11 @@ func (s *Service) Create(ctx context.Context, promoDto *promo.CreatePromoDTO) (i
 		return "", err
 	}
 
+	localizationDto, err := localization.Normalize(promoDto.Localization)
+	if err != nil {
+		return "", err
+	}
+
 	promoModel := &model.Promo{
 		Id:               uuid.New().String(),
 		CompanyId:        promoDto.CompanyId,
@@ -79,12 +118,22 @@ func (s *Service) Create(ctx context.Context, promoDto *promo.CreatePromoDTO) (i
 		PromoCommon:      promoDto.PromoCommon,
 		PromoUnique:      promoDto.PromoUnique,
 		MaxCount:         promoDto.MaxCount,
+		Status:           promoenum.StatusDraft,
+		Reward:           rewardToModel(promoDto.Reward),
+		Stacking:         stackingToModel(promoDto.Stacking),
+		ActivationLimits: activationLimitsToModel(promoDto.ActivationLimits),
+		ReferralReward:   referralRewardToModel(promoDto.ReferralReward),
+		Localization:     localizationToModel(localizationDto),
+	}
+
+	if !promoDto.Draft {
+		promoModel.Status = promoenum.LiveStatus(promoDto.ActiveFrom, promoDto.ActiveUntil, promoModel.CreatedAt)
 	}
 
 	id, err = s.promoRepository.Create(ctx, promoModel)
 
 	if err != nil {
-		s.log.Error("Failed to save promo to db", zap.Error(err))
+		s.logger(ctx).Error("Failed to save promo to db", zap.Error(err))
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -98,7 +147,7 @@ func (s *Service) Create(ctx context.Context, promoDto *promo.CreatePromoDTO) (i
 
 		_, err = s.promoCodeRepository.Create(ctx, promoCodeModel)
 		if err != nil {
-			s.log.Error("Failed to save promo code to db", zap.Error(err))
+			s.logger(ctx).Error("Failed to save promo code to db", zap.Error(err))
 			return "", fmt.Errorf("%s: %w", op, err)
 		}
 	} else if promoDto.Mode == promo.UNIQUE {
@@ -112,25 +161,60 @@ func (s *Service) Create(ctx context.Context, promoDto *promo.CreatePromoDTO) (i
 
 			_, err = s.promoCodeRepository.Create(ctx, promoCodeModel)
 			if err != nil {
-				s.log.Error("Failed to save unique promo code to db", zap.Error(err))
+				s.logger(ctx).Error("Failed to save unique promo code to db", zap.Error(err))
 			}
 		}
 	} else {
-		s.log.Error("Invalid promo mode", zap.String("mode", string(promoDto.Mode)))
+		s.logger(ctx).Error("Invalid promo mode", zap.String("mode", string(promoDto.Mode)))
 		return "", domainerrors.ValidationError{Field: "mode", Message: "Invalid promo mode"}
 	}
 
+	promosCreated.WithLabelValues(string(promoDto.Mode)).Inc()
+
+	s.audit(ctx, id, promoDto.CompanyId, auditenum.OperationCreate, nil, &promoSnapshot{
+		Description:      promoModel.Description,
+		ImageUrl:         promoModel.ImageUrl,
+		TargetAgeFrom:    int64(promoModel.TargetAgeFrom),
+		TargetAgeUntil:   int64(promoModel.TargetAgeUntil),
+		TargetCountry:    promoModel.TargetCountry,
+		TargetCategories: promoModel.TargetCategories,
+		ActiveFrom:       promoModel.ActiveFrom,
+		ActiveUntil:      promoModel.ActiveUntil,
+		Status:           promoModel.Status,
+		Reward:           promoModel.Reward,
+		Stacking:         promoModel.Stacking,
+		ActivationLimits: promoModel.ActivationLimits,
+		ReferralReward:   promoModel.ReferralReward,
+		Localization:     promoModel.Localization,
+	})
+
 	return id, nil
 }
 
-func (s *Service) List(ctx context.Context, companyId string, countries []string, limit int, offset int, sortBy promoenum.SortBy) (promoDTOs []promo.DTO, err error) {
+func (s *Service) List(ctx context.Context, companyId string, countries []string, limit int, offset int, sortBy promoenum.SortBy, searchQuery string) (promoDTOs []promo.DTO, err error) {
 
-	promoModels, err := s.promoRepository.List(ctx, companyId, countries, offset, sortBy, limit)
+	promoModels, err := s.promoRepository.List(ctx, companyId, countries, offset, sortBy, limit, searchQuery)
 
 	if err != nil {
 		return nil, fmt.Errorf("promoRepository.List: %w", err)
 	}
 
+	return s.promoDetailsToDTOs(ctx, promoModels)
+}
+
+func (s *Service) Feed(ctx context.Context, category string, limit int, offset int, searchQuery string) (promoDTOs []promo.DTO, err error) {
+
+	promoModels, err := s.promoRepository.Feed(ctx, category, offset, limit, searchQuery)
+
+	if err != nil {
+		return nil, fmt.Errorf("promoRepository.Feed: %w", err)
+	}
+
+	return s.promoDetailsToDTOs(ctx, promoModels)
+}
+
+func (s *Service) promoDetailsToDTOs(ctx context.Context, promoModels []promoStorage.PromoDetails) (promoDTOs []promo.DTO, err error) {
+
 	promoDTOs = make([]promo.DTO, len(promoModels))
 
 	for idx, promoModel := range promoModels {
@@ -138,7 +222,7 @@ func (s *Service) List(ctx context.Context, companyId string, countries []string
 		companyName, err := s.accountServiceClient.GetCompanyNameByCompanyID(ctx, promoModel.CompanyId)
 		if err != nil {
 			if status.Code(err) == codes.NotFound {
-				s.log.Error("company does not exist", zap.String("company_id", promoModel.CompanyId))
+				s.logger(ctx).Error("company does not exist", zap.String("company_id", promoModel.CompanyId))
 				return nil, ErrCompanyDoesNotExist
 			}
 			return nil, fmt.Errorf("accountServiceClient.GetCompanyNameByCompanyID: %w", err)
@@ -164,28 +248,47 @@ func (s *Service) List(ctx context.Context, companyId string, countries []string
 				Country:    promoModel.TargetCountry,
 				Categories: promoModel.TargetCategories,
 			},
-			ActiveFrom:  promoModel.ActiveFrom,
-			ActiveUntil: promoModel.ActiveUntil,
+			ActiveFrom:       promoModel.ActiveFrom,
+			ActiveUntil:      promoModel.ActiveUntil,
+			Highlight:        promoModel.Highlight,
+			SearchRank:       promoModel.SearchRank,
+			Status:           promoModel.Status,
+			Reward:           rewardFromModel(promoModel.Reward),
+			Stacking:         stackingFromModel(promoModel.Stacking),
+			ActivationLimits: activationLimitsFromModel(promoModel.ActivationLimits),
+			ReferralReward:   referralRewardFromModel(promoModel.ReferralReward),
+			Localization:     localizationFromModel(promoModel.Localization),
 		}
+		promoDTOs[idx].Active = promoDTOs[idx].IsActive(time.Now())
 	}
 
 	return promoDTOs, nil
 }
 
-func (s *Service) Count(ctx context.Context, companyId string, countries []string) (count int, err error) {
-	count, err = s.promoRepository.Count(ctx, companyId, countries)
+func (s *Service) Count(ctx context.Context, companyId string, countries []string, searchQuery string) (count int, err error) {
+	count, err = s.promoRepository.Count(ctx, companyId, countries, searchQuery)
 	if err != nil {
 		return 0, fmt.Errorf("promoRepository.Count: %w", err)
 	}
 	return count, nil
 }
 
+func (s *Service) FeedCount(ctx context.Context, category string, searchQuery string) (count int, err error) {
+	count, err = s.promoRepository.FeedCount(ctx, category, searchQuery)
+	if err != nil {
+		return 0, fmt.Errorf("promoRepository.FeedCount: %w", err)
+	}
+	return count, nil
+}
+
 func (s *Service) GetById(ctx context.Context, promoId string, companyId string) (promoDTO *promo.DTO, err error) {
 
 	promoModel := new(promoStorage.PromoDetails)
 
 	cashedPromoModel := s.redisDb.Get(ctx, promoId).Val()
 
+	metrics.ObserveCache(promoCacheName, cashedPromoModel != "")
+
 	if cashedPromoModel != "" {
 		err = json.Unmarshal([]byte(cashedPromoModel), promoModel)
 		if err != nil {
@@ -208,7 +311,7 @@ func (s *Service) GetById(ctx context.Context, promoId string, companyId string)
 
 		err = s.redisDb.Set(ctx, promoId, string(promoModelJSON), 0).Err()
 		if err != nil {
-			s.log.Warn("s.redisDb.Set: Failed to save promo to redis", zap.Error(err))
+			s.logger(ctx).Warn("s.redisDb.Set: Failed to save promo to redis", zap.Error(err))
 		}
 	}
 
@@ -241,9 +344,16 @@ func (s *Service) GetById(ctx context.Context, promoId string, companyId string)
 			Country:    promoModel.TargetCountry,
 			Categories: promoModel.TargetCategories,
 		},
-		ActiveFrom:  promoModel.ActiveFrom,
-		ActiveUntil: promoModel.ActiveUntil,
+		ActiveFrom:       promoModel.ActiveFrom,
+		ActiveUntil:      promoModel.ActiveUntil,
+		Status:           promoModel.Status,
+		Reward:           rewardFromModel(promoModel.Reward),
+		Stacking:         stackingFromModel(promoModel.Stacking),
+		ActivationLimits: activationLimitsFromModel(promoModel.ActivationLimits),
+		ReferralReward:   referralRewardFromModel(promoModel.ReferralReward),
+		Localization:     localizationFromModel(promoModel.Localization),
 	}
+	promoDTO.Active = promoDTO.IsActive(time.Now())
 
 	return promoDTO, nil
 }
@@ -258,9 +368,19 @@ func (s *Service) Update(ctx context.Context,
 	targetCountry string,
 	targetCategories []string,
 	activeFrom time.Time,
-	activeUntil time.Time) error {
+	activeUntil time.Time,
+	rewardDto *reward.DTO,
+	stackingSettings *stacking.Settings,
+	activationLimits []limit.Policy,
+	referralReward *referral.Reward,
+	localizationDto *localization.Localization) error {
 
-	err := validateUpdate(
+	localizationDto, err := localization.Normalize(localizationDto)
+	if err != nil {
+		return err
+	}
+
+	err = validateUpdate(
 		description,
 		imageUrl,
 		targetAgeFrom,
@@ -269,6 +389,10 @@ func (s *Service) Update(ctx context.Context,
 		targetCategories,
 		activeFrom,
 		activeUntil,
+		rewardDto,
+		stackingSettings,
+		activationLimits,
+		referralReward,
 	)
 	if err != nil {
 		return err
@@ -288,12 +412,33 @@ func (s *Service) Update(ctx context.Context,
 		return ErrPermissionDenied
 	}
 
-	err = s.promoRepository.Update(ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil)
+	now := time.Now()
+	nextStatus, ok := promoenum.RescheduledStatus(promoDTO.Status, activeFrom, activeUntil, now)
+	if !ok {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, promoDTO.Status, nextStatus)
+	}
+
+	var stackingModel *model.Stacking
+	if stackingSettings != nil {
+		stackingModel = pointer.To(stackingToModel(*stackingSettings))
+	}
+
+	var activationLimitsModel *model.ActivationLimits
+	if activationLimits != nil {
+		activationLimitsModel = pointer.To(activationLimitsToModel(activationLimits))
+	}
+
+	var localizationModel *model.Localization
+	if localizationDto != nil {
+		localizationModel = pointer.To(localizationToModel(localizationDto))
+	}
+
+	updated, err := s.promoRepository.Update(ctx, promoId, description, imageUrl, targetAgeFrom, targetAgeUntil, targetCountry, targetCategories, activeFrom, activeUntil, rewardToModel(rewardDto), stackingModel, activationLimitsModel, referralRewardToModel(referralReward), localizationModel, promoDTO.Status, nextStatus)
 
 	defer func() {
 		err = s.redisDb.Del(ctx, promoId).Err()
 		if err != nil {
-			s.log.Warn("s.redisDb.Del: Failed to delete promo from redis", zap.Error(err))
+			s.logger(ctx).Warn("s.redisDb.Del: Failed to delete promo from redis", zap.Error(err))
 		}
 	}()
 
@@ -301,6 +446,43 @@ func (s *Service) Update(ctx context.Context,
 		return fmt.Errorf("promoRepository.Update: %w", err)
 	}
 
+	if !updated {
+		return ErrInvalidTransition
+	}
+
+	before := newPromoSnapshot(promoDTO)
+	after := *before
+	after.Description = description
+	after.ImageUrl = imageUrl
+	after.TargetAgeFrom = targetAgeFrom
+	after.TargetAgeUntil = targetAgeUntil
+	after.TargetCountry = targetCountry
+	after.TargetCategories = targetCategories
+	after.ActiveFrom = activeFrom
+	after.ActiveUntil = activeUntil
+	after.Status = nextStatus
+	if rewardDto != nil {
+		after.Reward = rewardToModel(rewardDto)
+	}
+	if stackingModel != nil {
+		after.Stacking = *stackingModel
+	}
+	if activationLimitsModel != nil {
+		after.ActivationLimits = *activationLimitsModel
+	}
+	if referralReward != nil {
+		after.ReferralReward = referralRewardToModel(referralReward)
+	}
+	if localizationModel != nil {
+		after.Localization = *localizationModel
+	}
+
+	s.audit(ctx, promoId, companyId, auditenum.OperationUpdate, before, &after)
+
+	if nextStatus == promoenum.StatusExpired && promoDTO.Status != promoenum.StatusExpired {
+		s.publish(ctx, companyId, webhookenum.EventPromoExpired, statusEvent{PromoId: promoId, ChangedAt: now.UTC()})
+	}
+
 	return nil
 
 }
@@ -313,6 +495,10 @@ func validateUpdate(description string,
 	targetCategories []string,
 	activeFrom time.Time,
 	activeUntil time.Time,
+	rewardDto *reward.DTO,
+	stackingSettings *stacking.Settings,
+	activationLimits []limit.Policy,
+	referralReward *referral.Reward,
 ) error {
 	validate := validator.New()
 
@@ -325,6 +511,65 @@ func validateUpdate(description string,
 
 	}
 
+	if stackingSettings != nil {
+		if err = stackingSettings.Validate(); err != nil {
+			return domainerrors.ValidationError{
+				Field:   "stacking",
+				Message: err.Error(),
+			}
+		}
+	}
+
+	if err = limit.Validate(activationLimits); err != nil {
+		return err
+	}
+
+	if err = referral.Validate(referralReward); err != nil {
+		return err
+	}
+
+	return promo.ValidateReward(rewardDto)
+}
+
+// SetImage прикрепляет к промокоду загруженное изображение
+func (s *Service) SetImage(ctx context.Context, promoId string, companyId string, imageUrl string) error {
+	err := validator.New().Var(imageUrl, "required,url,max=350")
+	if err != nil {
+		return domainerrors.ValidationError{
+			Field:   "image_url",
+			Message: err.Error(),
+		}
+	}
+
+	promoDTO, err := s.GetById(ctx, promoId, companyId)
+
+	if err != nil {
+		return fmt.Errorf("s.GetById: %w", err)
+	}
+
+	if promoDTO == nil {
+		return ErrNotFound
+	}
+
+	if promoDTO.CompanyId != companyId {
+		return ErrPermissionDenied
+	}
+
+	defer func() {
+		err = s.redisDb.Del(ctx, promoId).Err()
+		if err != nil {
+			s.logger(ctx).Warn("s.redisDb.Del: Failed to delete promo from redis", zap.Error(err))
+		}
+	}()
+
+	err = s.promoRepository.SetImage(ctx, promoId, imageUrl)
+
+	if err != nil {
+		return fmt.Errorf("promoRepository.SetImage: %w", err)
+	}
+
+	s.audit(ctx, promoId, companyId, auditenum.OperationUpdate, map[string]string{"image_url": promoDTO.ImageURL}, map[string]string{"image_url": imageUrl})
+
 	return nil
 }
 
@@ -346,7 +591,7 @@ func (s *Service) Delete(ctx context.Context, promoId string, companyId string)
 	defer func() {
 		err = s.redisDb.Del(ctx, promoId).Err()
 		if err != nil {
-			s.log.Warn("s.redisDb.Del: Failed to delete promo from redis", zap.Error(err))
+			s.logger(ctx).Warn("s.redisDb.Del: Failed to delete promo from redis", zap.Error(err))
 		}
 	}()
 
@@ -356,23 +601,92 @@ func (s *Service) Delete(ctx context.Context, promoId string, companyId string)
 		return fmt.Errorf("promoRepository.Delete: %w", err)
 	}
 
+	s.audit(ctx, promoId, companyId, auditenum.OperationDelete, newPromoSnapshot(promoDTO), nil)
+
+	return nil
+}
+
+// Restore возвращает удалённый промокод, если срок хранения удалённых промокодов ещё не истёк
+func (s *Service) Restore(ctx context.Context, promoId string, companyId string) error {
+	restored, err := s.promoRepository.Restore(ctx, promoId, companyId, time.Now().Add(-s.deletedPromoRetention))
+	if err != nil {
+		return fmt.Errorf("promoRepository.Restore: %w", err)
+	}
+
+	if !restored {
+		return ErrNotFound
+	}
+
+	s.audit(ctx, promoId, companyId, auditenum.OperationRestore, map[string]bool{"deleted": true}, map[string]bool{"deleted": false})
+
 	return nil
 }
 
-func (s *Service) Activate(ctx context.Context, promoId string) (code string, err error) {
+// PurgeDeleted окончательно удаляет промокоды, срок хранения которых после удаления истёк
+func (s *Service) PurgeDeleted(ctx context.Context) (purged int, err error) {
+	purgedPromos, err := s.promoRepository.Purge(ctx, time.Now().Add(-s.deletedPromoRetention))
+	if err != nil {
+		return 0, fmt.Errorf("promoRepository.Purge: %w", err)
+	}
+
+	promoIds := make([]string, len(purgedPromos))
+	for idx, purgedPromo := range purgedPromos {
+		promoIds[idx] = purgedPromo.PromoId
+		s.auditSystem(ctx, purgedPromo.PromoId, purgedPromo.CompanyId, auditenum.OperationPurge, map[string]bool{"purged": false}, map[string]bool{"purged": true})
+	}
+
+	if len(promoIds) > 0 {
+		err = s.redisDb.Del(ctx, promoIds...).Err()
+		if err != nil {
+			s.logger(ctx).Warn("s.redisDb.Del: Failed to delete promos from redis", zap.Error(err))
+		}
+	}
+
+	return len(promoIds), nil
+}
+
+// Activate активирует промокод для пользователя. userId нужен для лимитов на пользователя.
+// При нарушении лимита возвращается *limit.ExceededError
+func (s *Service) Activate(ctx context.Context, promoId string, userId string) (code string, err error) {
+
+	promoModel, err := s.promoRepository.GetById(ctx, promoId)
+	if err != nil {
+		return "", fmt.Errorf("promoRepository.GetById: %w", err)
+	}
 
-	code, err = s.promoCodeRepository.Activate(ctx, promoId)
+	if promoModel == nil {
+		return "", ErrNotFound
+	}
 
+	// статус обновляет воркер жизненного цикла раз в LifecycleInterval, поэтому окно активности
+	// проверяется и здесь: между тиками промокод с истёкшим active_until ещё числится active
+	if promoModel.Status != promoenum.StatusActive ||
+		promoenum.LiveStatus(promoModel.ActiveFrom, promoModel.ActiveUntil, time.Now()) != promoenum.StatusActive {
+		return "", ErrPromoNotActive
+	}
+
+	// антифрод проверяется до активации: отклонённый запрос не должен расходовать код и лимиты
 	if antifraud() {
+		fraudRejections.Inc()
 		return "", ErrFraudDetected
 	}
 
+	code, exhausted, err := s.promoCodeRepository.Activate(ctx, promoId, userId)
 	if err != nil {
 
 		if errors.Is(err, promo_code.ErrNoActivations) {
 			return "", ErrNoActivations
 		}
 
+		if errors.Is(err, promo_code.ErrUserRequired) {
+			return "", ErrUserRequired
+		}
+
+		var exceeded *limit.ExceededError
+		if errors.As(err, &exceeded) {
+			return "", exceeded
+		}
+
 		return "", fmt.Errorf("promoCodeRepository.Activate: %w", err)
 	}
 
@@ -380,11 +694,247 @@ func (s *Service) Activate(ctx context.Context, promoId string) (code string, er
 		return "", ErrNotFound
 	}
 
+	activations.Inc()
+
+	err = s.redisDb.Del(ctx, promoId).Err()
+	if err != nil {
+		s.logger(ctx).Warn("s.redisDb.Del: Failed to delete promo from redis", zap.Error(err))
+	}
+
+	activatedAt := time.Now().UTC()
+	s.publish(ctx, promoModel.CompanyId, webhookenum.EventPromoActivated, activatedEvent{
+		PromoId:     promoId,
+		Code:        code,
+		UserId:      userId,
+		ActivatedAt: activatedAt,
+	})
+
+	// последний код переводит промокод в exhausted в транзакции активации, AdvanceStatuses этот переход уже не увидит
+	if exhausted {
+		promosExhausted.Inc()
+		s.auditSystem(ctx, promoId, promoModel.CompanyId, auditenum.OperationStatusChange,
+			map[string]promoenum.Status{"status": promoenum.StatusActive}, map[string]promoenum.Status{"status": promoenum.StatusExhausted})
+		s.publish(ctx, promoModel.CompanyId, webhookenum.EventPromoExhausted, statusEvent{PromoId: promoId, ChangedAt: activatedAt})
+	}
+
+	s.convertReferral(ctx, promoModel, userId)
+
 	return code, nil
 }
 
-// В будущем логика антифрода
-func antifraud() bool {
+func (s *Service) Publish(ctx context.Context, promoId string, companyId string) (promoenum.Status, error) {
+	return s.transition(ctx, promoId, companyId, auditenum.OperationPublish, []promoenum.Status{promoenum.StatusDraft}, func(promoDTO *promo.DTO) promoenum.Status {
+		return promoenum.LiveStatus(promoDTO.ActiveFrom, promoDTO.ActiveUntil, time.Now())
+	})
+}
+
+func (s *Service) Pause(ctx context.Context, promoId string, companyId string) (promoenum.Status, error) {
+	return s.transition(ctx, promoId, companyId, auditenum.OperationPause, []promoenum.Status{promoenum.StatusScheduled, promoenum.StatusActive}, func(*promo.DTO) promoenum.Status {
+		return promoenum.StatusPaused
+	})
+}
+
+func (s *Service) Resume(ctx context.Context, promoId string, companyId string) (promoenum.Status, error) {
+	return s.transition(ctx, promoId, companyId, auditenum.OperationResume, []promoenum.Status{promoenum.StatusPaused}, func(promoDTO *promo.DTO) promoenum.Status {
+		return promoenum.LiveStatus(promoDTO.ActiveFrom, promoDTO.ActiveUntil, time.Now())
+	})
+}
+
+func (s *Service) Archive(ctx context.Context, promoId string, companyId string) (promoenum.Status, error) {
+	return s.transition(ctx, promoId, companyId, auditenum.OperationArchive, nil, func(*promo.DTO) promoenum.Status {
+		return promoenum.StatusArchived
+	})
+}
+
+// transition переводит промокод в статус, вычисленный next, если текущий статус входит в from
+// (пустой from допускает любой статус) и переход разрешён машиной состояний.
+func (s *Service) transition(
+	ctx context.Context,
+	promoId string,
+	companyId string,
+	operation auditenum.Operation,
+	from []promoenum.Status,
+	next func(promoDTO *promo.DTO) promoenum.Status,
+) (promoenum.Status, error) {
+	promoDTO, err := s.GetById(ctx, promoId, companyId)
+	if err != nil {
+		return "", fmt.Errorf("s.GetById: %w", err)
+	}
+
+	if len(from) > 0 && !slices.Contains(from, promoDTO.Status) {
+		return "", ErrInvalidTransition
+	}
+
+	to := next(promoDTO)
+	if !promoDTO.Status.CanTransitionTo(to) {
+		return "", ErrInvalidTransition
+	}
+
+	updated, err := s.promoRepository.UpdateStatus(ctx, promoId, promoDTO.Status, to)
+
+	defer func() {
+		err := s.redisDb.Del(ctx, promoId).Err()
+		if err != nil {
+			s.logger(ctx).Warn("s.redisDb.Del: Failed to delete promo from redis", zap.Error(err))
+		}
+	}()
+
+	if err != nil {
+		return "", fmt.Errorf("promoRepository.UpdateStatus: %w", err)
+	}
+
+	if !updated {
+		return "", ErrInvalidTransition
+	}
+
+	s.audit(ctx, promoId, companyId, operation,
+		map[string]promoenum.Status{"status": promoDTO.Status}, map[string]promoenum.Status{"status": to})
+
+	return to, nil
+}
+
+// AdvanceStatuses переводит промокоды в expired, exhausted и active по мере течения времени и расхода активаций
+func (s *Service) AdvanceStatuses(ctx context.Context) (advanced int, err error) {
+	now := time.Now()
+	changes, err := s.promoRepository.AdvanceStatuses(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("promoRepository.AdvanceStatuses: %w", err)
+	}
+
+	promoIds := make([]string, len(changes))
+	for idx, change := range changes {
+		promoIds[idx] = change.PromoId
+		s.auditSystem(ctx, change.PromoId, change.CompanyId, auditenum.OperationStatusChange,
+			map[string]promoenum.Status{"status": change.From}, map[string]promoenum.Status{"status": change.To})
+
+		switch change.To {
+		case promoenum.StatusExhausted:
+			promosExhausted.Inc()
+			s.publish(ctx, change.CompanyId, webhookenum.EventPromoExhausted, statusEvent{PromoId: change.PromoId, ChangedAt: now.UTC()})
+		case promoenum.StatusExpired:
+			s.publish(ctx, change.CompanyId, webhookenum.EventPromoExpired, statusEvent{PromoId: change.PromoId, ChangedAt: now.UTC()})
+		}
+	}
+
+	if len(promoIds) > 0 {
+		err = s.redisDb.Del(ctx, promoIds...).Err()
+		if err != nil {
+			s.logger(ctx).Warn("s.redisDb.Del: Failed to delete promos from redis", zap.Error(err))
+		}
+	}
+
+	return len(promoIds), nil
+}
+
+func (s *Service) ListAuditLog(ctx context.Context, filter auditdto.Filter, limit int, offset int) (entries []auditdto.Entry, err error) {
+	entryModels, err := s.auditRepository.List(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("auditRepository.List: %w", err)
+	}
+
+	return functional.Map(entryModels, func(entry model.AuditEntry) auditdto.Entry {
+		return auditdto.Entry{
+			Id:          entry.Id,
+			PromoId:     entry.PromoId,
+			CompanyId:   entry.CompanyId,
+			ActorId:     entry.ActorId,
+			ActorUserId: entry.ActorUserId,
+			Operation:   entry.Operation,
+			Changes:     json.RawMessage(entry.Changes),
+			CreatedAt:   entry.CreatedAt,
+		}
+	}), nil
+}
+
+func (s *Service) CountAuditLog(ctx context.Context, filter auditdto.Filter) (count int, err error) {
+	count, err = s.auditRepository.Count(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("auditRepository.Count: %w", err)
+	}
+	return count, nil
+}
+
+// promoSnapshot фиксирует редактируемые поля промокода для журнала изменений
+type promoSnapshot struct {
+	Description      string                 `json:"description"`
+	ImageUrl         string                 `json:"image_url"`
+	TargetAgeFrom    int64                  `json:"target_age_from"`
+	TargetAgeUntil   int64                  `json:"target_age_until"`
+	TargetCountry    string                 `json:"target_country"`
+	TargetCategories []string               `json:"target_categories"`
+	ActiveFrom       time.Time              `json:"active_from"`
+	ActiveUntil      time.Time              `json:"active_until"`
+	Status           promoenum.Status       `json:"status"`
+	Reward           *model.Reward          `json:"reward"`
+	ActivationLimits model.ActivationLimits `json:"activation_limits"`
+	ReferralReward   *model.ReferralReward  `json:"referral_reward"`
+	model.Stacking
+	model.Localization
+}
+
+func newPromoSnapshot(promoDTO *promo.DTO) *promoSnapshot {
+	snapshot := &promoSnapshot{
+		Description:      promoDTO.Description,
+		ImageUrl:         promoDTO.ImageURL,
+		ActiveFrom:       promoDTO.ActiveFrom,
+		ActiveUntil:      promoDTO.ActiveUntil,
+		Status:           promoDTO.Status,
+		Reward:           rewardToModel(promoDTO.Reward),
+		Stacking:         stackingToModel(promoDTO.Stacking),
+		ActivationLimits: activationLimitsToModel(promoDTO.ActivationLimits),
+		ReferralReward:   referralRewardToModel(promoDTO.ReferralReward),
+		Localization:     localizationToModel(&promoDTO.Localization),
+	}
+
+	if promoDTO.Target != nil {
+		snapshot.TargetAgeFrom = promoDTO.Target.AgeFrom
+		snapshot.TargetAgeUntil = promoDTO.Target.AgeUntil
+		snapshot.TargetCountry = promoDTO.Target.Country
+		snapshot.TargetCategories = promoDTO.Target.Categories
+	}
+
+	return snapshot
+}
+
+// audit дописывает в журнал изменение, сделанное по запросу компании. Автор берётся из company_id
+// и user_id, которые AuthInterceptor кладёт в контекст после проверки запроса
+func (s *Service) audit(ctx context.Context, promoId string, companyId string, operation auditenum.Operation, before any, after any) {
+	actorId, _ := ctx.Value("company_id").(string)
+	actorUserId, _ := ctx.Value("user_id").(string)
+	s.writeAudit(ctx, promoId, companyId, actorId, actorUserId, operation, before, after)
+}
+
+// auditSystem дописывает в журнал изменение, сделанное фоновой задачей
+func (s *Service) auditSystem(ctx context.Context, promoId string, companyId string, operation auditenum.Operation, before any, after any) {
+	s.writeAudit(ctx, promoId, companyId, auditenum.SystemActor, "", operation, before, after)
+}
+
+// writeAudit дописывает запись в журнал изменений. Ошибка записи не отменяет уже совершённую операцию.
+func (s *Service) writeAudit(ctx context.Context, promoId string, companyId string, actorId string, actorUserId string, operation auditenum.Operation, before any, after any) {
+	changes, err := auditdto.Diff(before, after)
+	if err != nil {
+		s.logger(ctx).Error("auditdto.Diff: Failed to build audit diff", zap.String("promo_id", promoId), zap.Error(err))
+		return
+	}
+
+	err = s.auditRepository.Create(ctx, &model.AuditEntry{
+		Id:          uuid.New().String(),
+		PromoId:     promoId,
+		CompanyId:   companyId,
+		ActorId:     actorId,
+		ActorUserId: actorUserId,
+		Operation:   operation,
+		Changes:     model.JSON(changes),
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		s.logger(ctx).Error("auditRepository.Create: Failed to write audit entry",
+			zap.String("promo_id", promoId), zap.String("operation", string(operation)), zap.Error(err))
+	}
+}
+
+// В будущем логика антифрода. Переменная, чтобы тесты могли отключить случайные отказы
+var antifraud = func() bool {
 	if rand.Intn(100) < 25 {
 		return true
 	}
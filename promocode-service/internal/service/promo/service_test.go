@@ -1,25 +1,53 @@
 package promo
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	auditdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/cart"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/localization"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/referral"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/target"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/userdata"
+	auditenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/audit"
+	exportenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/export"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
 	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	domainerrors "gitlab.com/pisya-dev/promo-code-service/internal/domain/errors"
 	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
 	promoStorage "gitlab.com/pisya-dev/promo-code-service/internal/storage/promo"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/barcode"
+	"gitlab.com/pisya-dev/promo-code-service/pkg/tabular"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 )
 
+// actorUserId пользователь компании, от имени которого auth-service вызывает методы в тестах
+const actorUserId = "6a1c9f0e-2b7d-4e3a-9c58-0f4d2e7b1a63"
+
+// authCtx повторяет значения, которые AuthInterceptor кладёт в контекст запроса компании
+func authCtx(companyId string, userId string) context.Context {
+	ctx := context.WithValue(context.Background(), "company_id", companyId)
+	return context.WithValue(ctx, "user_id", userId)
+}
+
 func TestService_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -89,6 +117,7 @@ func TestService_List(t *testing.T) {
 			10,
 			promoenum.SortByActiveFrom,
 			20,
+			"",
 		).Return(testPromos, nil)
 
 		mockAccountClient.EXPECT().GetCompanyNameByCompanyID(ctx, "company1").
@@ -104,6 +133,7 @@ func TestService_List(t *testing.T) {
 			20,
 			10,
 			promoenum.SortByActiveFrom,
+			"",
 		)
 
 		// Проверки
@@ -122,9 +152,10 @@ func TestService_List(t *testing.T) {
 			gomock.Any(),
 			gomock.Any(),
 			gomock.Any(),
+			gomock.Any(),
 		).Return(nil, expectedErr)
 
-		result, err := service.List(ctx, "", nil, 0, 0, promoenum.SortByCreatedAt)
+		result, err := service.List(ctx, "", nil, 0, 0, promoenum.SortByCreatedAt, "")
 
 		assert.ErrorIs(t, err, expectedErr)
 		assert.Nil(t, result)
@@ -140,12 +171,13 @@ func TestService_List(t *testing.T) {
 			gomock.Any(),
 			gomock.Any(),
 			gomock.Any(),
+			gomock.Any(),
 		).Return(testPromos, nil)
 
 		mockAccountClient.EXPECT().GetCompanyNameByCompanyID(gomock.Any(), "company1").
 			Return("", testErr)
 
-		result, err := service.List(ctx, "", nil, 0, 0, promoenum.SortByActiveUntil)
+		result, err := service.List(ctx, "", nil, 0, 0, promoenum.SortByActiveUntil, "")
 
 		assert.ErrorContains(t, err, "accountServiceClient.GetCompanyNameByCompanyID")
 		assert.Nil(t, result)
@@ -159,9 +191,10 @@ func TestService_List(t *testing.T) {
 			gomock.Any(),
 			gomock.Any(),
 			gomock.Any(),
+			gomock.Any(),
 		).Return([]promoStorage.PromoDetails{}, nil)
 
-		result, err := service.List(ctx, "", nil, 0, 0, promoenum.SortByActiveUntil)
+		result, err := service.List(ctx, "", nil, 0, 0, promoenum.SortByActiveUntil, "")
 
 		assert.NoError(t, err)
 		assert.Empty(t, result)
@@ -329,6 +362,58 @@ func TestService_GetById(t *testing.T) {
 	}
 }
 
+func TestService_Feed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	mockRepo := NewMockpromoRepository(ctrl)
+	mockAccountClient := NewMockaccountServiceClient(ctrl)
+
+	service := &Service{
+		log:                  zap.NewNop(),
+		promoRepository:      mockRepo,
+		accountServiceClient: mockAccountClient,
+	}
+
+	t.Run("search results keep highlight and rank", func(t *testing.T) {
+		mockRepo.EXPECT().Feed(ctx, "коты", 0, 10, "кошачий корм").Return([]promoStorage.PromoDetails{
+			{
+				Id:          "1",
+				CompanyId:   "company1",
+				Description: "Скидка на кошачий корм",
+				Mode:        promo.COMMON,
+				Highlight:   "Скидка на <b>кошачий</b> <b>корм</b>",
+				SearchRank:  0.75,
+			},
+		}, nil)
+
+		mockAccountClient.EXPECT().GetCompanyNameByCompanyID(ctx, "company1").
+			Return("Company One", nil)
+
+		result, err := service.Feed(ctx, "коты", 10, 0, "кошачий корм")
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "Company One", result[0].CompanyName)
+		assert.Equal(t, "Скидка на <b>кошачий</b> <b>корм</b>", result[0].Highlight)
+		assert.Equal(t, 0.75, result[0].SearchRank)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		expectedErr := errors.New("database error")
+
+		mockRepo.EXPECT().Feed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, expectedErr)
+
+		result, err := service.Feed(ctx, "", 10, 0, "")
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, result)
+	})
+}
+
 func TestService_Count(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -374,7 +459,7 @@ func TestService_Count(t *testing.T) {
 			// Setup mocks
 			mockRepo := NewMockpromoRepository(ctrl)
 			mockRepo.EXPECT().
-				Count(ctx, tt.companyID, tt.countries).
+				Count(ctx, tt.companyID, tt.countries, "").
 				Return(tt.mockRepoCount, tt.mockRepoErr)
 
 			// Create service with mock dependencies
@@ -384,7 +469,7 @@ func TestService_Count(t *testing.T) {
 			}
 
 			// Execute
-			_, err := service.Count(ctx, tt.companyID, tt.countries)
+			_, err := service.Count(ctx, tt.companyID, tt.countries, "")
 
 			// Assert
 			if tt.expectedErrStr != "" {
@@ -406,6 +491,7 @@ func TestService_Create(t *testing.T) {
 		promoCodeRepository  *MockpromoCodeRepository
 		redisDb              *MockredisDb
 		accountServiceClient *MockaccountServiceClient
+		auditRepository      *MockauditRepository
 	}
 	type args struct {
 		ctx      context.Context
@@ -431,9 +517,10 @@ func TestService_Create(t *testing.T) {
 				promoCodeRepository:  NewMockpromoCodeRepository(ctrl),
 				redisDb:              NewMockredisDb(ctrl),
 				accountServiceClient: NewMockaccountServiceClient(ctrl),
+				auditRepository:      NewMockauditRepository(ctrl),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: authCtx("1113d90f-993c-4586-ab59-aa41b62ef792", actorUserId),
 				promoDto: &promo.CreatePromoDTO{
 					CompanyId:   "1113d90f-993c-4586-ab59-aa41b62ef792",
 					Mode:        promo.COMMON,
@@ -482,6 +569,15 @@ func TestService_Create(t *testing.T) {
 					return promoCodeId, nil
 				})
 
+				f.auditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+					require.Equal(t, promoId, entry.PromoId)
+					require.Equal(t, a.promoDto.CompanyId, entry.ActorId)
+					require.Equal(t, actorUserId, entry.ActorUserId)
+					require.Equal(t, auditenum.OperationCreate, entry.Operation)
+					require.Contains(t, string(entry.Changes), a.promoDto.Description)
+					return nil
+				})
+
 			},
 			want:    promoId,
 			wantErr: false,
@@ -496,6 +592,7 @@ func TestService_Create(t *testing.T) {
 				promoCodeRepository:  tt.fields.promoCodeRepository,
 				redisDb:              tt.fields.redisDb,
 				accountServiceClient: tt.fields.accountServiceClient,
+				auditRepository:      tt.fields.auditRepository,
 			}
 
 			tt.prepare(&tt.fields, &tt.args)
@@ -520,6 +617,7 @@ func TestService_Update(t *testing.T) {
 		promoCodeRepository  *MockpromoCodeRepository
 		redisDb              *MockredisDb
 		accountServiceClient *MockaccountServiceClient
+		auditRepository      *MockauditRepository
 	}
 	type args struct {
 		ctx              context.Context
@@ -533,6 +631,11 @@ func TestService_Update(t *testing.T) {
 		targetCategories []string
 		activeFrom       time.Time
 		activeUntil      time.Time
+		reward           *reward.DTO
+		stacking         *stacking.Settings
+		activationLimits []limit.Policy
+		referralReward   *referral.Reward
+		localization     *localization.Localization
 	}
 	tests := []struct {
 		name    string
@@ -550,9 +653,10 @@ func TestService_Update(t *testing.T) {
 				promoCodeRepository:  NewMockpromoCodeRepository(ctrl),
 				redisDb:              NewMockredisDb(ctrl),
 				accountServiceClient: NewMockaccountServiceClient(ctrl),
+				auditRepository:      NewMockauditRepository(ctrl),
 			},
 			args: args{
-				ctx:              context.Background(),
+				ctx:              authCtx("8eb7064a-a899-4ad4-814f-deb2f660536b", actorUserId),
 				promoId:          "f5db5acc-03da-4215-bb0d-87078e422c45",
 				companyId:        "8eb7064a-a899-4ad4-814f-deb2f660536b",
 				description:      "test-description",
@@ -563,6 +667,11 @@ func TestService_Update(t *testing.T) {
 				targetCategories: []string{"cat1", "cat2"},
 				activeFrom:       time.Now(),
 				activeUntil:      time.Now().Add(24 * time.Hour),
+				reward:           &reward.DTO{Type: rewardenum.TypePercent, Percent: 15},
+				stacking:         &stacking.Settings{Group: "seasonal", Priority: 10},
+				activationLimits: []limit.Policy{{Scope: limitenum.ScopeUser, Window: limitenum.WindowMonth, Max: 3}},
+				referralReward:   &referral.Reward{Amount: 50000, Currency: "RUB"},
+				localization:     &localization.Localization{DefaultLocale: "en", Translations: map[string]string{"DE-at": "Neue Beschreibung für Österreich"}},
 			},
 			prepare: func(f *fields, a *args) {
 				//f.promoRepository.EXPECT().Update(
@@ -581,6 +690,7 @@ func TestService_Update(t *testing.T) {
 				f.promoRepository.EXPECT().GetById(gomock.Any(), a.promoId).Return(&promoStorage.PromoDetails{
 					Id:        a.promoId,
 					CompanyId: a.companyId,
+					Status:    promoenum.StatusDraft,
 				}, nil)
 
 				f.promoRepository.EXPECT().Update(
@@ -594,7 +704,14 @@ func TestService_Update(t *testing.T) {
 					a.targetCategories,
 					a.activeFrom,
 					a.activeUntil,
-				).Return(nil)
+					&model.Reward{Type: rewardenum.TypePercent, Percent: 15},
+					&model.Stacking{Group: "seasonal", Priority: 10},
+					&model.ActivationLimits{{Scope: limitenum.ScopeUser, Window: limitenum.WindowMonth, Max: 3}},
+					&model.ReferralReward{Amount: 50000, Currency: "RUB"},
+					&model.Localization{DefaultLocale: "en", DescriptionTranslations: model.DescriptionTranslations{"de-AT": "Neue Beschreibung für Österreich"}},
+					promoenum.StatusDraft,
+					promoenum.StatusDraft,
+				).Return(true, nil)
 
 				f.redisDb.EXPECT().Get(gomock.Any(), gomock.Eq(a.promoId)).Return(redis.NewStringResult("", redis.Nil))
 
@@ -606,6 +723,27 @@ func TestService_Update(t *testing.T) {
 
 				f.accountServiceClient.EXPECT().GetCompanyNameByCompanyID(gomock.Any(), a.companyId).Return("companyName", nil)
 
+				f.auditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+					require.Equal(t, a.promoId, entry.PromoId)
+					require.Equal(t, a.companyId, entry.CompanyId)
+					require.Equal(t, a.companyId, entry.ActorId)
+					require.Equal(t, actorUserId, entry.ActorUserId)
+					require.Equal(t, auditenum.OperationUpdate, entry.Operation)
+
+					var changes map[string]auditdto.Change
+					require.NoError(t, json.Unmarshal(entry.Changes, &changes))
+					require.Equal(t, a.description, changes["description"].After)
+					require.Equal(t, "", changes["description"].Before)
+					require.Nil(t, changes["reward"].Before)
+					require.NotNil(t, changes["reward"].After)
+					require.Equal(t, "seasonal", changes["stacking_group"].After)
+					require.NotNil(t, changes["activation_limits"].After)
+					require.NotNil(t, changes["referral_reward"].After)
+					require.Equal(t, "en", changes["default_locale"].After)
+					require.Equal(t, map[string]any{"de-AT": "Neue Beschreibung für Österreich"}, changes["description_translations"].After)
+					return nil
+				})
+
 			},
 			wantErr: false,
 		},
@@ -619,6 +757,7 @@ func TestService_Update(t *testing.T) {
 				promoCodeRepository:  tt.fields.promoCodeRepository,
 				redisDb:              tt.fields.redisDb,
 				accountServiceClient: tt.fields.accountServiceClient,
+				auditRepository:      tt.fields.auditRepository,
 			}
 
 			tt.prepare(&tt.fields, &tt.args)
@@ -635,6 +774,11 @@ func TestService_Update(t *testing.T) {
 				tt.args.targetCategories,
 				tt.args.activeFrom,
 				tt.args.activeUntil,
+				tt.args.reward,
+				tt.args.stacking,
+				tt.args.activationLimits,
+				tt.args.referralReward,
+				tt.args.localization,
 			)
 
 			if (err != nil) != tt.wantErr {
@@ -645,6 +789,115 @@ func TestService_Update(t *testing.T) {
 	}
 }
 
+// Тест: смена дат действия пересчитывает статус промокода в пределах допустимых переходов,
+// а даты, требующие недопустимого перехода, отклоняются без записи в базу
+func TestService_Update_Reschedule(t *testing.T) {
+	promoId := "f5db5acc-03da-4215-bb0d-87078e422c45"
+	companyId := "8eb7064a-a899-4ad4-814f-deb2f660536b"
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		status      promoenum.Status
+		activeFrom  time.Time
+		activeUntil time.Time
+		want        promoenum.Status
+		wantErr     error
+	}{
+		{
+			name:        "expired promo moved into the future",
+			status:      promoenum.StatusExpired,
+			activeFrom:  now.Add(-24 * time.Hour),
+			activeUntil: now.Add(24 * time.Hour),
+			wantErr:     ErrInvalidTransition,
+		},
+		{
+			name:        "active promo postponed",
+			status:      promoenum.StatusActive,
+			activeFrom:  now.Add(24 * time.Hour),
+			activeUntil: now.Add(48 * time.Hour),
+			wantErr:     ErrInvalidTransition,
+		},
+		{
+			name:        "scheduled promo started earlier",
+			status:      promoenum.StatusScheduled,
+			activeFrom:  now.Add(-time.Hour),
+			activeUntil: now.Add(24 * time.Hour),
+			want:        promoenum.StatusActive,
+		},
+		{
+			name:        "active promo ended earlier",
+			status:      promoenum.StatusActive,
+			activeFrom:  now.Add(-48 * time.Hour),
+			activeUntil: now.Add(-time.Hour),
+			want:        promoenum.StatusExpired,
+		},
+		{
+			name:        "paused promo keeps status",
+			status:      promoenum.StatusPaused,
+			activeFrom:  now.Add(24 * time.Hour),
+			activeUntil: now.Add(48 * time.Hour),
+			want:        promoenum.StatusPaused,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := NewMockpromoRepository(ctrl)
+			redisMock := NewMockredisDb(ctrl)
+			accountMock := NewMockaccountServiceClient(ctrl)
+			auditMock := NewMockauditRepository(ctrl)
+			webhookMock := NewMockwebhookPublisher(ctrl)
+
+			data, _ := json.Marshal(&promoStorage.PromoDetails{Id: promoId, CompanyId: companyId, Status: tt.status})
+			redisMock.EXPECT().Get(gomock.Any(), promoId).Return(redis.NewStringResult(string(data), nil))
+			accountMock.EXPECT().GetCompanyNameByCompanyID(gomock.Any(), companyId).Return("companyName", nil)
+
+			if tt.wantErr == nil {
+				repo.EXPECT().Update(
+					gomock.Any(), promoId, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					tt.activeFrom, tt.activeUntil, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					tt.status, tt.want,
+				).Return(true, nil)
+				redisMock.EXPECT().Del(gomock.Any(), promoId).Return(redis.NewIntCmd(context.Background(), 1))
+				auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+					var changes map[string]auditdto.Change
+					require.NoError(t, json.Unmarshal(entry.Changes, &changes))
+					if tt.want != tt.status {
+						require.Equal(t, string(tt.want), changes["status"].After)
+					}
+					return nil
+				})
+			}
+			if tt.want == promoenum.StatusExpired {
+				webhookMock.EXPECT().Publish(gomock.Any(), companyId, webhookenum.EventPromoExpired, gomock.Any()).Return(nil)
+			}
+
+			s := &Service{
+				log:                  zap.NewNop(),
+				promoRepository:      repo,
+				redisDb:              redisMock,
+				accountServiceClient: accountMock,
+				auditRepository:      auditMock,
+				webhookPublisher:     webhookMock,
+			}
+
+			err := s.Update(context.Background(), promoId, companyId, "description", "", 0, 0, "", nil,
+				tt.activeFrom, tt.activeUntil, &reward.DTO{Type: rewardenum.TypePercent, Percent: 15}, nil, nil, nil, nil)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestService_Delete(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
@@ -655,6 +908,7 @@ func TestService_Delete(t *testing.T) {
 		promoCodeRepository  *MockpromoCodeRepository
 		redisDb              *MockredisDb
 		accountServiceClient *MockaccountServiceClient
+		auditRepository      *MockauditRepository
 	}
 	type args struct {
 		ctx       context.Context
@@ -676,9 +930,10 @@ func TestService_Delete(t *testing.T) {
 				promoCodeRepository:  NewMockpromoCodeRepository(ctrl),
 				redisDb:              NewMockredisDb(ctrl),
 				accountServiceClient: NewMockaccountServiceClient(ctrl),
+				auditRepository:      NewMockauditRepository(ctrl),
 			},
 			args: args{
-				ctx:       context.Background(),
+				ctx:       authCtx("8eb7064a-a899-4ad4-814f-deb2f660536b", actorUserId),
 				promoId:   "4eacc594-942f-482e-b0df-3c6a3f63ef33",
 				companyId: "8eb7064a-a899-4ad4-814f-deb2f660536b",
 			},
@@ -697,6 +952,12 @@ func TestService_Delete(t *testing.T) {
 				f.redisDb.EXPECT().Get(gomock.Any(), gomock.Eq(a.promoId)).Return(redis.NewStringResult("", redis.Nil))
 
 				f.redisDb.EXPECT().Del(gomock.Any(), a.promoId).Return(redis.NewIntCmd(context.Background(), 1))
+
+				f.auditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+					require.Equal(t, a.promoId, entry.PromoId)
+					require.Equal(t, auditenum.OperationDelete, entry.Operation)
+					return nil
+				})
 			},
 		},
 	}
@@ -711,6 +972,7 @@ func TestService_Delete(t *testing.T) {
 				promoCodeRepository:  tt.fields.promoCodeRepository,
 				redisDb:              tt.fields.redisDb,
 				accountServiceClient: tt.fields.accountServiceClient,
+				auditRepository:      tt.fields.auditRepository,
 			}
 
 			err := s.Delete(tt.args.ctx, tt.args.promoId, tt.args.companyId)
@@ -722,3 +984,927 @@ func TestService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestService_SetImage(t *testing.T) {
+	promoId := "4eacc594-942f-482e-b0df-3c6a3f63ef33"
+	companyId := "8eb7064a-a899-4ad4-814f-deb2f660536b"
+	imageUrl := "https://cdn.example.com/promo/4eacc594/image.png"
+
+	tests := []struct {
+		name      string
+		imageUrl  string
+		ownerId   string
+		prepare   func(promoRepository *MockpromoRepository, redisDb *MockredisDb, auditRepository *MockauditRepository)
+		wantErr   error
+		wantField string
+	}{
+		{
+			name:     "success",
+			imageUrl: imageUrl,
+			ownerId:  companyId,
+			prepare: func(promoRepository *MockpromoRepository, redisDb *MockredisDb, auditRepository *MockauditRepository) {
+				promoRepository.EXPECT().SetImage(gomock.Any(), promoId, imageUrl).Return(nil)
+				redisDb.EXPECT().Del(gomock.Any(), promoId).Return(redis.NewIntCmd(context.Background(), 1))
+				auditRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+					require.Equal(t, auditenum.OperationUpdate, entry.Operation)
+					require.JSONEq(t, `{"image_url": {"before": "", "after": "`+imageUrl+`"}}`, string(entry.Changes))
+					return nil
+				})
+			},
+		},
+		{
+			name:     "other company",
+			imageUrl: imageUrl,
+			ownerId:  "00000000-0000-0000-0000-000000000000",
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:      "invalid url",
+			imageUrl:  "not a url",
+			wantField: "image_url",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			promoRepository := NewMockpromoRepository(ctrl)
+			redisDb := NewMockredisDb(ctrl)
+			accountServiceClient := NewMockaccountServiceClient(ctrl)
+			auditRepository := NewMockauditRepository(ctrl)
+
+			if tt.ownerId != "" {
+				redisDb.EXPECT().Get(gomock.Any(), promoId).Return(redis.NewStringResult("", redis.Nil))
+				promoRepository.EXPECT().GetById(gomock.Any(), promoId).Return(&promoStorage.PromoDetails{
+					Id:        promoId,
+					CompanyId: tt.ownerId,
+				}, nil)
+				redisDb.EXPECT().Set(gomock.Any(), promoId, gomock.Any(), gomock.Any()).Return(redis.NewStatusCmd(context.Background(), "OK"))
+			}
+			if tt.ownerId == companyId {
+				accountServiceClient.EXPECT().GetCompanyNameByCompanyID(gomock.Any(), companyId).Return("companyName", nil)
+			}
+			if tt.prepare != nil {
+				tt.prepare(promoRepository, redisDb, auditRepository)
+			}
+
+			s := &Service{
+				log:                  zap.NewNop(),
+				promoRepository:      promoRepository,
+				redisDb:              redisDb,
+				accountServiceClient: accountServiceClient,
+				auditRepository:      auditRepository,
+			}
+
+			err := s.SetImage(context.Background(), promoId, companyId, tt.imageUrl)
+
+			if tt.wantField != "" {
+				var validationErr domainerrors.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				require.Equal(t, tt.wantField, validationErr.Field)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_Publish(t *testing.T) {
+	promoId := "4eacc594-942f-482e-b0df-3c6a3f63ef33"
+	companyId := "8eb7064a-a899-4ad4-814f-deb2f660536b"
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		details *promoStorage.PromoDetails
+		prepare func(repo *MockpromoRepositoryMockRecorder)
+		want    promoenum.Status
+		wantErr error
+	}{
+		{
+			name: "draft becomes active",
+			details: &promoStorage.PromoDetails{
+				Id:          promoId,
+				CompanyId:   companyId,
+				ActiveFrom:  now.Add(-time.Hour),
+				ActiveUntil: now.Add(time.Hour),
+				Status:      promoenum.StatusDraft,
+			},
+			prepare: func(repo *MockpromoRepositoryMockRecorder) {
+				repo.UpdateStatus(gomock.Any(), promoId, promoenum.StatusDraft, promoenum.StatusActive).Return(true, nil)
+			},
+			want: promoenum.StatusActive,
+		},
+		{
+			name: "draft becomes scheduled",
+			details: &promoStorage.PromoDetails{
+				Id:          promoId,
+				CompanyId:   companyId,
+				ActiveFrom:  now.Add(time.Hour),
+				ActiveUntil: now.Add(2 * time.Hour),
+				Status:      promoenum.StatusDraft,
+			},
+			prepare: func(repo *MockpromoRepositoryMockRecorder) {
+				repo.UpdateStatus(gomock.Any(), promoId, promoenum.StatusDraft, promoenum.StatusScheduled).Return(true, nil)
+			},
+			want: promoenum.StatusScheduled,
+		},
+		{
+			name: "already published",
+			details: &promoStorage.PromoDetails{
+				Id:        promoId,
+				CompanyId: companyId,
+				Status:    promoenum.StatusActive,
+			},
+			prepare: func(repo *MockpromoRepositoryMockRecorder) {},
+			wantErr: ErrInvalidTransition,
+		},
+		{
+			name: "status changed concurrently",
+			details: &promoStorage.PromoDetails{
+				Id:          promoId,
+				CompanyId:   companyId,
+				ActiveFrom:  now.Add(-time.Hour),
+				ActiveUntil: now.Add(time.Hour),
+				Status:      promoenum.StatusDraft,
+			},
+			prepare: func(repo *MockpromoRepositoryMockRecorder) {
+				repo.UpdateStatus(gomock.Any(), promoId, promoenum.StatusDraft, promoenum.StatusActive).Return(false, nil)
+			},
+			wantErr: ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := NewMockpromoRepository(ctrl)
+			redisMock := NewMockredisDb(ctrl)
+			accountMock := NewMockaccountServiceClient(ctrl)
+			auditMock := NewMockauditRepository(ctrl)
+
+			data, _ := json.Marshal(tt.details)
+			redisMock.EXPECT().Get(gomock.Any(), promoId).Return(redis.NewStringResult(string(data), nil))
+			redisMock.EXPECT().Del(gomock.Any(), promoId).Return(redis.NewIntCmd(context.Background(), 1)).AnyTimes()
+			accountMock.EXPECT().GetCompanyNameByCompanyID(gomock.Any(), companyId).Return("companyName", nil)
+			tt.prepare(repo.EXPECT())
+			if tt.wantErr == nil {
+				auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+					require.Equal(t, auditenum.OperationPublish, entry.Operation)
+					require.JSONEq(t, `{"status":{"before":"draft","after":"`+string(tt.want)+`"}}`, string(entry.Changes))
+					return nil
+				})
+			}
+
+			s := &Service{
+				log:                  zap.NewNop(),
+				promoRepository:      repo,
+				redisDb:              redisMock,
+				accountServiceClient: accountMock,
+				auditRepository:      auditMock,
+			}
+
+			got, err := s.Publish(context.Background(), promoId, companyId)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_Pause(t *testing.T) {
+	promoId := "4eacc594-942f-482e-b0df-3c6a3f63ef33"
+	companyId := "8eb7064a-a899-4ad4-814f-deb2f660536b"
+
+	tests := []struct {
+		name    string
+		status  promoenum.Status
+		prepare func(repo *MockpromoRepositoryMockRecorder)
+		wantErr error
+	}{
+		{
+			name:   "active",
+			status: promoenum.StatusActive,
+			prepare: func(repo *MockpromoRepositoryMockRecorder) {
+				repo.UpdateStatus(gomock.Any(), promoId, promoenum.StatusActive, promoenum.StatusPaused).Return(true, nil)
+			},
+		},
+		{
+			name:    "expired",
+			status:  promoenum.StatusExpired,
+			prepare: func(repo *MockpromoRepositoryMockRecorder) {},
+			wantErr: ErrInvalidTransition,
+		},
+		{
+			name:    "archived",
+			status:  promoenum.StatusArchived,
+			prepare: func(repo *MockpromoRepositoryMockRecorder) {},
+			wantErr: ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := NewMockpromoRepository(ctrl)
+			redisMock := NewMockredisDb(ctrl)
+			accountMock := NewMockaccountServiceClient(ctrl)
+			auditMock := NewMockauditRepository(ctrl)
+
+			data, _ := json.Marshal(&promoStorage.PromoDetails{Id: promoId, CompanyId: companyId, Status: tt.status})
+			redisMock.EXPECT().Get(gomock.Any(), promoId).Return(redis.NewStringResult(string(data), nil))
+			redisMock.EXPECT().Del(gomock.Any(), promoId).Return(redis.NewIntCmd(context.Background(), 1)).AnyTimes()
+			accountMock.EXPECT().GetCompanyNameByCompanyID(gomock.Any(), companyId).Return("companyName", nil)
+			tt.prepare(repo.EXPECT())
+			if tt.wantErr == nil {
+				auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			s := &Service{
+				log:                  zap.NewNop(),
+				promoRepository:      repo,
+				redisDb:              redisMock,
+				accountServiceClient: accountMock,
+				auditRepository:      auditMock,
+			}
+
+			got, err := s.Pause(context.Background(), promoId, companyId)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, promoenum.StatusPaused, got)
+		})
+	}
+}
+
+func TestService_AdvanceStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := NewMockpromoRepository(ctrl)
+	redisMock := NewMockredisDb(ctrl)
+	auditMock := NewMockauditRepository(ctrl)
+	webhookMock := NewMockwebhookPublisher(ctrl)
+
+	repo.EXPECT().AdvanceStatuses(gomock.Any(), gomock.Any()).Return([]promoStorage.StatusChange{
+		{PromoId: "promo-1", CompanyId: "company-1", From: promoenum.StatusScheduled, To: promoenum.StatusActive},
+		{PromoId: "promo-2", CompanyId: "company-1", From: promoenum.StatusActive, To: promoenum.StatusExpired},
+	}, nil)
+	redisMock.EXPECT().Del(gomock.Any(), "promo-1", "promo-2").Return(redis.NewIntCmd(context.Background(), 2))
+	auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+		require.Equal(t, auditenum.SystemActor, entry.ActorId)
+		require.Empty(t, entry.ActorUserId)
+		require.Equal(t, auditenum.OperationStatusChange, entry.Operation)
+		return nil
+	}).Times(2)
+	webhookMock.EXPECT().Publish(gomock.Any(), "company-1", webhookenum.EventPromoExpired, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, companyId string, event webhookenum.Event, data any) error {
+			require.Equal(t, "promo-2", data.(statusEvent).PromoId)
+			return nil
+		})
+
+	s := &Service{
+		log:              zap.NewNop(),
+		promoRepository:  repo,
+		redisDb:          redisMock,
+		auditRepository:  auditMock,
+		webhookPublisher: webhookMock,
+	}
+
+	advanced, err := s.AdvanceStatuses(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, advanced)
+}
+
+func TestService_AdvanceStatuses_CountsExhausted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := NewMockpromoRepository(ctrl)
+	redisMock := NewMockredisDb(ctrl)
+	auditMock := NewMockauditRepository(ctrl)
+	webhookMock := NewMockwebhookPublisher(ctrl)
+
+	repo.EXPECT().AdvanceStatuses(gomock.Any(), gomock.Any()).Return([]promoStorage.StatusChange{
+		{PromoId: "promo-1", CompanyId: "company-1", From: promoenum.StatusActive, To: promoenum.StatusExhausted},
+	}, nil)
+	redisMock.EXPECT().Del(gomock.Any(), "promo-1").Return(redis.NewIntCmd(context.Background(), 1))
+	auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	webhookMock.EXPECT().Publish(gomock.Any(), "company-1", webhookenum.EventPromoExhausted, gomock.Any()).Return(nil)
+
+	s := &Service{
+		log:              zap.NewNop(),
+		promoRepository:  repo,
+		redisDb:          redisMock,
+		auditRepository:  auditMock,
+		webhookPublisher: webhookMock,
+	}
+
+	before := testutil.ToFloat64(promosExhausted)
+
+	_, err := s.AdvanceStatuses(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, before+1, testutil.ToFloat64(promosExhausted))
+}
+
+// Тест активации последнего кода: промокод исчерпан в транзакции активации,
+// поэтому событие promo.exhausted и запись аудита создаёт сам Activate
+func TestService_Activate_LastCodeExhaustsPromo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	disableAntifraud(t)
+
+	repo := NewMockpromoRepository(ctrl)
+	codeRepo := NewMockpromoCodeRepository(ctrl)
+	redisMock := NewMockredisDb(ctrl)
+	auditMock := NewMockauditRepository(ctrl)
+	webhookMock := NewMockwebhookPublisher(ctrl)
+
+	now := time.Now()
+	repo.EXPECT().GetById(gomock.Any(), "promo-1").Return(&promoStorage.PromoDetails{
+		Id:          "promo-1",
+		CompanyId:   "company-1",
+		ActiveFrom:  now.Add(-time.Hour),
+		ActiveUntil: now.Add(time.Hour),
+		Status:      promoenum.StatusActive,
+	}, nil)
+	codeRepo.EXPECT().Activate(gomock.Any(), "promo-1", "").Return("LAST-CODE", true, nil)
+	redisMock.EXPECT().Del(gomock.Any(), "promo-1").Return(redis.NewIntCmd(context.Background(), 1))
+	auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+		require.Equal(t, auditenum.OperationStatusChange, entry.Operation)
+		require.Equal(t, auditenum.SystemActor, entry.ActorId)
+		require.JSONEq(t, `{"status":{"before":"active","after":"exhausted"}}`, string(entry.Changes))
+		return nil
+	})
+	webhookMock.EXPECT().Publish(gomock.Any(), "company-1", webhookenum.EventPromoActivated, gomock.Any()).Return(nil)
+	webhookMock.EXPECT().Publish(gomock.Any(), "company-1", webhookenum.EventPromoExhausted, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, companyId string, event webhookenum.Event, data any) error {
+			require.Equal(t, "promo-1", data.(statusEvent).PromoId)
+			return nil
+		})
+
+	s := &Service{
+		log:                 zap.NewNop(),
+		promoRepository:     repo,
+		promoCodeRepository: codeRepo,
+		redisDb:             redisMock,
+		auditRepository:     auditMock,
+		webhookPublisher:    webhookMock,
+	}
+
+	before := testutil.ToFloat64(promosExhausted)
+
+	code, err := s.Activate(context.Background(), "promo-1", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "LAST-CODE", code)
+	assert.Equal(t, before+1, testutil.ToFloat64(promosExhausted))
+}
+
+func disableAntifraud(t *testing.T) {
+	t.Helper()
+
+	original := antifraud
+	antifraud = func() bool { return false }
+	t.Cleanup(func() { antifraud = original })
+}
+
+func TestService_Restore(t *testing.T) {
+	promoId := "4eacc594-942f-482e-b0df-3c6a3f63ef33"
+	companyId := "8eb7064a-a899-4ad4-814f-deb2f660536b"
+	retention := 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		restored bool
+		repoErr  error
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			restored: true,
+		},
+		{
+			name:     "retention expired or not deleted",
+			restored: false,
+			wantErr:  ErrNotFound,
+		},
+		{
+			name:    "repository error",
+			repoErr: errors.New("db error"),
+			wantErr: errors.New("promoRepository.Restore"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := NewMockpromoRepository(ctrl)
+			repo.EXPECT().Restore(gomock.Any(), promoId, companyId, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, promoId string, companyId string, deletedAfter time.Time) (bool, error) {
+					assert.WithinDuration(t, time.Now().Add(-retention), deletedAfter, time.Minute)
+					return tt.restored, tt.repoErr
+				})
+
+			auditMock := NewMockauditRepository(ctrl)
+			if tt.wantErr == nil {
+				auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.AuditEntry) error {
+					require.Equal(t, auditenum.OperationRestore, entry.Operation)
+					require.Equal(t, companyId, entry.ActorId)
+					require.Equal(t, actorUserId, entry.ActorUserId)
+					return nil
+				})
+			}
+
+			s := &Service{
+				log:                   zap.NewNop(),
+				promoRepository:       repo,
+				auditRepository:       auditMock,
+				deletedPromoRetention: retention,
+			}
+
+			err := s.Restore(authCtx(companyId, actorUserId), promoId, companyId)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if errors.Is(tt.wantErr, ErrNotFound) {
+					require.ErrorIs(t, err, ErrNotFound)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_PurgeDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := NewMockpromoRepository(ctrl)
+	redisMock := NewMockredisDb(ctrl)
+
+	auditMock := NewMockauditRepository(ctrl)
+
+	repo.EXPECT().Purge(gomock.Any(), gomock.Any()).Return([]promoStorage.PurgedPromo{{PromoId: "promo-1", CompanyId: "company-1"}}, nil)
+	redisMock.EXPECT().Del(gomock.Any(), "promo-1").Return(redis.NewIntCmd(context.Background(), 1))
+	auditMock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	s := &Service{
+		log:                   zap.NewNop(),
+		promoRepository:       repo,
+		redisDb:               redisMock,
+		auditRepository:       auditMock,
+		deletedPromoRetention: 24 * time.Hour,
+	}
+
+	purged, err := s.PurgeDeleted(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+}
+
+func TestService_ListAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditMock := NewMockauditRepository(ctrl)
+
+	filter := auditdto.Filter{
+		PromoId:   "promo-1",
+		CompanyId: "company-1",
+		Operation: auditenum.OperationUpdate,
+	}
+	createdAt := time.Now()
+
+	auditMock.EXPECT().List(gomock.Any(), filter, 5, 10).Return([]model.AuditEntry{
+		{
+			Id:        "entry-1",
+			PromoId:   "promo-1",
+			CompanyId: "company-1",
+			ActorId:   "company-1",
+			Operation: auditenum.OperationUpdate,
+			Changes:   model.JSON(`{"description":{"before":"old","after":"new"}}`),
+			CreatedAt: createdAt,
+		},
+	}, nil)
+
+	s := &Service{
+		log:             zap.NewNop(),
+		auditRepository: auditMock,
+	}
+
+	entries, err := s.ListAuditLog(context.Background(), filter, 10, 5)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "entry-1", entries[0].Id)
+	assert.Equal(t, auditenum.OperationUpdate, entries[0].Operation)
+	assert.JSONEq(t, `{"description":{"before":"old","after":"new"}}`, string(entries[0].Changes))
+	assert.Equal(t, createdAt, entries[0].CreatedAt)
+}
+
+func TestService_QuoteDiscount(t *testing.T) {
+	promoId := "f5db5acc-03da-4215-bb0d-87078e422c45"
+	testCart := &cart.DTO{
+		Currency: "RUB",
+		Items: []cart.Item{
+			{Sku: "coffee-250", Category: "coffee", UnitPrice: 45000, Quantity: 2},
+			{Sku: "mug", Category: "dishes", UnitPrice: 30000, Quantity: 1},
+		},
+	}
+	percentReward := &model.Reward{Type: rewardenum.TypePercent, Percent: 10, EligibleCategories: []string{"coffee"}}
+
+	tests := []struct {
+		name         string
+		prepare      func(promoRepo *MockpromoRepository, promoCodeRepo *MockpromoCodeRepository)
+		cart         *cart.DTO
+		wantDiscount int64
+		wantReason   rewardenum.RejectReason
+		wantErr      bool
+	}{
+		{
+			name: "applicable",
+			prepare: func(promoRepo *MockpromoRepository, promoCodeRepo *MockpromoCodeRepository) {
+				promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "SUMMER25").Return(&model.PromoCode{PromoId: promoId, Code: "SUMMER25", Activations: 3, MaxCount: 10}, nil)
+				promoRepo.EXPECT().GetById(gomock.Any(), promoId).Return(&promoStorage.PromoDetails{Id: promoId, Status: promoenum.StatusActive, Reward: percentReward}, nil)
+			},
+			cart:         testCart,
+			wantDiscount: 9000,
+		},
+		{
+			name: "code not found",
+			prepare: func(promoRepo *MockpromoRepository, promoCodeRepo *MockpromoCodeRepository) {
+				promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "SUMMER25").Return(nil, nil)
+			},
+			cart:       testCart,
+			wantReason: rewardenum.RejectReasonCodeNotFound,
+		},
+		{
+			name: "paused promo",
+			prepare: func(promoRepo *MockpromoRepository, promoCodeRepo *MockpromoCodeRepository) {
+				promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "SUMMER25").Return(&model.PromoCode{PromoId: promoId, Code: "SUMMER25", MaxCount: 10}, nil)
+				promoRepo.EXPECT().GetById(gomock.Any(), promoId).Return(&promoStorage.PromoDetails{Id: promoId, Status: promoenum.StatusPaused, Reward: percentReward}, nil)
+			},
+			cart:       testCart,
+			wantReason: rewardenum.RejectReasonPromoNotActive,
+		},
+		{
+			name: "code exhausted",
+			prepare: func(promoRepo *MockpromoRepository, promoCodeRepo *MockpromoCodeRepository) {
+				promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "SUMMER25").Return(&model.PromoCode{PromoId: promoId, Code: "SUMMER25", Activations: 1, MaxCount: 1}, nil)
+				promoRepo.EXPECT().GetById(gomock.Any(), promoId).Return(&promoStorage.PromoDetails{Id: promoId, Status: promoenum.StatusActive, Reward: percentReward}, nil)
+			},
+			cart:       testCart,
+			wantReason: rewardenum.RejectReasonNoActivationsLeft,
+		},
+		{
+			name: "promo without reward",
+			prepare: func(promoRepo *MockpromoRepository, promoCodeRepo *MockpromoCodeRepository) {
+				promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "SUMMER25").Return(&model.PromoCode{PromoId: promoId, Code: "SUMMER25", MaxCount: 10}, nil)
+				promoRepo.EXPECT().GetById(gomock.Any(), promoId).Return(&promoStorage.PromoDetails{Id: promoId, Status: promoenum.StatusActive}, nil)
+			},
+			cart:       testCart,
+			wantReason: rewardenum.RejectReasonNoReward,
+		},
+		{
+			name:    "invalid cart",
+			prepare: func(promoRepo *MockpromoRepository, promoCodeRepo *MockpromoCodeRepository) {},
+			cart:    &cart.DTO{Currency: "RUB"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			promoRepo := NewMockpromoRepository(ctrl)
+			promoCodeRepo := NewMockpromoCodeRepository(ctrl)
+			tt.prepare(promoRepo, promoCodeRepo)
+
+			s := &Service{
+				log:                 zap.NewNop(),
+				promoRepository:     promoRepo,
+				promoCodeRepository: promoCodeRepo,
+			}
+
+			quote, err := s.QuoteDiscount(context.Background(), "SUMMER25", tt.cart)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReason, quote.RejectReason)
+			assert.Equal(t, tt.wantDiscount, quote.Discount)
+			assert.Equal(t, "RUB", quote.Currency)
+		})
+	}
+}
+
+func TestService_ResolveApplicablePromos(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	promoRepo := NewMockpromoRepository(ctrl)
+	promoCodeRepo := NewMockpromoCodeRepository(ctrl)
+
+	promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "SUMMER25").Return(&model.PromoCode{PromoId: "p1", Code: "SUMMER25", MaxCount: 10}, nil)
+	promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "AUTUMN10").Return(&model.PromoCode{PromoId: "p2", Code: "AUTUMN10", MaxCount: 10}, nil)
+	promoCodeRepo.EXPECT().GetByCode(gomock.Any(), "UNKNOWN").Return(nil, nil)
+
+	promoRepo.EXPECT().GetById(gomock.Any(), "p1").Return(&promoStorage.PromoDetails{
+		Id: "p1", CompanyId: "c1", Status: promoenum.StatusActive,
+		Stacking: model.Stacking{Group: "seasonal", Priority: 1},
+	}, nil)
+	promoRepo.EXPECT().GetById(gomock.Any(), "p2").Return(&promoStorage.PromoDetails{
+		Id: "p2", CompanyId: "c1", Status: promoenum.StatusActive,
+		Stacking: model.Stacking{Group: "seasonal", Priority: 7},
+	}, nil)
+
+	s := &Service{
+		log:                 zap.NewNop(),
+		promoRepository:     promoRepo,
+		promoCodeRepository: promoCodeRepo,
+	}
+
+	applied, rejected, err := s.ResolveApplicablePromos(context.Background(), []string{"SUMMER25", "AUTUMN10", "UNKNOWN"})
+
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.Equal(t, "AUTUMN10", applied[0].Code)
+	assert.Equal(t, []stacking.Rejection{
+		{Code: "UNKNOWN", Reason: rewardenum.RejectReasonCodeNotFound},
+		{Code: "SUMMER25", PromoId: "p1", Reason: rewardenum.RejectReasonStackingGroupConflict, ConflictingPromoId: "p2"},
+	}, rejected)
+}
+
+func TestService_RenderCode(t *testing.T) {
+	promoId := "4eacc594-942f-482e-b0df-3c6a3f63ef33"
+	userId := "b1f0d1b2-4a1c-4c55-9d4f-0c2f6f1c9a11"
+	opts := barcode.Options{Symbology: barcode.SymbologyQR, Format: barcode.FormatSVG, Size: barcode.DefaultSize, ErrorCorrection: barcode.ErrorCorrectionM}
+
+	tests := []struct {
+		name     string
+		userId   string
+		code     string
+		opts     barcode.Options
+		prepare  func(promoCodeRepository *MockpromoCodeRepository)
+		wantCode string
+		wantErr  error
+	}{
+		{
+			name:   "latest activation",
+			userId: userId,
+			opts:   opts,
+			prepare: func(promoCodeRepository *MockpromoCodeRepository) {
+				promoCodeRepository.EXPECT().GetActivatedCode(gomock.Any(), promoId, userId, "").Return("SUMMER2025", nil)
+			},
+			wantCode: "SUMMER2025",
+		},
+		{
+			name:   "code activated by another user",
+			userId: userId,
+			code:   "WINTER2025",
+			opts:   opts,
+			prepare: func(promoCodeRepository *MockpromoCodeRepository) {
+				promoCodeRepository.EXPECT().GetActivatedCode(gomock.Any(), promoId, userId, "WINTER2025").Return("", nil)
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "user required",
+			opts:    opts,
+			wantErr: ErrUserRequired,
+		},
+		{
+			name:    "invalid options",
+			userId:  userId,
+			opts:    barcode.Options{Symbology: barcode.SymbologyQR, Format: barcode.FormatPNG, Size: 1},
+			wantErr: barcode.ErrInvalidOptions,
+		},
+		{
+			name:   "ean13 for alphanumeric code",
+			userId: userId,
+			opts:   barcode.Options{Symbology: barcode.SymbologyEAN13, Format: barcode.FormatPNG, Size: barcode.DefaultSize},
+			prepare: func(promoCodeRepository *MockpromoCodeRepository) {
+				promoCodeRepository.EXPECT().GetActivatedCode(gomock.Any(), promoId, userId, "").Return("SUMMER2025", nil)
+			},
+			wantErr: barcode.ErrUnsupportedContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			promoCodeRepository := NewMockpromoCodeRepository(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(promoCodeRepository)
+			}
+
+			s := &Service{
+				log:                 zap.NewNop(),
+				promoCodeRepository: promoCodeRepository,
+			}
+
+			code, image, contentType, err := s.RenderCode(context.Background(), promoId, tt.userId, tt.code, tt.opts)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			require.Equal(t, tt.wantCode, code)
+			require.Equal(t, "image/svg+xml", contentType)
+			require.NotEmpty(t, image)
+		})
+	}
+}
+
+func TestService_Export(t *testing.T) {
+	promoId := "4eacc594-942f-482e-b0df-3c6a3f63ef33"
+	companyId := "f8a1d5b0-2c4e-4f0a-9a8b-1d2c3e4f5a6b"
+	activatedAt := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		dataset exportenum.Dataset
+		format  tabular.Format
+		prepare func(promoRepository *MockpromoRepository, promoCodeRepository *MockpromoCodeRepository)
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "codes",
+			dataset: exportenum.DatasetCodes,
+			format:  tabular.FormatCSV,
+			prepare: func(promoRepository *MockpromoRepository, promoCodeRepository *MockpromoCodeRepository) {
+				promoRepository.EXPECT().GetCompanyId(gomock.Any(), promoId).Return(companyId, nil)
+				promoCodeRepository.EXPECT().ExportCodes(gomock.Any(), promoId, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, fn func(*model.PromoCode) error) error {
+						for _, promoCode := range []model.PromoCode{
+							{Code: "SUMMER-1", Activations: 1, MaxCount: 1},
+							{Code: "SUMMER-2", Activations: 0, MaxCount: 1},
+						} {
+							if err := fn(&promoCode); err != nil {
+								return err
+							}
+						}
+						return nil
+					})
+			},
+			want: "code,activations,max_count\nSUMMER-1,1,1\nSUMMER-2,0,1\n",
+		},
+		{
+			name:    "activations",
+			dataset: exportenum.DatasetActivations,
+			format:  tabular.FormatCSV,
+			prepare: func(promoRepository *MockpromoRepository, promoCodeRepository *MockpromoCodeRepository) {
+				promoRepository.EXPECT().GetCompanyId(gomock.Any(), promoId).Return(companyId, nil)
+				promoCodeRepository.EXPECT().ExportActivations(gomock.Any(), promoId, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, fn func(*model.PromoActivation) error) error {
+						if err := fn(&model.PromoActivation{Code: "SUMMER-1", UserId: sql.NullString{String: "userId", Valid: true}, ActivatedAt: activatedAt}); err != nil {
+							return err
+						}
+						return fn(&model.PromoActivation{Code: "SUMMER-1", ActivatedAt: activatedAt})
+					})
+			},
+			want: "code,user_id,activated_at\nSUMMER-1,userId,2025-03-01 12:30:00\nSUMMER-1,,2025-03-01 12:30:00\n",
+		},
+		{
+			name:    "not found",
+			dataset: exportenum.DatasetCodes,
+			format:  tabular.FormatCSV,
+			prepare: func(promoRepository *MockpromoRepository, promoCodeRepository *MockpromoCodeRepository) {
+				promoRepository.EXPECT().GetCompanyId(gomock.Any(), promoId).Return("", nil)
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "another company",
+			dataset: exportenum.DatasetCodes,
+			format:  tabular.FormatCSV,
+			prepare: func(promoRepository *MockpromoRepository, promoCodeRepository *MockpromoCodeRepository) {
+				promoRepository.EXPECT().GetCompanyId(gomock.Any(), promoId).Return("anotherCompanyId", nil)
+			},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "unsupported format",
+			dataset: exportenum.DatasetCodes,
+			format:  "ods",
+			prepare: func(promoRepository *MockpromoRepository, promoCodeRepository *MockpromoCodeRepository) {
+				promoRepository.EXPECT().GetCompanyId(gomock.Any(), promoId).Return(companyId, nil)
+			},
+			wantErr: tabular.ErrUnsupportedFormat,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			promoRepository := NewMockpromoRepository(ctrl)
+			promoCodeRepository := NewMockpromoCodeRepository(ctrl)
+			tt.prepare(promoRepository, promoCodeRepository)
+
+			s := &Service{
+				log:                 zap.NewNop(),
+				promoRepository:     promoRepository,
+				promoCodeRepository: promoCodeRepository,
+			}
+
+			var buf bytes.Buffer
+
+			err := s.Export(context.Background(), promoId, companyId, tt.dataset, tt.format, &buf)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Zero(t, buf.Len())
+				return
+			}
+
+			require.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestService_ForgetUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	promoCodeRepository := NewMockpromoCodeRepository(ctrl)
+	webhookPublisher := NewMockwebhookPublisher(ctrl)
+
+	promoCodeRepository.EXPECT().AnonymizeActivations(gomock.Any(), "userId").Return(int64(3), nil)
+	webhookPublisher.EXPECT().ForgetUser(gomock.Any(), "userId").Return(2, nil)
+
+	s := &Service{
+		log:                 zap.NewNop(),
+		promoCodeRepository: promoCodeRepository,
+		webhookPublisher:    webhookPublisher,
+	}
+
+	erasure, err := s.ForgetUser(context.Background(), "userId")
+	require.NoError(t, err)
+	require.Equal(t, &userdata.Erasure{AnonymizedActivations: 3, ScrubbedWebhookDeliveries: 2}, erasure)
+}
+
+func TestService_ForgetUser_RepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	promoCodeRepository := NewMockpromoCodeRepository(ctrl)
+	webhookPublisher := NewMockwebhookPublisher(ctrl)
+
+	repoErr := errors.New("connection reset")
+	promoCodeRepository.EXPECT().AnonymizeActivations(gomock.Any(), "userId").Return(int64(0), repoErr)
+
+	s := &Service{
+		log:                 zap.NewNop(),
+		promoCodeRepository: promoCodeRepository,
+		webhookPublisher:    webhookPublisher,
+	}
+
+	_, err := s.ForgetUser(context.Background(), "userId")
+	require.ErrorIs(t, err, repoErr)
+}
+
+func TestService_ExportUserData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	promoCodeRepository := NewMockpromoCodeRepository(ctrl)
+	activatedAt := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	promoCodeRepository.EXPECT().ListUserActivations(gomock.Any(), "userId").Return([]model.UserActivation{
+		{PromoId: "promoId", CompanyId: "companyId", Code: "SUMMER-1", Description: "Summer sale", ActivatedAt: activatedAt},
+	}, nil)
+
+	s := &Service{
+		log:                 zap.NewNop(),
+		promoCodeRepository: promoCodeRepository,
+	}
+
+	activations, err := s.ExportUserData(context.Background(), "userId")
+	require.NoError(t, err)
+	require.Equal(t, []userdata.Activation{
+		{PromoId: "promoId", CompanyId: "companyId", Code: "SUMMER-1", Description: "Summer sale", ActivatedAt: activatedAt},
+	}, activations)
+}
@@ -0,0 +1,58 @@
+package promo
+
+import (
+	"context"
+
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/stacking"
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+)
+
+// ResolveApplicablePromos выбирает из кодов одной корзины те, что можно применить вместе.
+// Для остальных кодов возвращает причину отказа
+func (s *Service) ResolveApplicablePromos(ctx context.Context, codes []string) (applied []stacking.Candidate, rejected []stacking.Rejection, err error) {
+	candidates := make([]stacking.Candidate, 0, len(codes))
+
+	for _, code := range codes {
+		promoModel, reason, err := s.lookupCode(ctx, code)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if reason != rewardenum.RejectReasonNone {
+			rejection := stacking.Rejection{Code: code, Reason: reason}
+			if promoModel != nil {
+				rejection.PromoId = promoModel.Id
+			}
+			rejected = append(rejected, rejection)
+			continue
+		}
+
+		candidates = append(candidates, stacking.Candidate{
+			Code:      code,
+			PromoId:   promoModel.Id,
+			CompanyId: promoModel.CompanyId,
+			Settings:  stackingFromModel(promoModel.Stacking),
+		})
+	}
+
+	applied, conflicts := stacking.Resolve(candidates)
+
+	return applied, append(rejected, conflicts...), nil
+}
+
+func stackingToModel(settings stacking.Settings) model.Stacking {
+	return model.Stacking{
+		Group:     settings.Group,
+		Exclusive: settings.Exclusive,
+		Priority:  settings.Priority,
+	}
+}
+
+func stackingFromModel(stackingModel model.Stacking) stacking.Settings {
+	return stacking.Settings{
+		Group:     stackingModel.Group,
+		Exclusive: stackingModel.Exclusive,
+		Priority:  stackingModel.Priority,
+	}
+}
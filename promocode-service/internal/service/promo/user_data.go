@@ -0,0 +1,47 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/userdata"
+	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/functional"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+)
+
+// ExportUserData возвращает все активации пользователя для выгрузки персональных данных
+func (s *Service) ExportUserData(ctx context.Context, userId string) ([]userdata.Activation, error) {
+	activations, err := s.promoCodeRepository.ListUserActivations(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("promoCodeRepository.ListUserActivations: %w", err)
+	}
+
+	return functional.Map(activations, func(activation model.UserActivation) userdata.Activation {
+		return userdata.Activation{
+			PromoId:     activation.PromoId,
+			CompanyId:   activation.CompanyId,
+			Code:        activation.Code,
+			Description: activation.Description,
+			ActivatedAt: activation.ActivatedAt,
+		}
+	}), nil
+}
+
+// ForgetUser обезличивает данные удалённого пользователя: активации отвязываются от него,
+// а из журнала вебхуков удаляется его идентификатор. Повторный вызов безопасен
+func (s *Service) ForgetUser(ctx context.Context, userId string) (*userdata.Erasure, error) {
+	anonymized, err := s.promoCodeRepository.AnonymizeActivations(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("promoCodeRepository.AnonymizeActivations: %w", err)
+	}
+
+	scrubbed, err := s.webhookPublisher.ForgetUser(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("webhookPublisher.ForgetUser: %w", err)
+	}
+
+	return &userdata.Erasure{
+		AnonymizedActivations:     anonymized,
+		ScrubbedWebhookDeliveries: scrubbed,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package promo
+
+import (
+	"context"
+	"time"
+
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	"go.uber.org/zap"
+)
+
+// activatedEvent данные события promo.activated
+type activatedEvent struct {
+	PromoId     string    `json:"promo_id"`
+	Code        string    `json:"code"`
+	UserId      string    `json:"user_id,omitempty"`
+	ActivatedAt time.Time `json:"activated_at"`
+}
+
+// statusEvent данные событий promo.exhausted и promo.expired
+type statusEvent struct {
+	PromoId   string    `json:"promo_id"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// publish ставит событие в очередь вебхуков компании. Ошибка только логируется:
+// уведомление компании не должно отменять уже выполненное действие
+func (s *Service) publish(ctx context.Context, companyId string, event webhookenum.Event, data any) {
+	if err := s.webhookPublisher.Publish(ctx, companyId, event, data); err != nil {
+		s.logger(ctx).Warn("webhookPublisher.Publish: failed to enqueue webhook",
+			zap.String("company_id", companyId), zap.String("event", string(event)), zap.Error(err))
+	}
+}
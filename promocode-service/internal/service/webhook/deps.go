@@ -0,0 +1,23 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook
+
+import (
+	"context"
+	"time"
+
+	webhookdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+)
+
+type webhookRepository interface {
+	CreateEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (id string, err error)
+	ListEndpoints(ctx context.Context, companyId string) (endpoints []model.WebhookEndpoint, err error)
+	DeleteEndpoint(ctx context.Context, endpointId string, companyId string) (deleted bool, err error)
+	Enqueue(ctx context.Context, companyId string, event webhookenum.Event, payload model.JSON) (enqueued int, err error)
+	ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) (deliveries []model.DueWebhookDelivery, err error)
+	RecordAttempt(ctx context.Context, delivery *model.WebhookDelivery) error
+	ListDeliveries(ctx context.Context, filter webhookdto.Filter, offset int, limit int) (deliveries []model.WebhookDelivery, err error)
+	CountDeliveries(ctx context.Context, filter webhookdto.Filter) (count int, err error)
+	ScrubUser(ctx context.Context, userId string) (scrubbed int, err error)
+}
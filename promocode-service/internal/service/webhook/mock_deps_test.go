@@ -0,0 +1,395 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook -typed -destination mock_deps_test.go
+//
+
+// Package webhook is a generated GoMock package.
+package webhook
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	webhook "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	webhook0 "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	model "gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookRepository is a mock of webhookRepository interface.
+type MockwebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookRepositoryMockRecorder is the mock recorder for MockwebhookRepository.
+type MockwebhookRepositoryMockRecorder struct {
+	mock *MockwebhookRepository
+}
+
+// NewMockwebhookRepository creates a new mock instance.
+func NewMockwebhookRepository(ctrl *gomock.Controller) *MockwebhookRepository {
+	mock := &MockwebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockwebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookRepository) EXPECT() *MockwebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockwebhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.DueWebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]model.DueWebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockwebhookRepositoryMockRecorder) ClaimDue(ctx, now, leaseUntil, limit any) *MockwebhookRepositoryClaimDueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockwebhookRepository)(nil).ClaimDue), ctx, now, leaseUntil, limit)
+	return &MockwebhookRepositoryClaimDueCall{Call: call}
+}
+
+// MockwebhookRepositoryClaimDueCall wrap *gomock.Call
+type MockwebhookRepositoryClaimDueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryClaimDueCall) Return(deliveries []model.DueWebhookDelivery, err error) *MockwebhookRepositoryClaimDueCall {
+	c.Call = c.Call.Return(deliveries, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryClaimDueCall) Do(f func(context.Context, time.Time, time.Time, int) ([]model.DueWebhookDelivery, error)) *MockwebhookRepositoryClaimDueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryClaimDueCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) ([]model.DueWebhookDelivery, error)) *MockwebhookRepositoryClaimDueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountDeliveries mocks base method.
+func (m *MockwebhookRepository) CountDeliveries(ctx context.Context, filter webhook.Filter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeliveries", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeliveries indicates an expected call of CountDeliveries.
+func (mr *MockwebhookRepositoryMockRecorder) CountDeliveries(ctx, filter any) *MockwebhookRepositoryCountDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeliveries", reflect.TypeOf((*MockwebhookRepository)(nil).CountDeliveries), ctx, filter)
+	return &MockwebhookRepositoryCountDeliveriesCall{Call: call}
+}
+
+// MockwebhookRepositoryCountDeliveriesCall wrap *gomock.Call
+type MockwebhookRepositoryCountDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryCountDeliveriesCall) Return(count int, err error) *MockwebhookRepositoryCountDeliveriesCall {
+	c.Call = c.Call.Return(count, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryCountDeliveriesCall) Do(f func(context.Context, webhook.Filter) (int, error)) *MockwebhookRepositoryCountDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryCountDeliveriesCall) DoAndReturn(f func(context.Context, webhook.Filter) (int, error)) *MockwebhookRepositoryCountDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateEndpoint mocks base method.
+func (m *MockwebhookRepository) CreateEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEndpoint indicates an expected call of CreateEndpoint.
+func (mr *MockwebhookRepositoryMockRecorder) CreateEndpoint(ctx, endpoint any) *MockwebhookRepositoryCreateEndpointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpoint", reflect.TypeOf((*MockwebhookRepository)(nil).CreateEndpoint), ctx, endpoint)
+	return &MockwebhookRepositoryCreateEndpointCall{Call: call}
+}
+
+// MockwebhookRepositoryCreateEndpointCall wrap *gomock.Call
+type MockwebhookRepositoryCreateEndpointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryCreateEndpointCall) Return(id string, err error) *MockwebhookRepositoryCreateEndpointCall {
+	c.Call = c.Call.Return(id, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryCreateEndpointCall) Do(f func(context.Context, *model.WebhookEndpoint) (string, error)) *MockwebhookRepositoryCreateEndpointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryCreateEndpointCall) DoAndReturn(f func(context.Context, *model.WebhookEndpoint) (string, error)) *MockwebhookRepositoryCreateEndpointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockwebhookRepository) DeleteEndpoint(ctx context.Context, endpointId, companyId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", ctx, endpointId, companyId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockwebhookRepositoryMockRecorder) DeleteEndpoint(ctx, endpointId, companyId any) *MockwebhookRepositoryDeleteEndpointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockwebhookRepository)(nil).DeleteEndpoint), ctx, endpointId, companyId)
+	return &MockwebhookRepositoryDeleteEndpointCall{Call: call}
+}
+
+// MockwebhookRepositoryDeleteEndpointCall wrap *gomock.Call
+type MockwebhookRepositoryDeleteEndpointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryDeleteEndpointCall) Return(deleted bool, err error) *MockwebhookRepositoryDeleteEndpointCall {
+	c.Call = c.Call.Return(deleted, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryDeleteEndpointCall) Do(f func(context.Context, string, string) (bool, error)) *MockwebhookRepositoryDeleteEndpointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryDeleteEndpointCall) DoAndReturn(f func(context.Context, string, string) (bool, error)) *MockwebhookRepositoryDeleteEndpointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Enqueue mocks base method.
+func (m *MockwebhookRepository) Enqueue(ctx context.Context, companyId string, event webhook0.Event, payload model.JSON) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, companyId, event, payload)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockwebhookRepositoryMockRecorder) Enqueue(ctx, companyId, event, payload any) *MockwebhookRepositoryEnqueueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockwebhookRepository)(nil).Enqueue), ctx, companyId, event, payload)
+	return &MockwebhookRepositoryEnqueueCall{Call: call}
+}
+
+// MockwebhookRepositoryEnqueueCall wrap *gomock.Call
+type MockwebhookRepositoryEnqueueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryEnqueueCall) Return(enqueued int, err error) *MockwebhookRepositoryEnqueueCall {
+	c.Call = c.Call.Return(enqueued, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryEnqueueCall) Do(f func(context.Context, string, webhook0.Event, model.JSON) (int, error)) *MockwebhookRepositoryEnqueueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryEnqueueCall) DoAndReturn(f func(context.Context, string, webhook0.Event, model.JSON) (int, error)) *MockwebhookRepositoryEnqueueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeliveries mocks base method.
+func (m *MockwebhookRepository) ListDeliveries(ctx context.Context, filter webhook.Filter, offset, limit int) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, filter, offset, limit)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockwebhookRepositoryMockRecorder) ListDeliveries(ctx, filter, offset, limit any) *MockwebhookRepositoryListDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockwebhookRepository)(nil).ListDeliveries), ctx, filter, offset, limit)
+	return &MockwebhookRepositoryListDeliveriesCall{Call: call}
+}
+
+// MockwebhookRepositoryListDeliveriesCall wrap *gomock.Call
+type MockwebhookRepositoryListDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryListDeliveriesCall) Return(deliveries []model.WebhookDelivery, err error) *MockwebhookRepositoryListDeliveriesCall {
+	c.Call = c.Call.Return(deliveries, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryListDeliveriesCall) Do(f func(context.Context, webhook.Filter, int, int) ([]model.WebhookDelivery, error)) *MockwebhookRepositoryListDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryListDeliveriesCall) DoAndReturn(f func(context.Context, webhook.Filter, int, int) ([]model.WebhookDelivery, error)) *MockwebhookRepositoryListDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListEndpoints mocks base method.
+func (m *MockwebhookRepository) ListEndpoints(ctx context.Context, companyId string) ([]model.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpoints", ctx, companyId)
+	ret0, _ := ret[0].([]model.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpoints indicates an expected call of ListEndpoints.
+func (mr *MockwebhookRepositoryMockRecorder) ListEndpoints(ctx, companyId any) *MockwebhookRepositoryListEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpoints", reflect.TypeOf((*MockwebhookRepository)(nil).ListEndpoints), ctx, companyId)
+	return &MockwebhookRepositoryListEndpointsCall{Call: call}
+}
+
+// MockwebhookRepositoryListEndpointsCall wrap *gomock.Call
+type MockwebhookRepositoryListEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryListEndpointsCall) Return(endpoints []model.WebhookEndpoint, err error) *MockwebhookRepositoryListEndpointsCall {
+	c.Call = c.Call.Return(endpoints, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryListEndpointsCall) Do(f func(context.Context, string) ([]model.WebhookEndpoint, error)) *MockwebhookRepositoryListEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryListEndpointsCall) DoAndReturn(f func(context.Context, string) ([]model.WebhookEndpoint, error)) *MockwebhookRepositoryListEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordAttempt mocks base method.
+func (m *MockwebhookRepository) RecordAttempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAttempt", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAttempt indicates an expected call of RecordAttempt.
+func (mr *MockwebhookRepositoryMockRecorder) RecordAttempt(ctx, delivery any) *MockwebhookRepositoryRecordAttemptCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAttempt", reflect.TypeOf((*MockwebhookRepository)(nil).RecordAttempt), ctx, delivery)
+	return &MockwebhookRepositoryRecordAttemptCall{Call: call}
+}
+
+// MockwebhookRepositoryRecordAttemptCall wrap *gomock.Call
+type MockwebhookRepositoryRecordAttemptCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryRecordAttemptCall) Return(arg0 error) *MockwebhookRepositoryRecordAttemptCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryRecordAttemptCall) Do(f func(context.Context, *model.WebhookDelivery) error) *MockwebhookRepositoryRecordAttemptCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryRecordAttemptCall) DoAndReturn(f func(context.Context, *model.WebhookDelivery) error) *MockwebhookRepositoryRecordAttemptCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ScrubUser mocks base method.
+func (m *MockwebhookRepository) ScrubUser(ctx context.Context, userId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScrubUser", ctx, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScrubUser indicates an expected call of ScrubUser.
+func (mr *MockwebhookRepositoryMockRecorder) ScrubUser(ctx, userId any) *MockwebhookRepositoryScrubUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrubUser", reflect.TypeOf((*MockwebhookRepository)(nil).ScrubUser), ctx, userId)
+	return &MockwebhookRepositoryScrubUserCall{Call: call}
+}
+
+// MockwebhookRepositoryScrubUserCall wrap *gomock.Call
+type MockwebhookRepositoryScrubUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookRepositoryScrubUserCall) Return(scrubbed int, err error) *MockwebhookRepositoryScrubUserCall {
+	c.Call = c.Call.Return(scrubbed, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookRepositoryScrubUserCall) Do(f func(context.Context, string) (int, error)) *MockwebhookRepositoryScrubUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookRepositoryScrubUserCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockwebhookRepositoryScrubUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
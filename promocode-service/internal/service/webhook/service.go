@@ -0,0 +1,301 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	webhookdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	"gitlab.com/pisya-dev/promo-code-service/internal/pkg/functional"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	"go.uber.org/zap"
+)
+
+const (
+	secretPrefix = "whsec_"
+	secretBytes  = 32
+
+	// dispatchBatch число доставок, отправляемых за один проход
+	dispatchBatch = 50
+	// leaseMargin запас аренды сверх времени отправки всей пачки на запись результатов
+	leaseMargin = time.Minute
+)
+
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrTooManyEndpoints = errors.New("too many webhook endpoints")
+	errUnexpectedStatus = errors.New("unexpected response status")
+)
+
+type Service struct {
+	log               *zap.Logger
+	webhookRepository webhookRepository
+	httpClient        *http.Client
+
+	now func() time.Time
+}
+
+func New(log *zap.Logger, webhookRepository webhookRepository, timeout time.Duration) *Service {
+	return &Service{
+		log:               log,
+		webhookRepository: webhookRepository,
+		httpClient:        newHTTPClient(timeout),
+		now:               time.Now,
+	}
+}
+
+// CreateEndpoint регистрирует адрес компании и возвращает его вместе с секретом подписи.
+// Секрет возвращается только при создании
+func (s *Service) CreateEndpoint(ctx context.Context, endpoint *webhookdto.Endpoint) (*webhookdto.Endpoint, error) {
+	if err := endpoint.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.webhookRepository.ListEndpoints(ctx, endpoint.CompanyId)
+	if err != nil {
+		return nil, fmt.Errorf("webhookRepository.ListEndpoints: %w", err)
+	}
+
+	if len(existing) >= webhookdto.MaxEndpoints {
+		return nil, ErrTooManyEndpoints
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, fmt.Errorf("newSecret: %w", err)
+	}
+
+	endpointModel := &model.WebhookEndpoint{
+		CompanyId: endpoint.CompanyId,
+		Url:       endpoint.Url,
+		Secret:    secret,
+		Events:    functional.Map(endpoint.Events, func(event webhookenum.Event) string { return string(event) }),
+		CreatedAt: s.now(),
+	}
+
+	endpointModel.Id, err = s.webhookRepository.CreateEndpoint(ctx, endpointModel)
+	if err != nil {
+		return nil, fmt.Errorf("webhookRepository.CreateEndpoint: %w", err)
+	}
+
+	created := endpointFromModel(*endpointModel)
+	created.Secret = secret
+
+	return &created, nil
+}
+
+func (s *Service) ListEndpoints(ctx context.Context, companyId string) ([]webhookdto.Endpoint, error) {
+	endpointModels, err := s.webhookRepository.ListEndpoints(ctx, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("webhookRepository.ListEndpoints: %w", err)
+	}
+
+	return functional.Map(endpointModels, endpointFromModel), nil
+}
+
+func (s *Service) DeleteEndpoint(ctx context.Context, endpointId string, companyId string) error {
+	deleted, err := s.webhookRepository.DeleteEndpoint(ctx, endpointId, companyId)
+	if err != nil {
+		return fmt.Errorf("webhookRepository.DeleteEndpoint: %w", err)
+	}
+
+	if !deleted {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, filter webhookdto.Filter, limit int, offset int) ([]webhookdto.Delivery, error) {
+	deliveryModels, err := s.webhookRepository.ListDeliveries(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("webhookRepository.ListDeliveries: %w", err)
+	}
+
+	return functional.Map(deliveryModels, func(delivery model.WebhookDelivery) webhookdto.Delivery {
+		return webhookdto.Delivery{
+			Id:             delivery.Id,
+			EndpointId:     delivery.EndpointId,
+			CompanyId:      delivery.CompanyId,
+			Event:          delivery.Event,
+			Payload:        json.RawMessage(delivery.Payload),
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			LastStatusCode: delivery.LastStatusCode,
+			LastError:      delivery.LastError,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			CreatedAt:      delivery.CreatedAt,
+			DeliveredAt:    delivery.DeliveredAt.Time,
+		}
+	}), nil
+}
+
+func (s *Service) CountDeliveries(ctx context.Context, filter webhookdto.Filter) (int, error) {
+	count, err := s.webhookRepository.CountDeliveries(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("webhookRepository.CountDeliveries: %w", err)
+	}
+	return count, nil
+}
+
+// Publish ставит событие в очередь доставки на все адреса компании, подписанные на него.
+// Отправка выполняется фоновой задачей, поэтому медленные получатели не задерживают вызывающего
+func (s *Service) Publish(ctx context.Context, companyId string, event webhookenum.Event, data any) error {
+	payload, err := json.Marshal(webhookdto.Envelope{
+		Event:     event,
+		CreatedAt: s.now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	_, err = s.webhookRepository.Enqueue(ctx, companyId, event, payload)
+	if err != nil {
+		return fmt.Errorf("webhookRepository.Enqueue: %w", err)
+	}
+
+	return nil
+}
+
+// ForgetUser удаляет идентификатор пользователя из событий, сохранённых в журнале доставок
+func (s *Service) ForgetUser(ctx context.Context, userId string) (scrubbed int, err error) {
+	scrubbed, err = s.webhookRepository.ScrubUser(ctx, userId)
+	if err != nil {
+		return 0, fmt.Errorf("webhookRepository.ScrubUser: %w", err)
+	}
+
+	return scrubbed, nil
+}
+
+// DispatchDue отправляет доставки, время попытки которых наступило. Неудачная попытка откладывается
+// с экспоненциальной задержкой, после webhookdto.MaxAttempts попыток доставка переносится в dead-letter
+func (s *Service) DispatchDue(ctx context.Context) (delivered int, err error) {
+	now := s.now()
+
+	// доставки пачки отправляются по очереди, поэтому аренда должна пережить самый медленный проход:
+	// иначе другой экземпляр заберёт ещё не отправленные доставки и отправит их повторно
+	lease := time.Duration(dispatchBatch)*s.httpClient.Timeout + leaseMargin
+
+	deliveries, err := s.webhookRepository.ClaimDue(ctx, now, now.Add(lease), dispatchBatch)
+	if err != nil {
+		return 0, fmt.Errorf("webhookRepository.ClaimDue: %w", err)
+	}
+
+	for _, due := range deliveries {
+		result := &model.WebhookDelivery{
+			Id:       due.Id,
+			Attempts: due.Attempts + 1,
+		}
+
+		result.LastStatusCode, err = s.send(ctx, due)
+		attemptedAt := s.now()
+
+		switch {
+		case err == nil:
+			result.Status = webhookenum.DeliveryStatusDelivered
+			result.NextAttemptAt = attemptedAt
+			result.DeliveredAt.Time, result.DeliveredAt.Valid = attemptedAt, true
+			delivered++
+		case result.Attempts >= webhookdto.MaxAttempts:
+			result.Status = webhookenum.DeliveryStatusDead
+			result.LastError = err.Error()
+			result.NextAttemptAt = attemptedAt
+			s.log.Warn("webhook delivery moved to dead-letter", zap.String("delivery_id", due.Id), zap.Error(err))
+		default:
+			result.Status = webhookenum.DeliveryStatusPending
+			result.LastError = err.Error()
+			result.NextAttemptAt = attemptedAt.Add(webhookdto.Backoff(result.Attempts))
+		}
+
+		if err = s.webhookRepository.RecordAttempt(ctx, result); err != nil {
+			s.log.Error("webhookRepository.RecordAttempt: failed to record delivery attempt",
+				zap.String("delivery_id", due.Id), zap.Error(err))
+		}
+	}
+
+	return delivered, nil
+}
+
+// send отправляет событие и возвращает код ответа. Доставка считается успешной при любом ответе 2xx
+func (s *Service) send(ctx context.Context, due model.DueWebhookDelivery) (statusCode int, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, due.Url, bytes.NewReader(due.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+
+	timestamp := s.now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookdto.HeaderId, due.Id)
+	req.Header.Set(webhookdto.HeaderEvent, string(due.Event))
+	req.Header.Set(webhookdto.HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(webhookdto.HeaderSignature, webhookdto.Sign(due.Secret, timestamp, due.Payload))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("httpClient.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("%w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// newHTTPClient создаёт клиент, который соединяется только с публичными адресами. Адрес проверяется
+// после разрешения имени, поэтому запрет не обходится DNS-записью или редиректом на внутренний адрес
+func newHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("netip.ParseAddrPort: %w", err)
+			}
+			if !webhookdto.PublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", webhookdto.ErrForbiddenAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// прокси из окружения соединялся бы с получателем сам, минуя проверку адреса
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func endpointFromModel(endpointModel model.WebhookEndpoint) webhookdto.Endpoint {
+	return webhookdto.Endpoint{
+		Id:        endpointModel.Id,
+		CompanyId: endpointModel.CompanyId,
+		Url:       endpointModel.Url,
+		Events:    functional.Map(endpointModel.Events, func(event string) webhookenum.Event { return webhookenum.Event(event) }),
+		CreatedAt: endpointModel.CreatedAt,
+	}
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
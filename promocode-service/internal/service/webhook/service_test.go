@@ -0,0 +1,276 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	webhookdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+const testSecret = "whsec_test"
+
+// receiver локальный получатель событий, который проверяет подпись и отвечает заданным кодом
+type receiver struct {
+	server   *httptest.Server
+	status   atomic.Int32
+	received atomic.Int32
+	invalid  atomic.Int32
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	r := &receiver{}
+	r.status.Store(int32(status))
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		err := webhookdto.Verify(testSecret, req.Header.Get(webhookdto.HeaderTimestamp), req.Header.Get(webhookdto.HeaderSignature), body, 5*time.Minute, time.Now())
+		if err != nil || req.Header.Get(webhookdto.HeaderId) == "" || req.Header.Get(webhookdto.HeaderEvent) == "" {
+			r.invalid.Add(1)
+		}
+		r.received.Add(1)
+		w.WriteHeader(int(r.status.Load()))
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func newTestService(repo webhookRepository, now time.Time) *Service {
+	s := New(zap.NewNop(), repo, 5*time.Second)
+	s.now = func() time.Time { return now }
+	// httptest слушает loopback, который клиент сервиса отклоняет
+	s.httpClient = &http.Client{Timeout: 5 * time.Second}
+	return s
+}
+
+func TestService_DispatchDue(t *testing.T) {
+	now := time.Now()
+	payload := model.JSON(`{"event":"promo.activated","data":{"promo_id":"p1"}}`)
+
+	tests := []struct {
+		name       string
+		status     int
+		attempts   int
+		wantResult func(t *testing.T, result *model.WebhookDelivery)
+		wantCount  int
+	}{
+		{
+			name:   "delivered",
+			status: http.StatusNoContent,
+			wantResult: func(t *testing.T, result *model.WebhookDelivery) {
+				require.Equal(t, webhookenum.DeliveryStatusDelivered, result.Status)
+				require.Equal(t, 1, result.Attempts)
+				require.Equal(t, http.StatusNoContent, result.LastStatusCode)
+				require.True(t, result.DeliveredAt.Valid)
+			},
+			wantCount: 1,
+		},
+		{
+			name:     "failed attempt is retried with backoff",
+			status:   http.StatusInternalServerError,
+			attempts: 2,
+			wantResult: func(t *testing.T, result *model.WebhookDelivery) {
+				require.Equal(t, webhookenum.DeliveryStatusPending, result.Status)
+				require.Equal(t, 3, result.Attempts)
+				require.Equal(t, http.StatusInternalServerError, result.LastStatusCode)
+				require.Equal(t, now.Add(2*time.Minute), result.NextAttemptAt)
+				require.Contains(t, result.LastError, "500")
+			},
+		},
+		{
+			name:     "last attempt goes to dead-letter",
+			status:   http.StatusBadGateway,
+			attempts: webhookdto.MaxAttempts - 1,
+			wantResult: func(t *testing.T, result *model.WebhookDelivery) {
+				require.Equal(t, webhookenum.DeliveryStatusDead, result.Status)
+				require.Equal(t, webhookdto.MaxAttempts, result.Attempts)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockwebhookRepository(ctrl)
+			recv := newReceiver(t, tt.status)
+
+			repo.EXPECT().ClaimDue(gomock.Any(), now, gomock.Any(), dispatchBatch).Return([]model.DueWebhookDelivery{{
+				Id:       "d1",
+				Event:    webhookenum.EventPromoActivated,
+				Payload:  payload,
+				Attempts: tt.attempts,
+				Url:      recv.server.URL,
+				Secret:   testSecret,
+			}}, nil)
+			repo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, result *model.WebhookDelivery) error {
+				require.Equal(t, "d1", result.Id)
+				tt.wantResult(t, result)
+				return nil
+			})
+
+			delivered, err := newTestService(repo, now).DispatchDue(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCount, delivered)
+			require.Equal(t, int32(1), recv.received.Load())
+			require.Zero(t, recv.invalid.Load())
+		})
+	}
+}
+
+func TestService_DispatchDue_RetriesUntilDelivered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockwebhookRepository(ctrl)
+	recv := newReceiver(t, http.StatusServiceUnavailable)
+
+	due := model.DueWebhookDelivery{
+		Id:      "d1",
+		Event:   webhookenum.EventPromoExpired,
+		Payload: model.JSON(`{"event":"promo.expired"}`),
+		Url:     recv.server.URL,
+		Secret:  testSecret,
+	}
+
+	now := time.Now()
+	s := newTestService(repo, now)
+
+	repo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), dispatchBatch).DoAndReturn(
+		func(ctx context.Context, claimedAt time.Time, leaseUntil time.Time, limit int) ([]model.DueWebhookDelivery, error) {
+			return []model.DueWebhookDelivery{due}, nil
+		}).Times(2)
+	repo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, result *model.WebhookDelivery) error {
+		require.Equal(t, webhookenum.DeliveryStatusPending, result.Status)
+		due.Attempts = result.Attempts
+		return nil
+	})
+	repo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, result *model.WebhookDelivery) error {
+		require.Equal(t, webhookenum.DeliveryStatusDelivered, result.Status)
+		require.Equal(t, 2, result.Attempts)
+		return nil
+	})
+
+	delivered, err := s.DispatchDue(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+
+	recv.status.Store(http.StatusOK)
+
+	delivered, err = s.DispatchDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Equal(t, int32(2), recv.received.Load())
+	require.Zero(t, recv.invalid.Load())
+}
+
+// Тест клиента сервиса: соединение с внутренним адресом отклоняется до отправки запроса
+func TestService_DispatchDue_RejectsInternalAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockwebhookRepository(ctrl)
+	recv := newReceiver(t, http.StatusOK)
+
+	now := time.Now()
+	s := New(zap.NewNop(), repo, 5*time.Second)
+	s.now = func() time.Time { return now }
+
+	repo.EXPECT().ClaimDue(gomock.Any(), now, gomock.Any(), dispatchBatch).Return([]model.DueWebhookDelivery{{
+		Id:      "d1",
+		Event:   webhookenum.EventPromoActivated,
+		Payload: model.JSON(`{"event":"promo.activated"}`),
+		Url:     recv.server.URL,
+		Secret:  testSecret,
+	}}, nil)
+	repo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, result *model.WebhookDelivery) error {
+		require.Equal(t, webhookenum.DeliveryStatusPending, result.Status)
+		require.Contains(t, result.LastError, webhookdto.ErrForbiddenAddress.Error())
+		return nil
+	})
+
+	delivered, err := s.DispatchDue(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+	require.Zero(t, recv.received.Load())
+}
+
+// Тест аренды: доставки пачки отправляются последовательно, и аренда должна покрыть таймаут каждой
+func TestService_DispatchDue_LeaseCoversBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockwebhookRepository(ctrl)
+
+	now := time.Now()
+	s := newTestService(repo, now)
+
+	repo.EXPECT().ClaimDue(gomock.Any(), now, gomock.Any(), dispatchBatch).DoAndReturn(
+		func(ctx context.Context, claimedAt time.Time, leaseUntil time.Time, limit int) ([]model.DueWebhookDelivery, error) {
+			require.False(t, leaseUntil.Before(now.Add(time.Duration(limit)*s.httpClient.Timeout)))
+			return nil, nil
+		})
+
+	delivered, err := s.DispatchDue(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+}
+
+func TestService_CreateEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockwebhookRepository(ctrl)
+	now := time.Now()
+
+	repo.EXPECT().ListEndpoints(gomock.Any(), "c1").Return(nil, nil)
+	repo.EXPECT().CreateEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, endpoint *model.WebhookEndpoint) (string, error) {
+		require.Equal(t, "c1", endpoint.CompanyId)
+		require.Equal(t, []string{"promo.activated", "promo.expired"}, []string(endpoint.Events))
+		require.Len(t, endpoint.Secret, len(secretPrefix)+2*secretBytes)
+		return "e1", nil
+	})
+
+	endpoint, err := newTestService(repo, now).CreateEndpoint(context.Background(), &webhookdto.Endpoint{
+		CompanyId: "c1",
+		Url:       "https://example.com/hooks",
+		Events:    []webhookenum.Event{webhookenum.EventPromoActivated, webhookenum.EventPromoExpired},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "e1", endpoint.Id)
+	require.NotEmpty(t, endpoint.Secret)
+}
+
+func TestService_CreateEndpoint_TooMany(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockwebhookRepository(ctrl)
+
+	repo.EXPECT().ListEndpoints(gomock.Any(), "c1").Return(make([]model.WebhookEndpoint, webhookdto.MaxEndpoints), nil)
+
+	_, err := newTestService(repo, time.Now()).CreateEndpoint(context.Background(), &webhookdto.Endpoint{
+		CompanyId: "c1",
+		Url:       "https://example.com/hooks",
+		Events:    []webhookenum.Event{webhookenum.EventPromoActivated},
+	})
+	require.ErrorIs(t, err, ErrTooManyEndpoints)
+}
+
+func TestService_DeleteEndpoint_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockwebhookRepository(ctrl)
+
+	repo.EXPECT().DeleteEndpoint(gomock.Any(), "e1", "c1").Return(false, nil)
+
+	err := newTestService(repo, time.Now()).DeleteEndpoint(context.Background(), "e1", "c1")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestService_ForgetUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := NewMockwebhookRepository(ctrl)
+
+	repo.EXPECT().ScrubUser(gomock.Any(), "u1").Return(4, nil)
+
+	scrubbed, err := newTestService(repo, time.Now()).ForgetUser(context.Background(), "u1")
+	require.NoError(t, err)
+	require.Equal(t, 4, scrubbed)
+}
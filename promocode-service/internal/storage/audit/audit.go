@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	auditdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/audit"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(ctx context.Context, entry *model.AuditEntry) error {
+
+	const op = "storage.audit.Create"
+
+	query := `
+		INSERT INTO promo_audit_log(id, promo_id, company_id, actor_id, actor_user_id, operation, changes, created_at)
+		VALUES (:id, :promo_id, :company_id, :actor_id, :actor_user_id, :operation, :changes, :created_at)
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, entry)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) List(ctx context.Context, filter auditdto.Filter, offset int, limit int) (entries []model.AuditEntry, err error) {
+
+	condition, sqlParams := filterCondition(filter)
+
+	query := `
+		select id, promo_id, company_id, actor_id, actor_user_id, operation, changes, created_at
+		from promo_audit_log
+		where ` + condition + `
+		order by created_at desc, id
+		offset :offset limit :limit
+	`
+
+	sqlParams["offset"] = offset
+	sqlParams["limit"] = limit
+
+	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("storage.audit.List: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry model.AuditEntry
+		if err = rows.StructScan(&entry); err != nil {
+			return nil, fmt.Errorf("storage.audit.List: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (r *Repository) Count(ctx context.Context, filter auditdto.Filter) (count int, err error) {
+
+	condition, sqlParams := filterCondition(filter)
+
+	query := `select count(1) from promo_audit_log where ` + condition
+
+	stmt, err := r.db.PrepareNamedContext(ctx, query)
+
+	if err != nil {
+		return 0, fmt.Errorf("db.PrepareNamedContext: prepare failed: %w", err)
+	}
+
+	err = stmt.QueryRowxContext(ctx, sqlParams).Scan(&count)
+
+	if err != nil {
+		return 0, fmt.Errorf("stmt.QueryRowxContext: %w", err)
+	}
+
+	return count, nil
+}
+
+func filterCondition(filter auditdto.Filter) (string, map[string]interface{}) {
+	condition := "promo_id = :promo_id and company_id = :company_id"
+
+	sqlParams := map[string]interface{}{
+		"promo_id":   filter.PromoId,
+		"company_id": filter.CompanyId,
+	}
+
+	if filter.Operation != "" {
+		condition += " and operation = :operation"
+		sqlParams["operation"] = filter.Operation
+	}
+
+	if !filter.From.IsZero() {
+		condition += " and created_at >= :from"
+		sqlParams["from"] = filter.From
+	}
+
+	if !filter.Until.IsZero() {
+		condition += " and created_at < :until"
+		sqlParams["until"] = filter.Until
+	}
+
+	return condition, sqlParams
+}
@@ -0,0 +1,90 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+	rediskey "gitlab.com/pisya-dev/promo-code-service/internal/storage/redis"
+)
+
+// lockTTL сколько ключ занят выполняющимся запросом. Если сервис упал посреди запроса,
+// ключ освобождается сам
+const lockTTL = time.Minute
+
+type Repository struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// New ttl сколько хранится ответ выполненного запроса
+func New(client *redis.Client, ttl time.Duration) *Repository {
+	return &Repository{client: client, ttl: ttl}
+}
+
+// Begin занимает ключ под запрос с этим отпечатком. Если ключ уже занят, возвращает его запись
+func (r *Repository) Begin(ctx context.Context, scope string, fingerprint string) (*model.IdempotencyRecord, error) {
+	key := rediskey.GetIdempotencyKey(scope)
+
+	pending, err := json.Marshal(model.IdempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	// ключ может истечь между SETNX и GET, тогда пробуем занять его ещё раз
+	for range 2 {
+		acquired, err := r.client.SetNX(ctx, key, pending, lockTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("redis.SetNX: %w", err)
+		}
+		if acquired {
+			return nil, nil
+		}
+
+		data, err := r.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("redis.Get: %w", err)
+		}
+
+		var record model.IdempotencyRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		return &record, nil
+	}
+
+	return nil, errors.New("idempotency key expired while being read")
+}
+
+// Complete сохраняет ответ выполненного запроса
+func (r *Repository) Complete(ctx context.Context, scope string, record model.IdempotencyRecord) error {
+	record.Done = true
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	if err := r.client.Set(ctx, rediskey.GetIdempotencyKey(scope), data, r.ttl).Err(); err != nil {
+		return fmt.Errorf("redis.Set: %w", err)
+	}
+
+	return nil
+}
+
+// Release освобождает ключ после неудачного запроса, чтобы клиент мог повторить его с тем же ключом
+func (r *Repository) Release(ctx context.Context, scope string) error {
+	if err := r.client.Del(ctx, rediskey.GetIdempotencyKey(scope)).Err(); err != nil {
+		return fmt.Errorf("redis.Del: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
+)
+
+type ActivationLimit struct {
+	Scope  limitenum.Scope  `json:"scope"`
+	Window limitenum.Window `json:"window"`
+	Max    int64            `json:"max"`
+}
+
+// ActivationLimits хранятся в jsonb-колонке promo.activation_limits
+type ActivationLimits []ActivationLimit
+
+func (l ActivationLimits) Value() (driver.Value, error) {
+	if l == nil {
+		l = ActivationLimits{}
+	}
+	bytes, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+func (l *ActivationLimits) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("cannot convert %T to ActivationLimits", src)
+	}
+}
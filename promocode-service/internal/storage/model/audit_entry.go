@@ -0,0 +1,44 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	auditenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/audit"
+)
+
+type AuditEntry struct {
+	Id          string              `db:"id"`
+	PromoId     string              `db:"promo_id"`
+	CompanyId   string              `db:"company_id"`
+	ActorId     string              `db:"actor_id"`
+	ActorUserId string              `db:"actor_user_id"`
+	Operation   auditenum.Operation `db:"operation"`
+	Changes     JSON                `db:"changes"`
+	CreatedAt   time.Time           `db:"created_at"`
+}
+
+// JSON хранит jsonb-значение без разбора
+type JSON []byte
+
+func (j JSON) Value() (driver.Value, error) {
+	if j == nil {
+		return "{}", nil
+	}
+	return string(j), nil
+}
+
+func (j *JSON) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		*j = append((*j)[:0], v...)
+	case string:
+		*j = JSON(v)
+	case nil:
+		*j = nil
+	default:
+		return fmt.Errorf("cannot convert %T to JSON", src)
+	}
+	return nil
+}
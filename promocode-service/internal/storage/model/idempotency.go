@@ -0,0 +1,12 @@
+package model
+
+// IdempotencyRecord запрос, выполненный с Idempotency-Key. Пока Done не выставлен, запрос ещё
+// выполняется. Response хранит ответ в protobuf, ResponseType его полное имя. Если запрос
+// завершился ошибкой, Error хранит её google.rpc.Status в protobuf
+type IdempotencyRecord struct {
+	Fingerprint  string `json:"fingerprint"`
+	Done         bool   `json:"done"`
+	ResponseType string `json:"response_type,omitempty"`
+	Response     []byte `json:"response,omitempty"`
+	Error        []byte `json:"error,omitempty"`
+}
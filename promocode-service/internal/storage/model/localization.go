@@ -0,0 +1,38 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Localization язык основного описания и его переводы
+type Localization struct {
+	DefaultLocale           string                  `db:"default_locale" json:"default_locale"`
+	DescriptionTranslations DescriptionTranslations `db:"description_translations" json:"description_translations"`
+}
+
+// DescriptionTranslations хранятся в jsonb-колонке promo.description_translations
+type DescriptionTranslations map[string]string
+
+func (t DescriptionTranslations) Value() (driver.Value, error) {
+	if t == nil {
+		t = DescriptionTranslations{}
+	}
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+func (t *DescriptionTranslations) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, t)
+	case string:
+		return json.Unmarshal([]byte(v), t)
+	default:
+		return fmt.Errorf("cannot convert %T to DescriptionTranslations", src)
+	}
+}
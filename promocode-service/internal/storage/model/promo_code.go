@@ -1,5 +1,10 @@
 package model
 
+import (
+	"database/sql"
+	"time"
+)
+
 type PromoCode struct {
 	Id          string `db:"id"`
 	PromoId     string `db:"promo_id"`
@@ -7,3 +12,18 @@ type PromoCode struct {
 	Activations int64  `db:"activations"`
 	MaxCount    int64  `db:"max_count"`
 }
+
+type PromoActivation struct {
+	Code        string         `db:"code"`
+	UserId      sql.NullString `db:"user_id"`
+	ActivatedAt time.Time      `db:"activated_at"`
+}
+
+// UserActivation активация пользователя вместе с данными промокода, используется для выгрузки персональных данных
+type UserActivation struct {
+	PromoId     string    `db:"promo_id"`
+	CompanyId   string    `db:"company_id"`
+	Code        string    `db:"code"`
+	Description string    `db:"description"`
+	ActivatedAt time.Time `db:"activated_at"`
+}
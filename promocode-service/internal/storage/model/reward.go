@@ -0,0 +1,40 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	rewardenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/reward"
+)
+
+// Reward хранится в jsonb-колонке promo.reward
+type Reward struct {
+	Type               rewardenum.Type `json:"type"`
+	Percent            float64         `json:"percent,omitempty"`
+	Amount             int64           `json:"amount,omitempty"`
+	Currency           string          `json:"currency,omitempty"`
+	ItemSku            string          `json:"item_sku,omitempty"`
+	MinOrderAmount     int64           `json:"min_order_amount,omitempty"`
+	EligibleCategories []string        `json:"eligible_categories,omitempty"`
+	MaxDiscountAmount  int64           `json:"max_discount_amount,omitempty"`
+}
+
+func (r Reward) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+func (r *Reward) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("cannot convert %T to Reward", src)
+	}
+}
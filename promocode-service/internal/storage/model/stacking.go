@@ -0,0 +1,8 @@
+package model
+
+// Stacking правила совмещения промокода с другими промокодами компании
+type Stacking struct {
+	Group     string `db:"stacking_group" json:"stacking_group"`
+	Exclusive bool   `db:"exclusive" json:"exclusive"`
+	Priority  int64  `db:"priority" json:"priority"`
+}
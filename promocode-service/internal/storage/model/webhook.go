@@ -0,0 +1,43 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+)
+
+type WebhookEndpoint struct {
+	Id        string         `db:"id"`
+	CompanyId string         `db:"company_id"`
+	Url       string         `db:"url"`
+	Secret    string         `db:"secret"`
+	Events    pq.StringArray `db:"events"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+type WebhookDelivery struct {
+	Id             string                     `db:"id"`
+	EndpointId     string                     `db:"endpoint_id"`
+	CompanyId      string                     `db:"company_id"`
+	Event          webhookenum.Event          `db:"event"`
+	Payload        JSON                       `db:"payload"`
+	Status         webhookenum.DeliveryStatus `db:"status"`
+	Attempts       int                        `db:"attempts"`
+	LastStatusCode int                        `db:"last_status_code"`
+	LastError      string                     `db:"last_error"`
+	NextAttemptAt  time.Time                  `db:"next_attempt_at"`
+	CreatedAt      time.Time                  `db:"created_at"`
+	DeliveredAt    sql.NullTime               `db:"delivered_at"`
+}
+
+// DueWebhookDelivery доставка, выбранная для отправки, вместе с адресом и секретом
+type DueWebhookDelivery struct {
+	Id       string            `db:"id"`
+	Event    webhookenum.Event `db:"event"`
+	Payload  JSON              `db:"payload"`
+	Attempts int               `db:"attempts"`
+	Url      string            `db:"url"`
+	Secret   string            `db:"secret"`
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/lib/pq"
 	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/promo"
+	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
 )
 
 type CodeDTO struct {
@@ -26,17 +28,37 @@ func (c *CodeDTOs) Scan(src interface{}) error {
 }
 
 type PromoDetails struct {
-	Id               string         `db:"id"`
-	CompanyId        string         `db:"company_id"`
-	Description      string         `db:"description"`
-	ImageUrl         string         `db:"image_url"`
-	ActiveFrom       time.Time      `db:"active_from"`
-	ActiveUntil      time.Time      `db:"active_until"`
-	CreatedAt        time.Time      `db:"created_at"`
-	Mode             promo.Mode     `db:"mode"`
-	TargetAgeFrom    int            `db:"target_age_from"`
-	TargetAgeUntil   int            `db:"target_age_until"`
-	TargetCountry    string         `db:"target_country"`
-	TargetCategories pq.StringArray `db:"target_categories"`
-	Codes            CodeDTOs       `db:"codes"`
+	Id               string                 `db:"id"`
+	CompanyId        string                 `db:"company_id"`
+	Description      string                 `db:"description"`
+	ImageUrl         string                 `db:"image_url"`
+	ActiveFrom       time.Time              `db:"active_from"`
+	ActiveUntil      time.Time              `db:"active_until"`
+	CreatedAt        time.Time              `db:"created_at"`
+	Mode             promo.Mode             `db:"mode"`
+	TargetAgeFrom    int                    `db:"target_age_from"`
+	TargetAgeUntil   int                    `db:"target_age_until"`
+	TargetCountry    string                 `db:"target_country"`
+	TargetCategories pq.StringArray         `db:"target_categories"`
+	Codes            CodeDTOs               `db:"codes"`
+	Status           promoenum.Status       `db:"status"`
+	Reward           *model.Reward          `db:"reward"`
+	ActivationLimits model.ActivationLimits `db:"activation_limits"`
+	ReferralReward   *model.ReferralReward  `db:"referral_reward"`
+	Highlight        string                 `db:"highlight"`
+	SearchRank       float64                `db:"search_rank"`
+	model.Stacking
+	model.Localization
+}
+
+type StatusChange struct {
+	PromoId   string           `db:"id"`
+	CompanyId string           `db:"company_id"`
+	From      promoenum.Status `db:"from_status"`
+	To        promoenum.Status `db:"to_status"`
+}
+
+type PurgedPromo struct {
+	PromoId   string `db:"id"`
+	CompanyId string `db:"company_id"`
 }
@@ -29,11 +29,15 @@ func (r *Repository) Create(ctx context.Context, promoModel *model.Promo) (id st
 		INSERT INTO promo(
 			id, company_id, description, image_url, active_from, active_until,
 			created_at, mode, target_age_from, target_age_until,
-			target_country, target_categories
+			target_country, target_categories, status, status_changed_at, reward,
+			stacking_group, exclusive, priority, activation_limits, referral_reward,
+			default_locale, description_translations
 		) VALUES (
 			:id, :company_id, :description, :image_url, :active_from, :active_until,
 			:created_at, :mode, :target_age_from, :target_age_until,
-			:target_country, :target_categories
+			:target_country, :target_categories, :status, :created_at, :reward,
+			:stacking_group, :exclusive, :priority, :activation_limits, :referral_reward,
+			:default_locale, :description_translations
 		)
 		RETURNING id
 	`
@@ -52,7 +56,7 @@ func (r *Repository) Create(ctx context.Context, promoModel *model.Promo) (id st
 	return id, nil
 }
 
-func (r *Repository) List(ctx context.Context, companyId string, countries []string, offset int, sortBy promoenum.SortBy, limit int) (promoModels []PromoDetails, err error) {
+func (r *Repository) List(ctx context.Context, companyId string, countries []string, offset int, sortBy promoenum.SortBy, limit int, searchQuery string) (promoModels []PromoDetails, err error) {
 
 	query := `select 
 				p.id,
@@ -67,19 +71,30 @@ func (r *Repository) List(ctx context.Context, companyId string, countries []str
 				p.target_age_until,
 				p.target_country,
 				p.target_categories,
+				p.status,
+				p.reward,
+				p.stacking_group,
+				p.exclusive,
+				p.priority,
+				p.activation_limits,
+				p.referral_reward,
+				p.default_locale,
+				p.description_translations,
 				COALESCE(json_agg(json_build_object(
 					'code', pc.code,
 					'activations', pc.activations,
 					'max_count', pc.max_count
-				)) filter (where pc.id is not null), '[]') as codes
+				)) filter (where pc.id is not null), '[]') as codes,
+				` + searchColumns(searchQuery) + `
 			from promo p
 			left join promo_code pc on pc.promo_id = p.id 
-			where p.company_id = :company_id`
+			where p.company_id = :company_id and p.deleted_at is null` + searchCondition(searchQuery)
 
 	sqlParams := map[string]interface{}{
 		"company_id": companyId,
 		"offset":     offset,
 		"limit":      limit,
+		"query":      searchQuery,
 	}
 
 	sqlCountries := ""
@@ -96,7 +111,7 @@ func (r *Repository) List(ctx context.Context, companyId string, countries []str
 		query += fmt.Sprintf(" and (lower(p.target_country) in (%s) or p.target_country is null)", sqlCountries)
 	}
 
-	query += fmt.Sprintf(" group by p.id, p.%s order by p.%s desc offset :offset limit :limit", sortBy, sortBy)
+	query += fmt.Sprintf(" group by p.id, p.%s order by %sp.%s desc offset :offset limit :limit", sortBy, searchOrder(searchQuery), sortBy)
 
 	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
 
@@ -115,14 +130,15 @@ func (r *Repository) List(ctx context.Context, companyId string, countries []str
 	return promoModels, nil
 }
 
-func (r *Repository) Count(ctx context.Context, companyId string, countries []string) (count int, err error) {
+func (r *Repository) Count(ctx context.Context, companyId string, countries []string, searchQuery string) (count int, err error) {
 	query := `
-	select count(1) from promo p where p.company_id = :company_id
-	`
+	select count(1) from promo p where p.company_id = :company_id and p.deleted_at is null
+	` + searchCondition(searchQuery)
 
 	sqlCountries := ""
 	sqlParams := map[string]interface{}{
 		"company_id": companyId,
+		"query":      searchQuery,
 	}
 
 	if len(countries) > 0 {
@@ -153,6 +169,98 @@ func (r *Repository) Count(ctx context.Context, companyId string, countries []st
 
 }
 
+func (r *Repository) Feed(ctx context.Context, category string, offset int, limit int, searchQuery string) (promoModels []PromoDetails, err error) {
+
+	query := `select 
+				p.id,
+				p.company_id,
+				p.description,
+				p.image_url,
+				p.active_from,
+				p.active_until,
+				p.created_at,
+				p.mode,
+				p.target_age_from,
+				p.target_age_until,
+				p.target_country,
+				p.target_categories,
+				p.status,
+				p.reward,
+				p.stacking_group,
+				p.exclusive,
+				p.priority,
+				p.activation_limits,
+				p.referral_reward,
+				p.default_locale,
+				p.description_translations,
+				` + searchColumns(searchQuery) + `
+			from promo p
+			where p.status not in (:draft, :archived) and p.deleted_at is null` + searchCondition(searchQuery) + feedCategoryCondition(category)
+
+	sqlParams := map[string]interface{}{
+		"draft":    promoenum.StatusDraft,
+		"archived": promoenum.StatusArchived,
+		"category": category,
+		"offset":   offset,
+		"limit":    limit,
+		"query":    searchQuery,
+	}
+
+	query += fmt.Sprintf(" order by %sp.created_at desc offset :offset limit :limit", searchOrder(searchQuery))
+
+	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("storage.promo.Feed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var promo PromoDetails
+		if err = rows.StructScan(&promo); err != nil {
+			return nil, fmt.Errorf("storage.promo.Feed: %w", err)
+		}
+		promoModels = append(promoModels, promo)
+	}
+
+	return promoModels, nil
+}
+
+func (r *Repository) FeedCount(ctx context.Context, category string, searchQuery string) (count int, err error) {
+	query := `
+	select count(1) from promo p where p.status not in (:draft, :archived) and p.deleted_at is null
+	` + searchCondition(searchQuery) + feedCategoryCondition(category)
+
+	sqlParams := map[string]interface{}{
+		"draft":    promoenum.StatusDraft,
+		"archived": promoenum.StatusArchived,
+		"category": category,
+		"query":    searchQuery,
+	}
+
+	stmt, err := r.db.PrepareNamedContext(ctx, query)
+
+	if err != nil {
+		return 0, fmt.Errorf("db.PrepareNamedContext: prepare failed: %w", err)
+	}
+
+	err = stmt.QueryRowxContext(ctx, sqlParams).Scan(&count)
+
+	if err != nil {
+		return 0, fmt.Errorf("stmt.QueryRowxContext: %w", err)
+	}
+
+	return count, nil
+}
+
+func feedCategoryCondition(category string) string {
+	if category == "" {
+		return ""
+	}
+
+	return " and lower(:category) in (select lower(c) from unnest(p.target_categories) c)"
+}
+
 func (r *Repository) GetById(ctx context.Context, promoId string) (promoModel *PromoDetails, err error) {
 
 	query := `select 
@@ -168,6 +276,15 @@ func (r *Repository) GetById(ctx context.Context, promoId string) (promoModel *P
 				p.target_age_until,
 				p.target_country,
 				p.target_categories,
+				p.status,
+				p.reward,
+				p.stacking_group,
+				p.exclusive,
+				p.priority,
+				p.activation_limits,
+				p.referral_reward,
+				p.default_locale,
+				p.description_translations,
 				COALESCE(json_agg(json_build_object(
 					'code', pc.code,
 					'activations', pc.activations,
@@ -175,7 +292,7 @@ func (r *Repository) GetById(ctx context.Context, promoId string) (promoModel *P
 				)) filter (where pc.id is not null), '[]') as codes
 			from promo p
 			left join promo_code pc on pc.promo_id = p.id 
-			where p.id = :promo_id
+			where p.id = :promo_id and p.deleted_at is null
 			group by p.id`
 
 	sqlParams := map[string]interface{}{
@@ -200,6 +317,8 @@ func (r *Repository) GetById(ctx context.Context, promoId string) (promoModel *P
 
 }
 
+// Update обновляет редактируемые поля промокода. Если reward, stacking, activationLimits, referralReward или localization равны nil, соответствующие настройки остаются прежними.
+// Статус меняется с from на to вместе с датами; false означает, что статус промокода уже не from
 func (r *Repository) Update(
 	ctx context.Context,
 	promoId string,
@@ -211,7 +330,14 @@ func (r *Repository) Update(
 	targetCategories []string,
 	activeFrom time.Time,
 	activeUntil time.Time,
-) error {
+	reward *model.Reward,
+	stacking *model.Stacking,
+	activationLimits *model.ActivationLimits,
+	referralReward *model.ReferralReward,
+	localization *model.Localization,
+	from promoenum.Status,
+	to promoenum.Status,
+) (updated bool, err error) {
 
 	query := `
 		update promo set
@@ -222,20 +348,111 @@ func (r *Repository) Update(
 			target_country = :target_country,
 			target_categories = :target_categories,
 			active_from = :active_from,
-			active_until = :active_until
-		where id = :promo_id
+			active_until = :active_until,
+			reward = coalesce(:reward, reward),
+			stacking_group = coalesce(:stacking_group, stacking_group),
+			exclusive = coalesce(:exclusive, exclusive),
+			priority = coalesce(:priority, priority),
+			activation_limits = coalesce(:activation_limits, activation_limits),
+			referral_reward = coalesce(:referral_reward, referral_reward),
+			default_locale = coalesce(:default_locale, default_locale),
+			description_translations = coalesce(:description_translations, description_translations),
+			status_changed_at = case when status <> :to then now() else status_changed_at end,
+			status = :to
+		where id = :promo_id and status = :from and deleted_at is null
 	`
 
 	sqlParams := map[string]interface{}{
-		"promo_id":          promoId,
-		"description":       description,
-		"image_url":         imageUrl,
-		"target_age_from":   targetAgeFrom,
-		"target_age_until":  targetAgeUntil,
-		"target_country":    targetCountry,
-		"target_categories": pq.Array(targetCategories),
-		"active_from":       activeFrom,
-		"active_until":      activeUntil,
+		"promo_id":                 promoId,
+		"description":              description,
+		"image_url":                imageUrl,
+		"target_age_from":          targetAgeFrom,
+		"target_age_until":         targetAgeUntil,
+		"target_country":           targetCountry,
+		"target_categories":        pq.Array(targetCategories),
+		"active_from":              activeFrom,
+		"active_until":             activeUntil,
+		"reward":                   reward,
+		"stacking_group":           nil,
+		"exclusive":                nil,
+		"priority":                 nil,
+		"activation_limits":        nil,
+		"referral_reward":          referralReward,
+		"default_locale":           nil,
+		"description_translations": nil,
+		"from":                     from,
+		"to":                       to,
+	}
+
+	if stacking != nil {
+		sqlParams["stacking_group"] = stacking.Group
+		sqlParams["exclusive"] = stacking.Exclusive
+		sqlParams["priority"] = stacking.Priority
+	}
+
+	if activationLimits != nil {
+		sqlParams["activation_limits"] = *activationLimits
+	}
+
+	if localization != nil {
+		sqlParams["default_locale"] = localization.DefaultLocale
+		sqlParams["description_translations"] = localization.DescriptionTranslations
+	}
+
+	result, err := r.db.NamedExecContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return false, fmt.Errorf("r.db.NamedExecContext: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return false, fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	return affected > 0, nil
+
+}
+
+// GetCompanyId возвращает компанию неудалённого промокода без загрузки кодов.
+// Пустая строка означает, что промокод не найден
+func (r *Repository) GetCompanyId(ctx context.Context, promoId string) (string, error) {
+	query := `select company_id from promo where id = :promo_id and deleted_at is null`
+
+	sqlParams := map[string]interface{}{
+		"promo_id": promoId,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return "", fmt.Errorf("storage.promo.GetCompanyId: %w", err)
+	}
+	defer rows.Close()
+
+	var companyId string
+
+	for rows.Next() {
+		if err = rows.Scan(&companyId); err != nil {
+			return "", fmt.Errorf("storage.promo.GetCompanyId: %w", err)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return "", fmt.Errorf("storage.promo.GetCompanyId: %w", err)
+	}
+
+	return companyId, nil
+}
+
+// SetImage заменяет изображение промокода
+func (r *Repository) SetImage(ctx context.Context, promoId string, imageUrl string) error {
+	query := `update promo set image_url = :image_url where id = :promo_id and deleted_at is null`
+
+	sqlParams := map[string]interface{}{
+		"promo_id":  promoId,
+		"image_url": imageUrl,
 	}
 
 	_, err := r.db.NamedExecContext(ctx, query, sqlParams)
@@ -245,11 +462,10 @@ func (r *Repository) Update(
 	}
 
 	return nil
-
 }
 
 func (r *Repository) Delete(ctx context.Context, promoId string) error {
-	query := `delete from promo_code p where p.promo_id = :promo_id`
+	query := `update promo set deleted_at = now() where id = :promo_id and deleted_at is null`
 
 	sqlParams := map[string]interface{}{
 		"promo_id": promoId,
@@ -261,14 +477,141 @@ func (r *Repository) Delete(ctx context.Context, promoId string) error {
 		return fmt.Errorf("r.db.NamedExecContext: %w", err)
 	}
 
-	query = `delete from promo where id = :promo_id`
+	return nil
+
+}
+
+// Restore снимает пометку удаления, если промокод принадлежит компании и был удалён не раньше deletedAfter
+func (r *Repository) Restore(ctx context.Context, promoId string, companyId string, deletedAfter time.Time) (restored bool, err error) {
+	query := `
+		update promo set deleted_at = null
+		where id = :promo_id and company_id = :company_id and deleted_at is not null and deleted_at > :deleted_after
+	`
+
+	sqlParams := map[string]interface{}{
+		"promo_id":      promoId,
+		"company_id":    companyId,
+		"deleted_after": deletedAfter,
+	}
+
+	result, err := r.db.NamedExecContext(ctx, query, sqlParams)
 
-	_, err = r.db.NamedExecContext(ctx, query, sqlParams)
+	if err != nil {
+		return false, fmt.Errorf("r.db.NamedExecContext: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
 
 	if err != nil {
-		return fmt.Errorf("r.db.NamedExecContext: %w", err)
+		return false, fmt.Errorf("result.RowsAffected: %w", err)
 	}
 
-	return nil
+	return affected > 0, nil
+}
+
+// Purge окончательно удаляет промокоды, помеченные удалёнными раньше deletedBefore, вместе с их кодами
+func (r *Repository) Purge(ctx context.Context, deletedBefore time.Time) (purged []PurgedPromo, err error) {
+	query := `delete from promo where deleted_at is not null and deleted_at < :deleted_before returning id, company_id`
+
+	sqlParams := map[string]interface{}{
+		"deleted_before": deletedBefore,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("storage.promo.Purge: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var promo PurgedPromo
+		if err = rows.StructScan(&promo); err != nil {
+			return nil, fmt.Errorf("storage.promo.Purge: %w", err)
+		}
+		purged = append(purged, promo)
+	}
+
+	return purged, nil
+}
+
+func (r *Repository) UpdateStatus(ctx context.Context, promoId string, from promoenum.Status, to promoenum.Status) (updated bool, err error) {
+	query := `
+		update promo set
+			status = :to,
+			status_changed_at = now()
+		where id = :promo_id and status = :from and deleted_at is null
+	`
+
+	sqlParams := map[string]interface{}{
+		"promo_id": promoId,
+		"from":     from,
+		"to":       to,
+	}
+
+	result, err := r.db.NamedExecContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return false, fmt.Errorf("r.db.NamedExecContext: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return false, fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+// AdvanceStatuses переводит промокоды в expired, exhausted или active в зависимости от текущего времени
+// и оставшихся активаций. Возвращает совершённые переходы.
+func (r *Repository) AdvanceStatuses(ctx context.Context, now time.Time) (changes []StatusChange, err error) {
+	query := `
+		with next as (
+			select p.id, p.status as from_status,
+				case
+					when p.active_until is not null and p.active_until < :now then :expired
+					when p.status = :active and not exists (
+						select 1 from promo_code pc where pc.promo_id = p.id and pc.activations < pc.max_count
+					) then :exhausted
+					when p.status = :scheduled and (p.active_from is null or p.active_from <= :now) then :active
+					else p.status
+				end as status
+			from promo p
+			where p.status in (:scheduled, :active, :paused) and p.deleted_at is null
+		)
+		update promo p set
+			status = next.status,
+			status_changed_at = :now
+		from next
+		where p.id = next.id and p.status <> next.status
+		returning p.id, p.company_id, next.from_status, next.status as to_status
+	`
+
+	sqlParams := map[string]interface{}{
+		"now":       now,
+		"scheduled": promoenum.StatusScheduled,
+		"active":    promoenum.StatusActive,
+		"paused":    promoenum.StatusPaused,
+		"exhausted": promoenum.StatusExhausted,
+		"expired":   promoenum.StatusExpired,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("storage.promo.AdvanceStatuses: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var change StatusChange
+		if err = rows.StructScan(&change); err != nil {
+			return nil, fmt.Errorf("storage.promo.AdvanceStatuses: %w", err)
+		}
+		changes = append(changes, change)
+	}
 
+	return changes, nil
 }
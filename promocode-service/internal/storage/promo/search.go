@@ -0,0 +1,38 @@
+package promo
+
+import "fmt"
+
+// searchTsQuery разбирает строку поиска в синтаксисе веб-поиска: работают фразы в кавычках и "-слово"
+const searchTsQuery = "websearch_to_tsquery('simple', :query)"
+
+// searchColumns возвращает подсвеченный фрагмент описания и релевантность промокода. Без запроса
+// обе колонки выбираются константами, чтобы структура для сканирования не менялась
+func searchColumns(query string) string {
+	if query == "" {
+		return `'' as highlight, cast(0 as float8) as search_rank`
+	}
+
+	return fmt.Sprintf(`ts_headline('simple', coalesce(p.description, ''), %[1]s, 'StartSel=<b>, StopSel=</b>, MaxFragments=2') as highlight,
+				cast(ts_rank(p.search_vector, %[1]s) + similarity(coalesce(p.description, ''), :query) as float8) as search_rank`, searchTsQuery)
+}
+
+// searchCondition ищет промокоды по полнотекстовому вектору, а при опечатке в запросе по триграммному
+// сходству с описанием или с одной из целевых категорий
+func searchCondition(query string) string {
+	if query == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(` and (p.search_vector @@ %s
+				or coalesce(p.description, '') %% :query
+				or exists (select 1 from unnest(p.target_categories) c where c %% :query))`, searchTsQuery)
+}
+
+// searchOrder при поиске ставит первыми самые релевантные промокоды
+func searchOrder(query string) string {
+	if query == "" {
+		return ""
+	}
+
+	return "search_rank desc, "
+}
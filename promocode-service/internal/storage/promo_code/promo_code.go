@@ -5,8 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/limit"
+	limitenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/limit"
+	promoenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/promo"
 	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
 )
 
@@ -17,6 +21,7 @@ type Repository struct {
 var (
 	ErrNotFound      = errors.New("not found")
 	ErrNoActivations = errors.New("no activations")
+	ErrUserRequired  = errors.New("user required")
 )
 
 func New(db *sqlx.DB) *Repository {
@@ -51,37 +56,53 @@ func (r *Repository) Create(ctx context.Context, promoCodeModel *model.PromoCode
 
 }
 
-func (r *Repository) Activate(ctx context.Context, promoId string) (code string, err error) {
+// Activate выдаёт код промокода и записывает активацию. Политики activation_limits проверяются в той же транзакции
+// под блокировкой строки промокода, поэтому параллельные активации не могут превысить лимит.
+// userId обязателен, если у промокода есть лимиты на пользователя.
+// exhausted сообщает, что активация израсходовала последний код и перевела промокод в exhausted
+func (r *Repository) Activate(ctx context.Context, promoId string, userId string) (code string, exhausted bool, err error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return "", fmt.Errorf("begin transaction: %w", err)
+		return "", false, fmt.Errorf("begin transaction: %w", err)
 	}
+
 	defer func() {
 		if p := recover(); p != nil {
 			_ = tx.Rollback()
 			panic(p)
 		} else if err != nil {
 			_ = tx.Rollback()
-		} else {
-			err = tx.Commit()
+		} else if err = tx.Commit(); err != nil {
+			exhausted = false
 		}
 	}()
 
+	now := time.Now()
+
+	if err = r.checkLimits(ctx, tx, promoId, userId, now); err != nil {
+		return "", false, err
+	}
+
 	query := `
-		SELECT id, code 
-		FROM promo_code 
-		WHERE promo_id = :promo_id AND activations < max_count 
+		SELECT pc.id, pc.code 
+		FROM promo_code pc
+		JOIN promo p ON p.id = pc.promo_id
+		WHERE pc.promo_id = :promo_id AND pc.activations < pc.max_count AND p.status = :active AND p.deleted_at IS NULL
+			AND (p.active_from IS NULL OR p.active_from <= :now)
+			AND (p.active_until IS NULL OR p.active_until > :now)
 		LIMIT 1 
-		FOR UPDATE
+		FOR UPDATE OF pc
 	`
 
 	params := map[string]interface{}{
 		"promo_id": promoId,
+		"active":   promoenum.StatusActive,
+		"now":      now,
 	}
 
 	namedQuery, args, err := sqlx.Named(query, params)
 	if err != nil {
-		return "", fmt.Errorf("named query prepare: %w", err)
+		return "", false, fmt.Errorf("named query prepare: %w", err)
 	}
 
 	namedQuery = tx.Rebind(namedQuery)
@@ -91,23 +112,343 @@ func (r *Repository) Activate(ctx context.Context, promoId string) (code string,
 	row := tx.QueryRowxContext(ctx, namedQuery, args...)
 	if err = row.Scan(&promoCodeId, &code); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return "", ErrNoActivations
+			return "", false, ErrNoActivations
 		}
-		return "", fmt.Errorf("scan code: %w", err)
+		return "", false, fmt.Errorf("scan code: %w", err)
 	}
 
 	updateQuery := `
 		UPDATE promo_code 
 		SET activations = activations + 1 
-		WHERE code = :code
+		WHERE id = :id
 	`
 
 	_, err = tx.NamedExecContext(ctx, updateQuery, map[string]interface{}{
-		"code": code,
+		"id": promoCodeId,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("update activations: %w", err)
+	}
+
+	activationQuery := `
+		INSERT INTO promo_activation(promo_id, promo_code_id, user_id)
+		VALUES (:promo_id, :promo_code_id, :user_id)
+	`
+
+	_, err = tx.NamedExecContext(ctx, activationQuery, map[string]interface{}{
+		"promo_id":      promoId,
+		"promo_code_id": promoCodeId,
+		"user_id":       sql.NullString{String: userId, Valid: userId != ""},
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("insert activation: %w", err)
+	}
+
+	exhaustQuery := `
+		UPDATE promo 
+		SET status = :exhausted, status_changed_at = now() 
+		WHERE id = :promo_id AND status = :active AND NOT EXISTS (
+			SELECT 1 FROM promo_code WHERE promo_id = :promo_id AND activations < max_count
+		)
+	`
+
+	res, err := tx.NamedExecContext(ctx, exhaustQuery, map[string]interface{}{
+		"promo_id":  promoId,
+		"active":    promoenum.StatusActive,
+		"exhausted": promoenum.StatusExhausted,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("update promo status: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return "", false, fmt.Errorf("update promo status rows affected: %w", err)
+	}
+
+	return code, affected > 0, nil
+}
+
+func (r *Repository) checkLimits(ctx context.Context, tx *sqlx.Tx, promoId string, userId string, now time.Time) error {
+	lockQuery := `
+		SELECT activation_limits
+		FROM promo
+		WHERE id = :promo_id AND status = :active AND deleted_at IS NULL
+		FOR UPDATE
+	`
+
+	namedQuery, args, err := sqlx.Named(lockQuery, map[string]interface{}{
+		"promo_id": promoId,
+		"active":   promoenum.StatusActive,
 	})
 	if err != nil {
-		return "", fmt.Errorf("update activations: %w", err)
+		return fmt.Errorf("named query prepare: %w", err)
+	}
+
+	var limitModels model.ActivationLimits
+	if err = tx.QueryRowxContext(ctx, tx.Rebind(namedQuery), args...).Scan(&limitModels); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNoActivations
+		}
+		return fmt.Errorf("lock promo: %w", err)
+	}
+
+	if len(limitModels) == 0 {
+		return nil
+	}
+
+	policies := make([]limit.Policy, 0, len(limitModels))
+	for _, limitModel := range limitModels {
+		policies = append(policies, limit.Policy{Scope: limitModel.Scope, Window: limitModel.Window, Max: limitModel.Max})
+	}
+
+	if limit.PerUser(policies) && userId == "" {
+		return ErrUserRequired
+	}
+
+	used := make([]int64, len(policies))
+	for i, policy := range policies {
+		countQuery := `
+			SELECT count(1)
+			FROM promo_activation
+			WHERE promo_id = :promo_id AND activated_at >= :since
+		`
+		params := map[string]interface{}{
+			"promo_id": promoId,
+			"since":    policy.WindowStart(now),
+		}
+		if policy.Scope == limitenum.ScopeUser {
+			countQuery += " AND user_id = :user_id"
+			params["user_id"] = userId
+		}
+
+		namedQuery, args, err = sqlx.Named(countQuery, params)
+		if err != nil {
+			return fmt.Errorf("named query prepare: %w", err)
+		}
+
+		if err = tx.QueryRowxContext(ctx, tx.Rebind(namedQuery), args...).Scan(&used[i]); err != nil {
+			return fmt.Errorf("count activations: %w", err)
+		}
+	}
+
+	if exceeded := limit.Check(policies, used, now); exceeded != nil {
+		return exceeded
+	}
+
+	return nil
+}
+
+// GetByCode ищет код среди неудалённых промокодов. Если код встречается в нескольких промокодах,
+// предпочтение отдаётся активному промокоду с оставшимися активациями
+func (r *Repository) GetByCode(ctx context.Context, code string) (promoCodeModel *model.PromoCode, err error) {
+	query := `
+		SELECT pc.id, pc.promo_id, pc.code, pc.activations, pc.max_count
+		FROM promo_code pc
+		JOIN promo p ON p.id = pc.promo_id
+		WHERE pc.code = :code AND p.deleted_at IS NULL
+		ORDER BY p.status = :active DESC, pc.activations < pc.max_count DESC, p.created_at DESC
+		LIMIT 1
+	`
+
+	params := map[string]interface{}{
+		"code":   code,
+		"active": promoenum.StatusActive,
+	}
+
+	stmt, err := r.db.PrepareNamedContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("storage.promo_code.GetByCode: prepare failed: %w", err)
+	}
+
+	var promoCode model.PromoCode
+
+	err = stmt.GetContext(ctx, &promoCode, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("storage.promo_code.GetByCode: %w", err)
+	}
+
+	return &promoCode, nil
+}
+
+// GetActivatedCode возвращает код промокода, выданный пользователю при активации. Если code пустой,
+// возвращается последний выданный код. Пустая строка означает, что пользователь такой код не получал
+func (r *Repository) GetActivatedCode(ctx context.Context, promoId string, userId string, code string) (string, error) {
+	query := `
+		SELECT pc.code
+		FROM promo_activation pa
+		JOIN promo_code pc ON pc.id = pa.promo_code_id
+		JOIN promo p ON p.id = pa.promo_id
+		WHERE pa.promo_id = :promo_id AND pa.user_id = :user_id AND (:code = '' OR pc.code = :code) AND p.deleted_at IS NULL
+		ORDER BY pa.activated_at DESC
+		LIMIT 1
+	`
+
+	params := map[string]interface{}{
+		"promo_id": promoId,
+		"user_id":  userId,
+		"code":     code,
+	}
+
+	stmt, err := r.db.PrepareNamedContext(ctx, query)
+	if err != nil {
+		return "", fmt.Errorf("storage.promo_code.GetActivatedCode: prepare failed: %w", err)
+	}
+
+	var activatedCode string
+
+	err = stmt.GetContext(ctx, &activatedCode, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("storage.promo_code.GetActivatedCode: %w", err)
+	}
+
+	return activatedCode, nil
+}
+
+// ExportCodes передаёт в fn коды промокода, упорядоченные по значению. Строки читаются из курсора по одной,
+// поэтому выгрузка не загружает все коды в память. Ошибка fn прерывает выгрузку и возвращается как есть
+func (r *Repository) ExportCodes(ctx context.Context, promoId string, fn func(promoCode *model.PromoCode) error) error {
+	query := `
+		SELECT pc.id, pc.promo_id, pc.code, pc.activations, pc.max_count
+		FROM promo_code pc
+		WHERE pc.promo_id = :promo_id
+		ORDER BY pc.code
+	`
+
+	params := map[string]interface{}{
+		"promo_id": promoId,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return fmt.Errorf("storage.promo_code.ExportCodes: %w", err)
+	}
+	defer rows.Close()
+
+	var promoCode model.PromoCode
+
+	for rows.Next() {
+		if err = rows.StructScan(&promoCode); err != nil {
+			return fmt.Errorf("storage.promo_code.ExportCodes: %w", err)
+		}
+
+		if err = fn(&promoCode); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("storage.promo_code.ExportCodes: %w", err)
+	}
+
+	return nil
+}
+
+// ExportActivations передаёт в fn активации промокода в хронологическом порядке, читая строки из курсора по одной.
+// Ошибка fn прерывает выгрузку и возвращается как есть
+func (r *Repository) ExportActivations(ctx context.Context, promoId string, fn func(activation *model.PromoActivation) error) error {
+	query := `
+		SELECT pc.code, pa.user_id, pa.activated_at
+		FROM promo_activation pa
+		JOIN promo_code pc ON pc.id = pa.promo_code_id
+		WHERE pa.promo_id = :promo_id
+		ORDER BY pa.activated_at, pa.id
+	`
+
+	params := map[string]interface{}{
+		"promo_id": promoId,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return fmt.Errorf("storage.promo_code.ExportActivations: %w", err)
+	}
+	defer rows.Close()
+
+	var activation model.PromoActivation
+
+	for rows.Next() {
+		if err = rows.StructScan(&activation); err != nil {
+			return fmt.Errorf("storage.promo_code.ExportActivations: %w", err)
+		}
+
+		if err = fn(&activation); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("storage.promo_code.ExportActivations: %w", err)
+	}
+
+	return nil
+}
+
+// ListUserActivations возвращает все активации пользователя в хронологическом порядке,
+// включая активации удалённых промокодов
+func (r *Repository) ListUserActivations(ctx context.Context, userId string) (activations []model.UserActivation, err error) {
+	query := `
+		SELECT pa.promo_id, p.company_id, pc.code, p.description, pa.activated_at
+		FROM promo_activation pa
+		JOIN promo_code pc ON pc.id = pa.promo_code_id
+		JOIN promo p ON p.id = pa.promo_id
+		WHERE pa.user_id = :user_id
+		ORDER BY pa.activated_at, pa.id
+	`
+
+	params := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return nil, fmt.Errorf("storage.promo_code.ListUserActivations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var activation model.UserActivation
+		if err = rows.StructScan(&activation); err != nil {
+			return nil, fmt.Errorf("storage.promo_code.ListUserActivations: %w", err)
+		}
+
+		activations = append(activations, activation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("storage.promo_code.ListUserActivations: %w", err)
+	}
+
+	return activations, nil
+}
+
+// AnonymizeActivations отвязывает активации от пользователя. Сами активации остаются,
+// чтобы не менять статистику промокодов и счётчики общих лимитов
+func (r *Repository) AnonymizeActivations(ctx context.Context, userId string) (anonymized int64, err error) {
+	query := `
+		UPDATE promo_activation SET user_id = NULL
+		WHERE user_id = :user_id
+	`
+
+	params := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	result, err := r.db.NamedExecContext(ctx, query, params)
+	if err != nil {
+		return 0, fmt.Errorf("storage.promo_code.AnonymizeActivations: %w", err)
+	}
+
+	anonymized, err = result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("storage.promo_code.AnonymizeActivations: %w", err)
 	}
 
-	return code, nil
+	return anonymized, nil
 }
@@ -3,9 +3,14 @@ package redis
 import "fmt"
 
 const (
-	promoKey = "promoId_%s"
+	promoKey       = "promoId_%s"
+	idempotencyKey = "idempotency_%s"
 )
 
 func GetPromoKey(promoId string) string {
 	return fmt.Sprintf(promoKey, promoId)
 }
+
+func GetIdempotencyKey(scope string) string {
+	return fmt.Sprintf(idempotencyKey, scope)
+}
@@ -0,0 +1,295 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	webhookdto "gitlab.com/pisya-dev/promo-code-service/internal/domain/dto/webhook"
+	webhookenum "gitlab.com/pisya-dev/promo-code-service/internal/domain/enum/webhook"
+	"gitlab.com/pisya-dev/promo-code-service/internal/storage/model"
+)
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) CreateEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (id string, err error) {
+
+	const op = "storage.webhook.CreateEndpoint"
+
+	query := `
+		INSERT INTO webhook_endpoint(company_id, url, secret, events, created_at)
+		VALUES (:company_id, :url, :secret, :events, :created_at)
+		RETURNING id
+	`
+
+	stmt, err := r.db.PrepareNamedContext(ctx, query)
+	if err != nil {
+		return "", fmt.Errorf("%s: prepare failed: %w", op, err)
+	}
+
+	err = stmt.QueryRowxContext(ctx, endpoint).Scan(&id)
+
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) ListEndpoints(ctx context.Context, companyId string) (endpoints []model.WebhookEndpoint, err error) {
+
+	query := `
+		select id, company_id, url, secret, events, created_at
+		from webhook_endpoint
+		where company_id = $1 and deleted_at is null
+		order by created_at
+	`
+
+	err = r.db.SelectContext(ctx, &endpoints, query, companyId)
+
+	if err != nil {
+		return nil, fmt.Errorf("storage.webhook.ListEndpoints: %w", err)
+	}
+
+	return endpoints, nil
+}
+
+// DeleteEndpoint помечает адрес удалённым. Недоставленные события этого адреса переносятся в dead-letter
+func (r *Repository) DeleteEndpoint(ctx context.Context, endpointId string, companyId string) (deleted bool, err error) {
+	query := `
+		with endpoint as (
+			update webhook_endpoint set deleted_at = now()
+			where id = :endpoint_id and company_id = :company_id and deleted_at is null
+			returning id
+		), dead as (
+			update webhook_delivery d set status = :dead, last_error = 'endpoint deleted'
+			from endpoint
+			where d.endpoint_id = endpoint.id and d.status = :pending
+		)
+		select count(1) from endpoint
+	`
+
+	sqlParams := map[string]interface{}{
+		"endpoint_id": endpointId,
+		"company_id":  companyId,
+		"dead":        webhookenum.DeliveryStatusDead,
+		"pending":     webhookenum.DeliveryStatusPending,
+	}
+
+	stmt, err := r.db.PrepareNamedContext(ctx, query)
+	if err != nil {
+		return false, fmt.Errorf("db.PrepareNamedContext: prepare failed: %w", err)
+	}
+
+	var count int
+	err = stmt.QueryRowxContext(ctx, sqlParams).Scan(&count)
+
+	if err != nil {
+		return false, fmt.Errorf("stmt.QueryRowxContext: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Enqueue создаёт по доставке на каждый адрес компании, подписанный на событие
+func (r *Repository) Enqueue(ctx context.Context, companyId string, event webhookenum.Event, payload model.JSON) (enqueued int, err error) {
+	query := `
+		insert into webhook_delivery(endpoint_id, company_id, event, payload)
+		select id, company_id, :event, :payload
+		from webhook_endpoint
+		where company_id = :company_id and deleted_at is null and :event = any(events)
+	`
+
+	sqlParams := map[string]interface{}{
+		"company_id": companyId,
+		"event":      event,
+		"payload":    payload,
+	}
+
+	result, err := r.db.NamedExecContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return 0, fmt.Errorf("r.db.NamedExecContext: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return 0, fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+// ScrubUser удаляет идентификатор пользователя из событий promo.activated, в том числе уже доставленных
+func (r *Repository) ScrubUser(ctx context.Context, userId string) (scrubbed int, err error) {
+	query := `
+		update webhook_delivery set payload = payload #- '{data,user_id}'
+		where event = :event and payload -> 'data' ->> 'user_id' = :user_id
+	`
+
+	sqlParams := map[string]interface{}{
+		"event":   webhookenum.EventPromoActivated,
+		"user_id": userId,
+	}
+
+	result, err := r.db.NamedExecContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return 0, fmt.Errorf("r.db.NamedExecContext: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return 0, fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+// ClaimDue выбирает доставки, время попытки которых наступило, и откладывает их до leaseUntil,
+// чтобы параллельно работающие экземпляры сервиса не отправили одно событие дважды
+func (r *Repository) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) (deliveries []model.DueWebhookDelivery, err error) {
+	query := `
+		with due as (
+			select id from webhook_delivery
+			where status = :pending and next_attempt_at <= :now
+			order by next_attempt_at
+			limit :limit
+			for update skip locked
+		)
+		update webhook_delivery d set next_attempt_at = :lease_until
+		from due, webhook_endpoint e
+		where d.id = due.id and e.id = d.endpoint_id
+		returning d.id, d.event, d.payload, d.attempts, e.url, e.secret
+	`
+
+	sqlParams := map[string]interface{}{
+		"pending":     webhookenum.DeliveryStatusPending,
+		"now":         now,
+		"lease_until": leaseUntil,
+		"limit":       limit,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("storage.webhook.ClaimDue: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var delivery model.DueWebhookDelivery
+		if err = rows.StructScan(&delivery); err != nil {
+			return nil, fmt.Errorf("storage.webhook.ClaimDue: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// RecordAttempt сохраняет результат попытки доставки
+func (r *Repository) RecordAttempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	query := `
+		update webhook_delivery set
+			status = :status,
+			attempts = :attempts,
+			last_status_code = :last_status_code,
+			last_error = :last_error,
+			next_attempt_at = :next_attempt_at,
+			delivered_at = :delivered_at
+		where id = :id
+	`
+
+	_, err := r.db.NamedExecContext(ctx, query, delivery)
+
+	if err != nil {
+		return fmt.Errorf("r.db.NamedExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListDeliveries(ctx context.Context, filter webhookdto.Filter, offset int, limit int) (deliveries []model.WebhookDelivery, err error) {
+
+	condition, sqlParams := filterCondition(filter)
+
+	query := `
+		select id, endpoint_id, company_id, event, payload, status, attempts, last_status_code, last_error,
+			next_attempt_at, created_at, delivered_at
+		from webhook_delivery
+		where ` + condition + `
+		order by created_at desc, id
+		offset :offset limit :limit
+	`
+
+	sqlParams["offset"] = offset
+	sqlParams["limit"] = limit
+
+	rows, err := r.db.NamedQueryContext(ctx, query, sqlParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("storage.webhook.ListDeliveries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var delivery model.WebhookDelivery
+		if err = rows.StructScan(&delivery); err != nil {
+			return nil, fmt.Errorf("storage.webhook.ListDeliveries: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func (r *Repository) CountDeliveries(ctx context.Context, filter webhookdto.Filter) (count int, err error) {
+
+	condition, sqlParams := filterCondition(filter)
+
+	query := `select count(1) from webhook_delivery where ` + condition
+
+	stmt, err := r.db.PrepareNamedContext(ctx, query)
+
+	if err != nil {
+		return 0, fmt.Errorf("db.PrepareNamedContext: prepare failed: %w", err)
+	}
+
+	err = stmt.QueryRowxContext(ctx, sqlParams).Scan(&count)
+
+	if err != nil {
+		return 0, fmt.Errorf("stmt.QueryRowxContext: %w", err)
+	}
+
+	return count, nil
+}
+
+func filterCondition(filter webhookdto.Filter) (string, map[string]interface{}) {
+	condition := "company_id = :company_id"
+
+	sqlParams := map[string]interface{}{
+		"company_id": filter.CompanyId,
+	}
+
+	if filter.EndpointId != "" {
+		condition += " and endpoint_id = :endpoint_id"
+		sqlParams["endpoint_id"] = filter.EndpointId
+	}
+
+	if filter.Status != "" {
+		condition += " and status = :status"
+		sqlParams["status"] = filter.Status
+	}
+
+	return condition, sqlParams
+}
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job один проход фоновой задачи, возвращает число обработанных записей
+type Job func(ctx context.Context) (processed int, err error)
+
+// Worker выполняет Job сразу после запуска и затем раз в interval, пока не отменён контекст
+type Worker struct {
+	log      *zap.Logger
+	job      Job
+	interval time.Duration
+}
+
+func New(log *zap.Logger, name string, job Job, interval time.Duration) *Worker {
+	return &Worker{
+		log:      log.With(zap.String("worker", name)),
+		job:      job,
+		interval: interval,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) tick(ctx context.Context) {
+	processed, err := w.job(ctx)
+	if err != nil {
+		w.log.Error("worker job failed", zap.Error(err))
+		return
+	}
+
+	if processed > 0 {
+		w.log.Info("worker job done", zap.Int("count", processed))
+	}
+}
@@ -0,0 +1,9 @@
+drop index if exists promo_description_trgm_idx;
+
+drop index if exists promo_search_vector_idx;
+
+drop trigger if exists promo_search_vector_trigger on promo;
+
+drop function if exists promo_search_vector_update();
+
+alter table promo drop column if exists search_vector;
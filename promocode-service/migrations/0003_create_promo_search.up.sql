@@ -0,0 +1,30 @@
+create extension if not exists pg_trgm;
+
+alter table promo add column if not exists search_vector tsvector;
+
+create or replace function promo_search_vector_update() returns trigger as
+$$
+begin
+    new.search_vector :=
+            setweight(to_tsvector('simple', coalesce(new.description, '')), 'A') ||
+            setweight(to_tsvector('simple', coalesce(array_to_string(new.target_categories, ' '), '')), 'B');
+    return new;
+end
+$$ language plpgsql;
+
+drop trigger if exists promo_search_vector_trigger on promo;
+
+create trigger promo_search_vector_trigger
+    before insert or update of description, target_categories
+    on promo
+    for each row
+execute function promo_search_vector_update();
+
+update promo
+set search_vector =
+        setweight(to_tsvector('simple', coalesce(description, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(array_to_string(target_categories, ' '), '')), 'B');
+
+create index if not exists promo_search_vector_idx on promo using gin (search_vector);
+
+create index if not exists promo_description_trgm_idx on promo using gin (description gin_trgm_ops);
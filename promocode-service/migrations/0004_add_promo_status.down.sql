@@ -0,0 +1,5 @@
+drop index if exists promo_status_idx;
+
+alter table promo drop column if exists status_changed_at;
+
+alter table promo drop column if exists status;
@@ -0,0 +1,19 @@
+alter table promo
+    add column if not exists status varchar not null default 'draft'
+        check (status in ('draft', 'scheduled', 'active', 'paused', 'exhausted', 'expired', 'archived'));
+
+alter table promo add column if not exists status_changed_at timestamptz;
+
+update promo p
+set status            = case
+                            when p.active_until is not null and p.active_until < now() then 'expired'
+                            when not exists (select 1
+                                             from promo_code pc
+                                             where pc.promo_id = p.id
+                                               and pc.activations < pc.max_count) then 'exhausted'
+                            when p.active_from is not null and p.active_from > now() then 'scheduled'
+                            else 'active'
+    end,
+    status_changed_at = now();
+
+create index if not exists promo_status_idx on promo (status);
@@ -0,0 +1,3 @@
+drop index if exists promo_deleted_at_idx;
+
+alter table promo drop column if exists deleted_at;
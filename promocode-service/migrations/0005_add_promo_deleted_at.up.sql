@@ -0,0 +1,3 @@
+alter table promo add column if not exists deleted_at timestamptz;
+
+create index if not exists promo_deleted_at_idx on promo (deleted_at) where deleted_at is not null;
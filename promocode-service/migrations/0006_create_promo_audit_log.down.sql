@@ -0,0 +1,5 @@
+drop trigger if exists promo_audit_log_append_only_trigger on promo_audit_log;
+
+drop function if exists promo_audit_log_append_only();
+
+drop table if exists promo_audit_log;
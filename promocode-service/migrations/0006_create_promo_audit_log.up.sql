@@ -0,0 +1,27 @@
+create table if not exists promo_audit_log
+(
+    id         uuid primary key not null,
+    promo_id   uuid             not null,
+    company_id varchar          not null,
+    actor_id   varchar          not null,
+    operation  varchar          not null,
+    changes    jsonb            not null default '{}',
+    created_at timestamptz      not null default now()
+);
+
+create index if not exists promo_audit_log_promo_id_created_at_idx on promo_audit_log (promo_id, created_at desc);
+
+create or replace function promo_audit_log_append_only() returns trigger as
+$$
+begin
+    raise exception 'promo_audit_log is append-only';
+end
+$$ language plpgsql;
+
+drop trigger if exists promo_audit_log_append_only_trigger on promo_audit_log;
+
+create trigger promo_audit_log_append_only_trigger
+    before update or delete
+    on promo_audit_log
+    for each row
+execute function promo_audit_log_append_only();
@@ -0,0 +1,3 @@
+drop index if exists promo_code_code_idx;
+
+alter table promo drop column if exists reward;
@@ -0,0 +1,5 @@
+alter table promo
+    add column if not exists reward jsonb
+        check (reward is null or reward ->> 'type' in ('percent', 'fixed_amount', 'free_item', 'free_shipping'));
+
+create index if not exists promo_code_code_idx on promo_code (code);
@@ -0,0 +1,5 @@
+alter table promo drop column if exists priority;
+
+alter table promo drop column if exists exclusive;
+
+alter table promo drop column if exists stacking_group;
@@ -0,0 +1,5 @@
+alter table promo add column if not exists stacking_group varchar not null default '';
+
+alter table promo add column if not exists exclusive boolean not null default false;
+
+alter table promo add column if not exists priority int not null default 0 check (priority >= 0);
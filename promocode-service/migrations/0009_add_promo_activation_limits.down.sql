@@ -0,0 +1,3 @@
+drop table if exists promo_activation;
+
+alter table promo drop column if exists activation_limits;
@@ -0,0 +1,15 @@
+alter table promo
+    add column if not exists activation_limits jsonb not null default '[]'
+        check (jsonb_typeof(activation_limits) = 'array');
+
+create table if not exists promo_activation
+(
+    id            uuid primary key not null default uuid_generate_v4(),
+    promo_id      uuid             not null,
+    promo_code_id uuid             not null,
+    user_id       varchar,
+    activated_at  timestamptz      not null default now()
+);
+
+create index if not exists promo_activation_promo_id_activated_at_idx on promo_activation (promo_id, activated_at);
+create index if not exists promo_activation_promo_id_user_id_activated_at_idx on promo_activation (promo_id, user_id, activated_at);
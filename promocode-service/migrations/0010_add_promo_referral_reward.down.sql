@@ -0,0 +1 @@
+alter table promo drop column if exists referral_reward;
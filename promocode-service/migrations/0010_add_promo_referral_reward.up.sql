@@ -0,0 +1,3 @@
+alter table promo
+    add column if not exists referral_reward jsonb
+        check (referral_reward is null or (referral_reward ->> 'amount')::bigint > 0);
@@ -0,0 +1,31 @@
+create table if not exists webhook_endpoint
+(
+    id         uuid primary key not null default uuid_generate_v4(),
+    company_id varchar          not null,
+    url        varchar          not null,
+    secret     varchar          not null,
+    events     varchar[]        not null,
+    created_at timestamptz      not null default now(),
+    deleted_at timestamptz
+);
+
+create index if not exists webhook_endpoint_company_id_idx on webhook_endpoint (company_id) where deleted_at is null;
+
+create table if not exists webhook_delivery
+(
+    id               uuid primary key not null default uuid_generate_v4(),
+    endpoint_id      uuid             not null references webhook_endpoint (id) on delete cascade,
+    company_id       varchar          not null,
+    event            varchar          not null,
+    payload          jsonb            not null,
+    status           varchar          not null default 'pending' check (status in ('pending', 'delivered', 'dead')),
+    attempts         int              not null default 0,
+    last_status_code int              not null default 0,
+    last_error       varchar          not null default '',
+    next_attempt_at  timestamptz      not null default now(),
+    created_at       timestamptz      not null default now(),
+    delivered_at     timestamptz
+);
+
+create index if not exists webhook_delivery_due_idx on webhook_delivery (next_attempt_at) where status = 'pending';
+create index if not exists webhook_delivery_company_id_created_at_idx on webhook_delivery (company_id, created_at desc);
@@ -0,0 +1,21 @@
+create or replace function promo_search_vector_update() returns trigger as
+$$
+begin
+    new.search_vector :=
+            setweight(to_tsvector('simple', coalesce(new.description, '')), 'A') ||
+            setweight(to_tsvector('simple', coalesce(array_to_string(new.target_categories, ' '), '')), 'B');
+    return new;
+end
+$$ language plpgsql;
+
+drop trigger if exists promo_search_vector_trigger on promo;
+
+create trigger promo_search_vector_trigger
+    before insert or update of description, target_categories
+    on promo
+    for each row
+execute function promo_search_vector_update();
+
+alter table promo
+    drop column if exists description_translations,
+    drop column if exists default_locale;
@@ -0,0 +1,24 @@
+alter table promo
+    add column if not exists default_locale varchar(35) not null default '',
+    add column if not exists description_translations jsonb not null default '{}'
+        check (jsonb_typeof(description_translations) = 'object');
+
+create or replace function promo_search_vector_update() returns trigger as
+$$
+begin
+    new.search_vector :=
+            setweight(to_tsvector('simple', coalesce(new.description, '')), 'A') ||
+            setweight(to_tsvector('simple', coalesce((select string_agg(value, ' ')
+                                                      from jsonb_each_text(new.description_translations)), '')), 'A') ||
+            setweight(to_tsvector('simple', coalesce(array_to_string(new.target_categories, ' '), '')), 'B');
+    return new;
+end
+$$ language plpgsql;
+
+drop trigger if exists promo_search_vector_trigger on promo;
+
+create trigger promo_search_vector_trigger
+    before insert or update of description, description_translations, target_categories
+    on promo
+    for each row
+execute function promo_search_vector_update();
@@ -0,0 +1 @@
+drop index if exists promo_activation_user_id_idx;
@@ -0,0 +1 @@
+create index if not exists promo_activation_user_id_idx on promo_activation (user_id) where user_id is not null;
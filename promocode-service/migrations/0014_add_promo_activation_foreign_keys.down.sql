@@ -0,0 +1,3 @@
+alter table promo_activation
+    drop constraint if exists promo_activation_promo_code_id_fkey,
+    drop constraint if exists promo_activation_promo_id_fkey;
@@ -0,0 +1,8 @@
+delete
+from promo_activation pa
+where not exists (select 1 from promo p where p.id = pa.promo_id)
+   or not exists (select 1 from promo_code pc where pc.id = pa.promo_code_id);
+
+alter table promo_activation
+    add constraint promo_activation_promo_id_fkey foreign key (promo_id) references promo (id) on delete cascade,
+    add constraint promo_activation_promo_code_id_fkey foreign key (promo_code_id) references promo_code (id) on delete cascade;
@@ -0,0 +1,2 @@
+alter table promo_audit_log
+    drop column if exists actor_user_id;
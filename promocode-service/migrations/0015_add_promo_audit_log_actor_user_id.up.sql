@@ -0,0 +1,2 @@
+alter table promo_audit_log
+    add column if not exists actor_user_id varchar not null default '';
@@ -7,7 +7,6 @@
 package promopb
 
 import (
-	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -121,6 +120,7 @@ const (
 	Reason_OK                  Reason = 0
 	Reason_ANTIFRAUD           Reason = 1
 	Reason_NO_ACTIVATIONS_LEFT Reason = 2
+	Reason_PROMO_NOT_ACTIVE    Reason = 3
 )
 
 // Enum value maps for Reason.
@@ -129,11 +129,13 @@ var (
 		0: "OK",
 		1: "ANTIFRAUD",
 		2: "NO_ACTIVATIONS_LEFT",
+		3: "PROMO_NOT_ACTIVE",
 	}
 	Reason_value = map[string]int32{
 		"OK":                  0,
 		"ANTIFRAUD":           1,
 		"NO_ACTIVATIONS_LEFT": 2,
+		"PROMO_NOT_ACTIVE":    3,
 	}
 )
 
@@ -164,27 +166,3648 @@ func (Reason) EnumDescriptor() ([]byte, []int) {
 	return file_promo_proto_rawDescGZIP(), []int{2}
 }
 
+type PromoStatus int32
+
+const (
+	PromoStatus_DRAFT     PromoStatus = 0
+	PromoStatus_SCHEDULED PromoStatus = 1
+	PromoStatus_ACTIVE    PromoStatus = 2
+	PromoStatus_PAUSED    PromoStatus = 3
+	PromoStatus_EXHAUSTED PromoStatus = 4
+	PromoStatus_EXPIRED   PromoStatus = 5
+	PromoStatus_ARCHIVED  PromoStatus = 6
+)
+
+// Enum value maps for PromoStatus.
+var (
+	PromoStatus_name = map[int32]string{
+		0: "DRAFT",
+		1: "SCHEDULED",
+		2: "ACTIVE",
+		3: "PAUSED",
+		4: "EXHAUSTED",
+		5: "EXPIRED",
+		6: "ARCHIVED",
+	}
+	PromoStatus_value = map[string]int32{
+		"DRAFT":     0,
+		"SCHEDULED": 1,
+		"ACTIVE":    2,
+		"PAUSED":    3,
+		"EXHAUSTED": 4,
+		"EXPIRED":   5,
+		"ARCHIVED":  6,
+	}
+)
+
+func (x PromoStatus) Enum() *PromoStatus {
+	p := new(PromoStatus)
+	*p = x
+	return p
+}
+
+func (x PromoStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromoStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[3].Descriptor()
+}
+
+func (PromoStatus) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[3]
+}
+
+func (x PromoStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromoStatus.Descriptor instead.
+func (PromoStatus) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{3}
+}
+
+type AuditOperation int32
+
+const (
+	AuditOperation_CREATE        AuditOperation = 0
+	AuditOperation_UPDATE        AuditOperation = 1
+	AuditOperation_DELETE        AuditOperation = 2
+	AuditOperation_RESTORE       AuditOperation = 3
+	AuditOperation_PUBLISH       AuditOperation = 4
+	AuditOperation_PAUSE         AuditOperation = 5
+	AuditOperation_RESUME        AuditOperation = 6
+	AuditOperation_ARCHIVE       AuditOperation = 7
+	AuditOperation_STATUS_CHANGE AuditOperation = 8
+	AuditOperation_PURGE         AuditOperation = 9
+)
+
+// Enum value maps for AuditOperation.
+var (
+	AuditOperation_name = map[int32]string{
+		0: "CREATE",
+		1: "UPDATE",
+		2: "DELETE",
+		3: "RESTORE",
+		4: "PUBLISH",
+		5: "PAUSE",
+		6: "RESUME",
+		7: "ARCHIVE",
+		8: "STATUS_CHANGE",
+		9: "PURGE",
+	}
+	AuditOperation_value = map[string]int32{
+		"CREATE":        0,
+		"UPDATE":        1,
+		"DELETE":        2,
+		"RESTORE":       3,
+		"PUBLISH":       4,
+		"PAUSE":         5,
+		"RESUME":        6,
+		"ARCHIVE":       7,
+		"STATUS_CHANGE": 8,
+		"PURGE":         9,
+	}
+)
+
+func (x AuditOperation) Enum() *AuditOperation {
+	p := new(AuditOperation)
+	*p = x
+	return p
+}
+
+func (x AuditOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[4].Descriptor()
+}
+
+func (AuditOperation) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[4]
+}
+
+func (x AuditOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditOperation.Descriptor instead.
+func (AuditOperation) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{4}
+}
+
+type RewardType int32
+
+const (
+	RewardType_PERCENT       RewardType = 0
+	RewardType_FIXED_AMOUNT  RewardType = 1
+	RewardType_FREE_ITEM     RewardType = 2
+	RewardType_FREE_SHIPPING RewardType = 3
+)
+
+// Enum value maps for RewardType.
+var (
+	RewardType_name = map[int32]string{
+		0: "PERCENT",
+		1: "FIXED_AMOUNT",
+		2: "FREE_ITEM",
+		3: "FREE_SHIPPING",
+	}
+	RewardType_value = map[string]int32{
+		"PERCENT":       0,
+		"FIXED_AMOUNT":  1,
+		"FREE_ITEM":     2,
+		"FREE_SHIPPING": 3,
+	}
+)
+
+func (x RewardType) Enum() *RewardType {
+	p := new(RewardType)
+	*p = x
+	return p
+}
+
+func (x RewardType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RewardType) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[5].Descriptor()
+}
+
+func (RewardType) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[5]
+}
+
+func (x RewardType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RewardType.Descriptor instead.
+func (RewardType) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{5}
+}
+
+type LimitScope int32
+
+const (
+	LimitScope_LIMIT_GLOBAL   LimitScope = 0
+	LimitScope_LIMIT_PER_USER LimitScope = 1
+)
+
+// Enum value maps for LimitScope.
+var (
+	LimitScope_name = map[int32]string{
+		0: "LIMIT_GLOBAL",
+		1: "LIMIT_PER_USER",
+	}
+	LimitScope_value = map[string]int32{
+		"LIMIT_GLOBAL":   0,
+		"LIMIT_PER_USER": 1,
+	}
+)
+
+func (x LimitScope) Enum() *LimitScope {
+	p := new(LimitScope)
+	*p = x
+	return p
+}
+
+func (x LimitScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LimitScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[6].Descriptor()
+}
+
+func (LimitScope) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[6]
+}
+
+func (x LimitScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LimitScope.Descriptor instead.
+func (LimitScope) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{6}
+}
+
+type LimitWindow int32
+
+const (
+	LimitWindow_WINDOW_LIFETIME LimitWindow = 0
+	LimitWindow_WINDOW_DAY      LimitWindow = 1
+	LimitWindow_WINDOW_WEEK     LimitWindow = 2
+	LimitWindow_WINDOW_MONTH    LimitWindow = 3
+)
+
+// Enum value maps for LimitWindow.
+var (
+	LimitWindow_name = map[int32]string{
+		0: "WINDOW_LIFETIME",
+		1: "WINDOW_DAY",
+		2: "WINDOW_WEEK",
+		3: "WINDOW_MONTH",
+	}
+	LimitWindow_value = map[string]int32{
+		"WINDOW_LIFETIME": 0,
+		"WINDOW_DAY":      1,
+		"WINDOW_WEEK":     2,
+		"WINDOW_MONTH":    3,
+	}
+)
+
+func (x LimitWindow) Enum() *LimitWindow {
+	p := new(LimitWindow)
+	*p = x
+	return p
+}
+
+func (x LimitWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LimitWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[7].Descriptor()
+}
+
+func (LimitWindow) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[7]
+}
+
+func (x LimitWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LimitWindow.Descriptor instead.
+func (LimitWindow) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{7}
+}
+
+type QuoteRejectReason int32
+
+const (
+	QuoteRejectReason_REJECT_NONE                    QuoteRejectReason = 0
+	QuoteRejectReason_REJECT_CODE_NOT_FOUND          QuoteRejectReason = 1
+	QuoteRejectReason_REJECT_PROMO_NOT_ACTIVE        QuoteRejectReason = 2
+	QuoteRejectReason_REJECT_NO_ACTIVATIONS_LEFT     QuoteRejectReason = 3
+	QuoteRejectReason_REJECT_NO_REWARD               QuoteRejectReason = 4
+	QuoteRejectReason_REJECT_CURRENCY_MISMATCH       QuoteRejectReason = 5
+	QuoteRejectReason_REJECT_MIN_ORDER_NOT_MET       QuoteRejectReason = 6
+	QuoteRejectReason_REJECT_NO_ELIGIBLE_ITEMS       QuoteRejectReason = 7
+	QuoteRejectReason_REJECT_FREE_ITEM_NOT_IN_CART   QuoteRejectReason = 8
+	QuoteRejectReason_REJECT_DUPLICATE_CODE          QuoteRejectReason = 9
+	QuoteRejectReason_REJECT_EXCLUSIVE_CONFLICT      QuoteRejectReason = 10
+	QuoteRejectReason_REJECT_STACKING_GROUP_CONFLICT QuoteRejectReason = 11
+)
+
+// Enum value maps for QuoteRejectReason.
+var (
+	QuoteRejectReason_name = map[int32]string{
+		0:  "REJECT_NONE",
+		1:  "REJECT_CODE_NOT_FOUND",
+		2:  "REJECT_PROMO_NOT_ACTIVE",
+		3:  "REJECT_NO_ACTIVATIONS_LEFT",
+		4:  "REJECT_NO_REWARD",
+		5:  "REJECT_CURRENCY_MISMATCH",
+		6:  "REJECT_MIN_ORDER_NOT_MET",
+		7:  "REJECT_NO_ELIGIBLE_ITEMS",
+		8:  "REJECT_FREE_ITEM_NOT_IN_CART",
+		9:  "REJECT_DUPLICATE_CODE",
+		10: "REJECT_EXCLUSIVE_CONFLICT",
+		11: "REJECT_STACKING_GROUP_CONFLICT",
+	}
+	QuoteRejectReason_value = map[string]int32{
+		"REJECT_NONE":                    0,
+		"REJECT_CODE_NOT_FOUND":          1,
+		"REJECT_PROMO_NOT_ACTIVE":        2,
+		"REJECT_NO_ACTIVATIONS_LEFT":     3,
+		"REJECT_NO_REWARD":               4,
+		"REJECT_CURRENCY_MISMATCH":       5,
+		"REJECT_MIN_ORDER_NOT_MET":       6,
+		"REJECT_NO_ELIGIBLE_ITEMS":       7,
+		"REJECT_FREE_ITEM_NOT_IN_CART":   8,
+		"REJECT_DUPLICATE_CODE":          9,
+		"REJECT_EXCLUSIVE_CONFLICT":      10,
+		"REJECT_STACKING_GROUP_CONFLICT": 11,
+	}
+)
+
+func (x QuoteRejectReason) Enum() *QuoteRejectReason {
+	p := new(QuoteRejectReason)
+	*p = x
+	return p
+}
+
+func (x QuoteRejectReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuoteRejectReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[8].Descriptor()
+}
+
+func (QuoteRejectReason) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[8]
+}
+
+func (x QuoteRejectReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuoteRejectReason.Descriptor instead.
+func (QuoteRejectReason) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{8}
+}
+
+type WebhookEvent int32
+
+const (
+	WebhookEvent_WEBHOOK_PROMO_ACTIVATED WebhookEvent = 0
+	WebhookEvent_WEBHOOK_PROMO_EXHAUSTED WebhookEvent = 1
+	WebhookEvent_WEBHOOK_PROMO_EXPIRED   WebhookEvent = 2
+)
+
+// Enum value maps for WebhookEvent.
+var (
+	WebhookEvent_name = map[int32]string{
+		0: "WEBHOOK_PROMO_ACTIVATED",
+		1: "WEBHOOK_PROMO_EXHAUSTED",
+		2: "WEBHOOK_PROMO_EXPIRED",
+	}
+	WebhookEvent_value = map[string]int32{
+		"WEBHOOK_PROMO_ACTIVATED": 0,
+		"WEBHOOK_PROMO_EXHAUSTED": 1,
+		"WEBHOOK_PROMO_EXPIRED":   2,
+	}
+)
+
+func (x WebhookEvent) Enum() *WebhookEvent {
+	p := new(WebhookEvent)
+	*p = x
+	return p
+}
+
+func (x WebhookEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[9].Descriptor()
+}
+
+func (WebhookEvent) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[9]
+}
+
+func (x WebhookEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEvent.Descriptor instead.
+func (WebhookEvent) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{9}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_DELIVERY_PENDING   WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_DELIVERY_DELIVERED WebhookDeliveryStatus = 1
+	// доставка исчерпала попытки и перенесена в dead-letter
+	WebhookDeliveryStatus_DELIVERY_DEAD WebhookDeliveryStatus = 2
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_PENDING",
+		1: "DELIVERY_DELIVERED",
+		2: "DELIVERY_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"DELIVERY_PENDING":   0,
+		"DELIVERY_DELIVERED": 1,
+		"DELIVERY_DEAD":      2,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[10].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[10]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{10}
+}
+
+type CodeSymbology int32
+
+const (
+	CodeSymbology_SYMBOLOGY_QR      CodeSymbology = 0
+	CodeSymbology_SYMBOLOGY_CODE128 CodeSymbology = 1
+	// только для кодов из 12 или 13 цифр
+	CodeSymbology_SYMBOLOGY_EAN13 CodeSymbology = 2
+)
+
+// Enum value maps for CodeSymbology.
+var (
+	CodeSymbology_name = map[int32]string{
+		0: "SYMBOLOGY_QR",
+		1: "SYMBOLOGY_CODE128",
+		2: "SYMBOLOGY_EAN13",
+	}
+	CodeSymbology_value = map[string]int32{
+		"SYMBOLOGY_QR":      0,
+		"SYMBOLOGY_CODE128": 1,
+		"SYMBOLOGY_EAN13":   2,
+	}
+)
+
+func (x CodeSymbology) Enum() *CodeSymbology {
+	p := new(CodeSymbology)
+	*p = x
+	return p
+}
+
+func (x CodeSymbology) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CodeSymbology) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[11].Descriptor()
+}
+
+func (CodeSymbology) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[11]
+}
+
+func (x CodeSymbology) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CodeSymbology.Descriptor instead.
+func (CodeSymbology) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{11}
+}
+
+type CodeImageFormat int32
+
+const (
+	CodeImageFormat_IMAGE_PNG CodeImageFormat = 0
+	CodeImageFormat_IMAGE_SVG CodeImageFormat = 1
+)
+
+// Enum value maps for CodeImageFormat.
+var (
+	CodeImageFormat_name = map[int32]string{
+		0: "IMAGE_PNG",
+		1: "IMAGE_SVG",
+	}
+	CodeImageFormat_value = map[string]int32{
+		"IMAGE_PNG": 0,
+		"IMAGE_SVG": 1,
+	}
+)
+
+func (x CodeImageFormat) Enum() *CodeImageFormat {
+	p := new(CodeImageFormat)
+	*p = x
+	return p
+}
+
+func (x CodeImageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CodeImageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[12].Descriptor()
+}
+
+func (CodeImageFormat) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[12]
+}
+
+func (x CodeImageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CodeImageFormat.Descriptor instead.
+func (CodeImageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{12}
+}
+
+type QrErrorCorrection int32
+
+const (
+	QrErrorCorrection_QR_EC_L QrErrorCorrection = 0
+	QrErrorCorrection_QR_EC_M QrErrorCorrection = 1
+	QrErrorCorrection_QR_EC_Q QrErrorCorrection = 2
+	QrErrorCorrection_QR_EC_H QrErrorCorrection = 3
+)
+
+// Enum value maps for QrErrorCorrection.
+var (
+	QrErrorCorrection_name = map[int32]string{
+		0: "QR_EC_L",
+		1: "QR_EC_M",
+		2: "QR_EC_Q",
+		3: "QR_EC_H",
+	}
+	QrErrorCorrection_value = map[string]int32{
+		"QR_EC_L": 0,
+		"QR_EC_M": 1,
+		"QR_EC_Q": 2,
+		"QR_EC_H": 3,
+	}
+)
+
+func (x QrErrorCorrection) Enum() *QrErrorCorrection {
+	p := new(QrErrorCorrection)
+	*p = x
+	return p
+}
+
+func (x QrErrorCorrection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QrErrorCorrection) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[13].Descriptor()
+}
+
+func (QrErrorCorrection) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[13]
+}
+
+func (x QrErrorCorrection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QrErrorCorrection.Descriptor instead.
+func (QrErrorCorrection) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{13}
+}
+
+type ExportDataset int32
+
+const (
+	// коды с числом активаций
+	ExportDataset_EXPORT_CODES ExportDataset = 0
+	// записи об отдельных активациях
+	ExportDataset_EXPORT_ACTIVATIONS ExportDataset = 1
+)
+
+// Enum value maps for ExportDataset.
+var (
+	ExportDataset_name = map[int32]string{
+		0: "EXPORT_CODES",
+		1: "EXPORT_ACTIVATIONS",
+	}
+	ExportDataset_value = map[string]int32{
+		"EXPORT_CODES":       0,
+		"EXPORT_ACTIVATIONS": 1,
+	}
+)
+
+func (x ExportDataset) Enum() *ExportDataset {
+	p := new(ExportDataset)
+	*p = x
+	return p
+}
+
+func (x ExportDataset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportDataset) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[14].Descriptor()
+}
+
+func (ExportDataset) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[14]
+}
+
+func (x ExportDataset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportDataset.Descriptor instead.
+func (ExportDataset) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{14}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_CSV  ExportFormat = 0
+	ExportFormat_EXPORT_XLSX ExportFormat = 1
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_CSV",
+		1: "EXPORT_XLSX",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_CSV":  0,
+		"EXPORT_XLSX": 1,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_proto_enumTypes[15].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_promo_proto_enumTypes[15]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{15}
+}
+
 type PromoPingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PromoPingRequest) Reset() {
-	*x = PromoPingRequest{}
-	mi := &file_promo_proto_msgTypes[0]
+func (x *PromoPingRequest) Reset() {
+	*x = PromoPingRequest{}
+	mi := &file_promo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoPingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoPingRequest) ProtoMessage() {}
+
+func (x *PromoPingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoPingRequest.ProtoReflect.Descriptor instead.
+func (*PromoPingRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{0}
+}
+
+type PromoPingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoPingResponse) Reset() {
+	*x = PromoPingResponse{}
+	mi := &file_promo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoPingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoPingResponse) ProtoMessage() {}
+
+func (x *PromoPingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoPingResponse.ProtoReflect.Descriptor instead.
+func (*PromoPingResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PromoPingResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type CreatePromoRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Mode             Mode                   `protobuf:"varint,1,opt,name=mode,proto3,enum=api.Mode" json:"mode,omitempty"`
+	CompanyId        *string                `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoCommon      *string                `protobuf:"bytes,3,opt,name=promo_common,json=promoCommon,proto3,oneof" json:"promo_common,omitempty"`
+	PromoUnique      []string               `protobuf:"bytes,4,rep,name=promo_unique,json=promoUnique,proto3" json:"promo_unique,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl         *string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Target           *Target                `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	MaxCount         int64                  `protobuf:"varint,8,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	ActiveFrom       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	ActiveUntil      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	Draft            *bool                  `protobuf:"varint,11,opt,name=draft,proto3,oneof" json:"draft,omitempty"`
+	Reward           *Reward                `protobuf:"bytes,12,opt,name=reward,proto3,oneof" json:"reward,omitempty"`
+	Stacking         *Stacking              `protobuf:"bytes,13,opt,name=stacking,proto3,oneof" json:"stacking,omitempty"`
+	ActivationLimits []*ActivationLimit     `protobuf:"bytes,14,rep,name=activation_limits,json=activationLimits,proto3" json:"activation_limits,omitempty"`
+	ReferralReward   *ReferralReward        `protobuf:"bytes,15,opt,name=referral_reward,json=referralReward,proto3,oneof" json:"referral_reward,omitempty"`
+	Localization     *Localization          `protobuf:"bytes,16,opt,name=localization,proto3,oneof" json:"localization,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreatePromoRequest) Reset() {
+	*x = CreatePromoRequest{}
+	mi := &file_promo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoRequest) ProtoMessage() {}
+
+func (x *CreatePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePromoRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_COMMON
+}
+
+func (x *CreatePromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreatePromoRequest) GetPromoCommon() string {
+	if x != nil && x.PromoCommon != nil {
+		return *x.PromoCommon
+	}
+	return ""
+}
+
+func (x *CreatePromoRequest) GetPromoUnique() []string {
+	if x != nil {
+		return x.PromoUnique
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePromoRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreatePromoRequest) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *CreatePromoRequest) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetDraft() bool {
+	if x != nil && x.Draft != nil {
+		return *x.Draft
+	}
+	return false
+}
+
+func (x *CreatePromoRequest) GetReward() *Reward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetStacking() *Stacking {
+	if x != nil {
+		return x.Stacking
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetActivationLimits() []*ActivationLimit {
+	if x != nil {
+		return x.ActivationLimits
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetReferralReward() *ReferralReward {
+	if x != nil {
+		return x.ReferralReward
+	}
+	return nil
+}
+
+func (x *CreatePromoRequest) GetLocalization() *Localization {
+	if x != nil {
+		return x.Localization
+	}
+	return nil
+}
+
+type CreatePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoResponse) Reset() {
+	*x = CreatePromoResponse{}
+	mi := &file_promo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoResponse) ProtoMessage() {}
+
+func (x *CreatePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePromoResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int64                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	SortBy        *PromoSortBy           `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=api.PromoSortBy,oneof" json:"sort_by,omitempty"`
+	Countries     []string               `protobuf:"bytes,5,rep,name=countries,proto3" json:"countries,omitempty"`
+	Query         *string                `protobuf:"bytes,6,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoRequest) Reset() {
+	*x = ListPromoRequest{}
+	mi := &file_promo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoRequest) ProtoMessage() {}
+
+func (x *ListPromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *ListPromoRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListPromoRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *ListPromoRequest) GetSortBy() PromoSortBy {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return PromoSortBy_ACTIVE_FROM
+}
+
+func (x *ListPromoRequest) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *ListPromoRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+type ListPromoFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *int64                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int64                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Category      *string                `protobuf:"bytes,3,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Query         *string                `protobuf:"bytes,4,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoFeedRequest) Reset() {
+	*x = ListPromoFeedRequest{}
+	mi := &file_promo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoFeedRequest) ProtoMessage() {}
+
+func (x *ListPromoFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoFeedRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoFeedRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPromoFeedRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListPromoFeedRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *ListPromoFeedRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ListPromoFeedRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+type ListPromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	XTotalCount   int64                  `protobuf:"varint,1,opt,name=x_total_count,json=xTotalCount,proto3" json:"x_total_count,omitempty"`
+	Promo         []*Promo               `protobuf:"bytes,2,rep,name=promo,proto3" json:"promo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoResponse) Reset() {
+	*x = ListPromoResponse{}
+	mi := &file_promo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoResponse) ProtoMessage() {}
+
+func (x *ListPromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPromoResponse) GetXTotalCount() int64 {
+	if x != nil {
+		return x.XTotalCount
+	}
+	return 0
+}
+
+func (x *ListPromoResponse) GetPromo() []*Promo {
+	if x != nil {
+		return x.Promo
+	}
+	return nil
+}
+
+type GetPromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromoRequest) Reset() {
+	*x = GetPromoRequest{}
+	mi := &file_promo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoRequest) ProtoMessage() {}
+
+func (x *GetPromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoRequest.ProtoReflect.Descriptor instead.
+func (*GetPromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetPromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+type GetPromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promo         *Promo                 `protobuf:"bytes,1,opt,name=promo,proto3" json:"promo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromoResponse) Reset() {
+	*x = GetPromoResponse{}
+	mi := &file_promo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoResponse) ProtoMessage() {}
+
+func (x *GetPromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoResponse.ProtoReflect.Descriptor instead.
+func (*GetPromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPromoResponse) GetPromo() *Promo {
+	if x != nil {
+		return x.Promo
+	}
+	return nil
+}
+
+type UpdatePromoRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId        *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId          string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl         string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Target           *Target                `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	MaxCount         int64                  `protobuf:"varint,6,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	ActiveFrom       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	ActiveUntil      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	Reward           *Reward                `protobuf:"bytes,9,opt,name=reward,proto3,oneof" json:"reward,omitempty"`
+	Stacking         *Stacking              `protobuf:"bytes,10,opt,name=stacking,proto3,oneof" json:"stacking,omitempty"`
+	ActivationLimits *ActivationLimits      `protobuf:"bytes,11,opt,name=activation_limits,json=activationLimits,proto3,oneof" json:"activation_limits,omitempty"`
+	ReferralReward   *ReferralReward        `protobuf:"bytes,12,opt,name=referral_reward,json=referralReward,proto3,oneof" json:"referral_reward,omitempty"`
+	// если не передано, переводы остаются прежними, иначе заменяются целиком
+	Localization  *Localization `protobuf:"bytes,13,opt,name=localization,proto3,oneof" json:"localization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromoRequest) Reset() {
+	*x = UpdatePromoRequest{}
+	mi := &file_promo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromoRequest) ProtoMessage() {}
+
+func (x *UpdatePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromoRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *UpdatePromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *UpdatePromoRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePromoRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdatePromoRequest) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *UpdatePromoRequest) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *UpdatePromoRequest) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *UpdatePromoRequest) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *UpdatePromoRequest) GetReward() *Reward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *UpdatePromoRequest) GetStacking() *Stacking {
+	if x != nil {
+		return x.Stacking
+	}
+	return nil
+}
+
+func (x *UpdatePromoRequest) GetActivationLimits() *ActivationLimits {
+	if x != nil {
+		return x.ActivationLimits
+	}
+	return nil
+}
+
+func (x *UpdatePromoRequest) GetReferralReward() *ReferralReward {
+	if x != nil {
+		return x.ReferralReward
+	}
+	return nil
+}
+
+func (x *UpdatePromoRequest) GetLocalization() *Localization {
+	if x != nil {
+		return x.Localization
+	}
+	return nil
+}
+
+type UpdatePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromoResponse) Reset() {
+	*x = UpdatePromoResponse{}
+	mi := &file_promo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromoResponse) ProtoMessage() {}
+
+func (x *UpdatePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromoResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{10}
+}
+
+type DeletePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoRequest) Reset() {
+	*x = DeletePromoRequest{}
+	mi := &file_promo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoRequest) ProtoMessage() {}
+
+func (x *DeletePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeletePromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *DeletePromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+type DeletePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoResponse) Reset() {
+	*x = DeletePromoResponse{}
+	mi := &file_promo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoResponse) ProtoMessage() {}
+
+func (x *DeletePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{12}
+}
+
+type RestorePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePromoRequest) Reset() {
+	*x = RestorePromoRequest{}
+	mi := &file_promo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePromoRequest) ProtoMessage() {}
+
+func (x *RestorePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePromoRequest.ProtoReflect.Descriptor instead.
+func (*RestorePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestorePromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *RestorePromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+type RestorePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePromoResponse) Reset() {
+	*x = RestorePromoResponse{}
+	mi := &file_promo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePromoResponse) ProtoMessage() {}
+
+func (x *RestorePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePromoResponse.ProtoReflect.Descriptor instead.
+func (*RestorePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{14}
+}
+
+type SetPromoImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromoImageRequest) Reset() {
+	*x = SetPromoImageRequest{}
+	mi := &file_promo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromoImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromoImageRequest) ProtoMessage() {}
+
+func (x *SetPromoImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromoImageRequest.ProtoReflect.Descriptor instead.
+func (*SetPromoImageRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetPromoImageRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *SetPromoImageRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *SetPromoImageRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type SetPromoImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromoImageResponse) Reset() {
+	*x = SetPromoImageResponse{}
+	mi := &file_promo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromoImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromoImageResponse) ProtoMessage() {}
+
+func (x *SetPromoImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromoImageResponse.ProtoReflect.Descriptor instead.
+func (*SetPromoImageResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{16}
+}
+
+type ActivatePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoId       string                 `protobuf:"bytes,1,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	UserId        *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivatePromoRequest) Reset() {
+	*x = ActivatePromoRequest{}
+	mi := &file_promo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivatePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivatePromoRequest) ProtoMessage() {}
+
+func (x *ActivatePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivatePromoRequest.ProtoReflect.Descriptor instead.
+func (*ActivatePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ActivatePromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *ActivatePromoRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type ActivatePromoResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	SuccessActivation bool                   `protobuf:"varint,2,opt,name=success_activation,json=successActivation,proto3" json:"success_activation,omitempty"`
+	Reason            Reason                 `protobuf:"varint,3,opt,name=reason,proto3,enum=api.Reason" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ActivatePromoResponse) Reset() {
+	*x = ActivatePromoResponse{}
+	mi := &file_promo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivatePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivatePromoResponse) ProtoMessage() {}
+
+func (x *ActivatePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivatePromoResponse.ProtoReflect.Descriptor instead.
+func (*ActivatePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActivatePromoResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ActivatePromoResponse) GetSuccessActivation() bool {
+	if x != nil {
+		return x.SuccessActivation
+	}
+	return false
+}
+
+func (x *ActivatePromoResponse) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_OK
+}
+
+type RenderPromoCodeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PromoId string                 `protobuf:"bytes,1,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// код конкретной активации пользователя, по умолчанию последняя
+	Code      *string         `protobuf:"bytes,3,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	Symbology CodeSymbology   `protobuf:"varint,4,opt,name=symbology,proto3,enum=api.CodeSymbology" json:"symbology,omitempty"`
+	Format    CodeImageFormat `protobuf:"varint,5,opt,name=format,proto3,enum=api.CodeImageFormat" json:"format,omitempty"`
+	// ширина изображения в пикселях, по умолчанию 256
+	Size *int64 `protobuf:"varint,6,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	// только для QR-кода, по умолчанию M
+	ErrorCorrection *QrErrorCorrection `protobuf:"varint,7,opt,name=error_correction,json=errorCorrection,proto3,enum=api.QrErrorCorrection,oneof" json:"error_correction,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RenderPromoCodeRequest) Reset() {
+	*x = RenderPromoCodeRequest{}
+	mi := &file_promo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderPromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderPromoCodeRequest) ProtoMessage() {}
+
+func (x *RenderPromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderPromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*RenderPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenderPromoCodeRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *RenderPromoCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RenderPromoCodeRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *RenderPromoCodeRequest) GetSymbology() CodeSymbology {
+	if x != nil {
+		return x.Symbology
+	}
+	return CodeSymbology_SYMBOLOGY_QR
+}
+
+func (x *RenderPromoCodeRequest) GetFormat() CodeImageFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CodeImageFormat_IMAGE_PNG
+}
+
+func (x *RenderPromoCodeRequest) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *RenderPromoCodeRequest) GetErrorCorrection() QrErrorCorrection {
+	if x != nil && x.ErrorCorrection != nil {
+		return *x.ErrorCorrection
+	}
+	return QrErrorCorrection_QR_EC_L
+}
+
+type RenderPromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Image         []byte                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderPromoCodeResponse) Reset() {
+	*x = RenderPromoCodeResponse{}
+	mi := &file_promo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderPromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderPromoCodeResponse) ProtoMessage() {}
+
+func (x *RenderPromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderPromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*RenderPromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RenderPromoCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RenderPromoCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RenderPromoCodeResponse) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ExportPromoDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	Dataset       ExportDataset          `protobuf:"varint,3,opt,name=dataset,proto3,enum=api.ExportDataset" json:"dataset,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=api.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPromoDataRequest) Reset() {
+	*x = ExportPromoDataRequest{}
+	mi := &file_promo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPromoDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPromoDataRequest) ProtoMessage() {}
+
+func (x *ExportPromoDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPromoDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportPromoDataRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportPromoDataRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *ExportPromoDataRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *ExportPromoDataRequest) GetDataset() ExportDataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return ExportDataset_EXPORT_CODES
+}
+
+func (x *ExportPromoDataRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_CSV
+}
+
+// первый чанк содержит content_type и filename, последующие только данные
+type ExportPromoDataChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   *string                `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	Filename      *string                `protobuf:"bytes,2,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPromoDataChunk) Reset() {
+	*x = ExportPromoDataChunk{}
+	mi := &file_promo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPromoDataChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPromoDataChunk) ProtoMessage() {}
+
+func (x *ExportPromoDataChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPromoDataChunk.ProtoReflect.Descriptor instead.
+func (*ExportPromoDataChunk) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportPromoDataChunk) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportPromoDataChunk) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
+	}
+	return ""
+}
+
+func (x *ExportPromoDataChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PublishPromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPromoRequest) Reset() {
+	*x = PublishPromoRequest{}
+	mi := &file_promo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPromoRequest) ProtoMessage() {}
+
+func (x *PublishPromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPromoRequest.ProtoReflect.Descriptor instead.
+func (*PublishPromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PublishPromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *PublishPromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+type PublishPromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        PromoStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=api.PromoStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPromoResponse) Reset() {
+	*x = PublishPromoResponse{}
+	mi := &file_promo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPromoResponse) ProtoMessage() {}
+
+func (x *PublishPromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPromoResponse.ProtoReflect.Descriptor instead.
+func (*PublishPromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PublishPromoResponse) GetStatus() PromoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PromoStatus_DRAFT
+}
+
+type PausePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PausePromoRequest) Reset() {
+	*x = PausePromoRequest{}
+	mi := &file_promo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PausePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePromoRequest) ProtoMessage() {}
+
+func (x *PausePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePromoRequest.ProtoReflect.Descriptor instead.
+func (*PausePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PausePromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *PausePromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+type PausePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        PromoStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=api.PromoStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PausePromoResponse) Reset() {
+	*x = PausePromoResponse{}
+	mi := &file_promo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PausePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePromoResponse) ProtoMessage() {}
+
+func (x *PausePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePromoResponse.ProtoReflect.Descriptor instead.
+func (*PausePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PausePromoResponse) GetStatus() PromoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PromoStatus_DRAFT
+}
+
+type ResumePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumePromoRequest) Reset() {
+	*x = ResumePromoRequest{}
+	mi := &file_promo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePromoRequest) ProtoMessage() {}
+
+func (x *ResumePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePromoRequest.ProtoReflect.Descriptor instead.
+func (*ResumePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResumePromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *ResumePromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+type ResumePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        PromoStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=api.PromoStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumePromoResponse) Reset() {
+	*x = ResumePromoResponse{}
+	mi := &file_promo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePromoResponse) ProtoMessage() {}
+
+func (x *ResumePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePromoResponse.ProtoReflect.Descriptor instead.
+func (*ResumePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResumePromoResponse) GetStatus() PromoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PromoStatus_DRAFT
+}
+
+type ArchivePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePromoRequest) Reset() {
+	*x = ArchivePromoRequest{}
+	mi := &file_promo_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePromoRequest) ProtoMessage() {}
+
+func (x *ArchivePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePromoRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePromoRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ArchivePromoRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *ArchivePromoRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+type ArchivePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        PromoStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=api.PromoStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePromoResponse) Reset() {
+	*x = ArchivePromoResponse{}
+	mi := &file_promo_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePromoResponse) ProtoMessage() {}
+
+func (x *ArchivePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePromoResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePromoResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ArchivePromoResponse) GetStatus() PromoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PromoStatus_DRAFT
+}
+
+type ListPromoAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int64                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Operation     *AuditOperation        `protobuf:"varint,5,opt,name=operation,proto3,enum=api.AuditOperation,oneof" json:"operation,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoAuditLogRequest) Reset() {
+	*x = ListPromoAuditLogRequest{}
+	mi := &file_promo_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoAuditLogRequest) ProtoMessage() {}
+
+func (x *ListPromoAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListPromoAuditLogRequest) GetCompanyId() string {
+	if x != nil && x.CompanyId != nil {
+		return *x.CompanyId
+	}
+	return ""
+}
+
+func (x *ListPromoAuditLogRequest) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *ListPromoAuditLogRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListPromoAuditLogRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *ListPromoAuditLogRequest) GetOperation() AuditOperation {
+	if x != nil && x.Operation != nil {
+		return *x.Operation
+	}
+	return AuditOperation_CREATE
+}
+
+func (x *ListPromoAuditLogRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListPromoAuditLogRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type ListPromoAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	XTotalCount   int64                  `protobuf:"varint,1,opt,name=x_total_count,json=xTotalCount,proto3" json:"x_total_count,omitempty"`
+	Entries       []*AuditLogEntry       `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoAuditLogResponse) Reset() {
+	*x = ListPromoAuditLogResponse{}
+	mi := &file_promo_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoAuditLogResponse) ProtoMessage() {}
+
+func (x *ListPromoAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPromoAuditLogResponse) GetXTotalCount() int64 {
+	if x != nil {
+		return x.XTotalCount
+	}
+	return 0
+}
+
+func (x *ListPromoAuditLogResponse) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type AuditLogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Operation     AuditOperation         `protobuf:"varint,4,opt,name=operation,proto3,enum=api.AuditOperation" json:"operation,omitempty"`
+	Changes       string                 `protobuf:"bytes,5,opt,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,7,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_promo_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AuditLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetOperation() AuditOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return AuditOperation_CREATE
+}
+
+func (x *AuditLogEntry) GetChanges() string {
+	if x != nil {
+		return x.Changes
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+type Reward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          RewardType             `protobuf:"varint,1,opt,name=type,proto3,enum=api.RewardType" json:"type,omitempty"`
+	Percent       *float64               `protobuf:"fixed64,2,opt,name=percent,proto3,oneof" json:"percent,omitempty"`
+	Amount        *int64                 `protobuf:"varint,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Currency      *string                `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	ItemSku       *string                `protobuf:"bytes,5,opt,name=item_sku,json=itemSku,proto3,oneof" json:"item_sku,omitempty"`
+	Conditions    *RewardConditions      `protobuf:"bytes,6,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reward) Reset() {
+	*x = Reward{}
+	mi := &file_promo_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reward) ProtoMessage() {}
+
+func (x *Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
+func (*Reward) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Reward) GetType() RewardType {
+	if x != nil {
+		return x.Type
+	}
+	return RewardType_PERCENT
+}
+
+func (x *Reward) GetPercent() float64 {
+	if x != nil && x.Percent != nil {
+		return *x.Percent
+	}
+	return 0
+}
+
+func (x *Reward) GetAmount() int64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *Reward) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *Reward) GetItemSku() string {
+	if x != nil && x.ItemSku != nil {
+		return *x.ItemSku
+	}
+	return ""
+}
+
+func (x *Reward) GetConditions() *RewardConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type RewardConditions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MinOrderAmount     *int64                 `protobuf:"varint,1,opt,name=min_order_amount,json=minOrderAmount,proto3,oneof" json:"min_order_amount,omitempty"`
+	EligibleCategories []string               `protobuf:"bytes,2,rep,name=eligible_categories,json=eligibleCategories,proto3" json:"eligible_categories,omitempty"`
+	MaxDiscountAmount  *int64                 `protobuf:"varint,3,opt,name=max_discount_amount,json=maxDiscountAmount,proto3,oneof" json:"max_discount_amount,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RewardConditions) Reset() {
+	*x = RewardConditions{}
+	mi := &file_promo_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardConditions) ProtoMessage() {}
+
+func (x *RewardConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardConditions.ProtoReflect.Descriptor instead.
+func (*RewardConditions) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RewardConditions) GetMinOrderAmount() int64 {
+	if x != nil && x.MinOrderAmount != nil {
+		return *x.MinOrderAmount
+	}
+	return 0
+}
+
+func (x *RewardConditions) GetEligibleCategories() []string {
+	if x != nil {
+		return x.EligibleCategories
+	}
+	return nil
+}
+
+func (x *RewardConditions) GetMaxDiscountAmount() int64 {
+	if x != nil && x.MaxDiscountAmount != nil {
+		return *x.MaxDiscountAmount
+	}
+	return 0
+}
+
+type CartItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Category      *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	UnitPrice     int64                  `protobuf:"varint,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_promo_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CartItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CartItem) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *CartItem) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Cart struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Currency       string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Items          []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ShippingAmount int64                  `protobuf:"varint,3,opt,name=shipping_amount,json=shippingAmount,proto3" json:"shipping_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Cart) Reset() {
+	*x = Cart{}
+	mi := &file_promo_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart) ProtoMessage() {}
+
+func (x *Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Cart) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Cart) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Cart) GetShippingAmount() int64 {
+	if x != nil {
+		return x.ShippingAmount
+	}
+	return 0
+}
+
+type QuoteDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Cart          *Cart                  `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteDiscountRequest) Reset() {
+	*x = QuoteDiscountRequest{}
+	mi := &file_promo_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDiscountRequest) ProtoMessage() {}
+
+func (x *QuoteDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDiscountRequest.ProtoReflect.Descriptor instead.
+func (*QuoteDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QuoteDiscountRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *QuoteDiscountRequest) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type QuoteDiscountResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Applicable     bool                   `protobuf:"varint,1,opt,name=applicable,proto3" json:"applicable,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,2,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	PromoId        *string                `protobuf:"bytes,4,opt,name=promo_id,json=promoId,proto3,oneof" json:"promo_id,omitempty"`
+	RewardType     *RewardType            `protobuf:"varint,5,opt,name=reward_type,json=rewardType,proto3,enum=api.RewardType,oneof" json:"reward_type,omitempty"`
+	RejectReason   QuoteRejectReason      `protobuf:"varint,6,opt,name=reject_reason,json=rejectReason,proto3,enum=api.QuoteRejectReason" json:"reject_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuoteDiscountResponse) Reset() {
+	*x = QuoteDiscountResponse{}
+	mi := &file_promo_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDiscountResponse) ProtoMessage() {}
+
+func (x *QuoteDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDiscountResponse.ProtoReflect.Descriptor instead.
+func (*QuoteDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QuoteDiscountResponse) GetApplicable() bool {
+	if x != nil {
+		return x.Applicable
+	}
+	return false
+}
+
+func (x *QuoteDiscountResponse) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *QuoteDiscountResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *QuoteDiscountResponse) GetPromoId() string {
+	if x != nil && x.PromoId != nil {
+		return *x.PromoId
+	}
+	return ""
+}
+
+func (x *QuoteDiscountResponse) GetRewardType() RewardType {
+	if x != nil && x.RewardType != nil {
+		return *x.RewardType
+	}
+	return RewardType_PERCENT
+}
+
+func (x *QuoteDiscountResponse) GetRejectReason() QuoteRejectReason {
+	if x != nil {
+		return x.RejectReason
+	}
+	return QuoteRejectReason_REJECT_NONE
+}
+
+type Stacking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *string                `protobuf:"bytes,1,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Exclusive     bool                   `protobuf:"varint,2,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	Priority      int64                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stacking) Reset() {
+	*x = Stacking{}
+	mi := &file_promo_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stacking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stacking) ProtoMessage() {}
+
+func (x *Stacking) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stacking.ProtoReflect.Descriptor instead.
+func (*Stacking) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Stacking) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *Stacking) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *Stacking) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type ActivationLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         LimitScope             `protobuf:"varint,1,opt,name=scope,proto3,enum=api.LimitScope" json:"scope,omitempty"`
+	Window        LimitWindow            `protobuf:"varint,2,opt,name=window,proto3,enum=api.LimitWindow" json:"window,omitempty"`
+	Max           int64                  `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivationLimit) Reset() {
+	*x = ActivationLimit{}
+	mi := &file_promo_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivationLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivationLimit) ProtoMessage() {}
+
+func (x *ActivationLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivationLimit.ProtoReflect.Descriptor instead.
+func (*ActivationLimit) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ActivationLimit) GetScope() LimitScope {
+	if x != nil {
+		return x.Scope
+	}
+	return LimitScope_LIMIT_GLOBAL
+}
+
+func (x *ActivationLimit) GetWindow() LimitWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LimitWindow_WINDOW_LIFETIME
+}
+
+func (x *ActivationLimit) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ActivationLimits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        []*ActivationLimit     `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivationLimits) Reset() {
+	*x = ActivationLimits{}
+	mi := &file_promo_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivationLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivationLimits) ProtoMessage() {}
+
+func (x *ActivationLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivationLimits.ProtoReflect.Descriptor instead.
+func (*ActivationLimits) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ActivationLimits) GetLimits() []*ActivationLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// награда пригласившему за первую активацию приглашённым пользователем промокода компании
+type ReferralReward struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// сумма в минимальных единицах валюты
+	Amount        int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferralReward) Reset() {
+	*x = ReferralReward{}
+	mi := &file_promo_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferralReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferralReward) ProtoMessage() {}
+
+func (x *ReferralReward) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferralReward.ProtoReflect.Descriptor instead.
+func (*ReferralReward) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReferralReward) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReferralReward) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ResolveApplicablePromosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codes         []string               `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveApplicablePromosRequest) Reset() {
+	*x = ResolveApplicablePromosRequest{}
+	mi := &file_promo_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveApplicablePromosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveApplicablePromosRequest) ProtoMessage() {}
+
+func (x *ResolveApplicablePromosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveApplicablePromosRequest.ProtoReflect.Descriptor instead.
+func (*ResolveApplicablePromosRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResolveApplicablePromosRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type ResolveApplicablePromosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       []*AppliedPromo        `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	Rejected      []*RejectedPromoCode   `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveApplicablePromosResponse) Reset() {
+	*x = ResolveApplicablePromosResponse{}
+	mi := &file_promo_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveApplicablePromosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveApplicablePromosResponse) ProtoMessage() {}
+
+func (x *ResolveApplicablePromosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveApplicablePromosResponse.ProtoReflect.Descriptor instead.
+func (*ResolveApplicablePromosResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ResolveApplicablePromosResponse) GetApplied() []*AppliedPromo {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *ResolveApplicablePromosResponse) GetRejected() []*RejectedPromoCode {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+type AppliedPromo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Stacking      *Stacking              `protobuf:"bytes,4,opt,name=stacking,proto3" json:"stacking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedPromo) Reset() {
+	*x = AppliedPromo{}
+	mi := &file_promo_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedPromo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedPromo) ProtoMessage() {}
+
+func (x *AppliedPromo) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedPromo.ProtoReflect.Descriptor instead.
+func (*AppliedPromo) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AppliedPromo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AppliedPromo) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *AppliedPromo) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *AppliedPromo) GetStacking() *Stacking {
+	if x != nil {
+		return x.Stacking
+	}
+	return nil
+}
+
+type RejectedPromoCode struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Code               string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	PromoId            *string                `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3,oneof" json:"promo_id,omitempty"`
+	Reason             QuoteRejectReason      `protobuf:"varint,3,opt,name=reason,proto3,enum=api.QuoteRejectReason" json:"reason,omitempty"`
+	ConflictingPromoId *string                `protobuf:"bytes,4,opt,name=conflicting_promo_id,json=conflictingPromoId,proto3,oneof" json:"conflicting_promo_id,omitempty"`
+	Explanation        string                 `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RejectedPromoCode) Reset() {
+	*x = RejectedPromoCode{}
+	mi := &file_promo_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedPromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedPromoCode) ProtoMessage() {}
+
+func (x *RejectedPromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedPromoCode.ProtoReflect.Descriptor instead.
+func (*RejectedPromoCode) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RejectedPromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RejectedPromoCode) GetPromoId() string {
+	if x != nil && x.PromoId != nil {
+		return *x.PromoId
+	}
+	return ""
+}
+
+func (x *RejectedPromoCode) GetReason() QuoteRejectReason {
+	if x != nil {
+		return x.Reason
+	}
+	return QuoteRejectReason_REJECT_NONE
+}
+
+func (x *RejectedPromoCode) GetConflictingPromoId() string {
+	if x != nil && x.ConflictingPromoId != nil {
+		return *x.ConflictingPromoId
+	}
+	return ""
+}
+
+func (x *RejectedPromoCode) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type Target struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgeFrom       *int64                 `protobuf:"varint,1,opt,name=age_from,json=ageFrom,proto3,oneof" json:"age_from,omitempty"`
+	AgeUntil      *int64                 `protobuf:"varint,2,opt,name=age_until,json=ageUntil,proto3,oneof" json:"age_until,omitempty"`
+	Country       *string                `protobuf:"bytes,3,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	Categories    []string               `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	mi := &file_promo_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PromoPingRequest) String() string {
+func (x *Target) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoPingRequest) ProtoMessage() {}
+func (*Target) ProtoMessage() {}
 
-func (x *PromoPingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[0]
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,33 +3818,81 @@ func (x *PromoPingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoPingRequest.ProtoReflect.Descriptor instead.
-func (*PromoPingRequest) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{48}
 }
 
-type PromoPingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+func (x *Target) GetAgeFrom() int64 {
+	if x != nil && x.AgeFrom != nil {
+		return *x.AgeFrom
+	}
+	return 0
+}
+
+func (x *Target) GetAgeUntil() int64 {
+	if x != nil && x.AgeUntil != nil {
+		return *x.AgeUntil
+	}
+	return 0
+}
+
+func (x *Target) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *Target) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type Promo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PromoId          string                 `protobuf:"bytes,1,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	CompanyId        string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CompanyName      string                 `protobuf:"bytes,3,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Mode             Mode                   `protobuf:"varint,4,opt,name=mode,proto3,enum=api.Mode" json:"mode,omitempty"`
+	Codes            []*PromoCode           `protobuf:"bytes,5,rep,name=codes,proto3" json:"codes,omitempty"`
+	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl         *string                `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Target           *Target                `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
+	ActiveFrom       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=active_from,json=activeFrom,proto3,oneof" json:"active_from,omitempty"`
+	ActiveUntil      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=active_until,json=activeUntil,proto3,oneof" json:"active_until,omitempty"`
+	Highlight        *string                `protobuf:"bytes,11,opt,name=highlight,proto3,oneof" json:"highlight,omitempty"`
+	SearchRank       *float64               `protobuf:"fixed64,12,opt,name=search_rank,json=searchRank,proto3,oneof" json:"search_rank,omitempty"`
+	Status           PromoStatus            `protobuf:"varint,13,opt,name=status,proto3,enum=api.PromoStatus" json:"status,omitempty"`
+	Active           bool                   `protobuf:"varint,14,opt,name=active,proto3" json:"active,omitempty"`
+	Reward           *Reward                `protobuf:"bytes,15,opt,name=reward,proto3,oneof" json:"reward,omitempty"`
+	Stacking         *Stacking              `protobuf:"bytes,16,opt,name=stacking,proto3" json:"stacking,omitempty"`
+	ActivationLimits []*ActivationLimit     `protobuf:"bytes,17,rep,name=activation_limits,json=activationLimits,proto3" json:"activation_limits,omitempty"`
+	ReferralReward   *ReferralReward        `protobuf:"bytes,18,opt,name=referral_reward,json=referralReward,proto3,oneof" json:"referral_reward,omitempty"`
+	// язык description, выбранный по accept-language; пустой, если язык описания не указан
+	Locale        *string       `protobuf:"bytes,19,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	Localization  *Localization `protobuf:"bytes,20,opt,name=localization,proto3" json:"localization,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PromoPingResponse) Reset() {
-	*x = PromoPingResponse{}
-	mi := &file_promo_proto_msgTypes[1]
+func (x *Promo) Reset() {
+	*x = Promo{}
+	mi := &file_promo_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PromoPingResponse) String() string {
+func (x *Promo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoPingResponse) ProtoMessage() {}
+func (*Promo) ProtoMessage() {}
 
-func (x *PromoPingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[1]
+func (x *Promo) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,49 +3903,176 @@ func (x *PromoPingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoPingResponse.ProtoReflect.Descriptor instead.
-func (*PromoPingResponse) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Promo.ProtoReflect.Descriptor instead.
+func (*Promo) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *PromoPingResponse) GetOk() bool {
+func (x *Promo) GetPromoId() string {
 	if x != nil {
-		return x.Ok
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *Promo) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *Promo) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *Promo) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_COMMON
+}
+
+func (x *Promo) GetCodes() []*PromoCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *Promo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Promo) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Promo) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Promo) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *Promo) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *Promo) GetHighlight() string {
+	if x != nil && x.Highlight != nil {
+		return *x.Highlight
+	}
+	return ""
+}
+
+func (x *Promo) GetSearchRank() float64 {
+	if x != nil && x.SearchRank != nil {
+		return *x.SearchRank
+	}
+	return 0
+}
+
+func (x *Promo) GetStatus() PromoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PromoStatus_DRAFT
+}
+
+func (x *Promo) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-type CreatePromoRequest struct {
+func (x *Promo) GetReward() *Reward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *Promo) GetStacking() *Stacking {
+	if x != nil {
+		return x.Stacking
+	}
+	return nil
+}
+
+func (x *Promo) GetActivationLimits() []*ActivationLimit {
+	if x != nil {
+		return x.ActivationLimits
+	}
+	return nil
+}
+
+func (x *Promo) GetReferralReward() *ReferralReward {
+	if x != nil {
+		return x.ReferralReward
+	}
+	return nil
+}
+
+func (x *Promo) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *Promo) GetLocalization() *Localization {
+	if x != nil {
+		return x.Localization
+	}
+	return nil
+}
+
+// description промокода написан на языке default_locale, translations содержит описания
+// на других языках с ключами BCP-47
+type Localization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mode          Mode                   `protobuf:"varint,1,opt,name=mode,proto3,enum=api.Mode" json:"mode,omitempty"`
-	CompanyId     *string                `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	PromoCommon   *string                `protobuf:"bytes,3,opt,name=promo_common,json=promoCommon,proto3,oneof" json:"promo_common,omitempty"`
-	PromoUnique   []string               `protobuf:"bytes,4,rep,name=promo_unique,json=promoUnique,proto3" json:"promo_unique,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUrl      *string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Target        *Target                `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
-	MaxCount      int64                  `protobuf:"varint,8,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
-	ActiveFrom    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
-	ActiveUntil   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	DefaultLocale string                 `protobuf:"bytes,1,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`
+	Translations  map[string]string      `protobuf:"bytes,2,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromoRequest) Reset() {
-	*x = CreatePromoRequest{}
-	mi := &file_promo_proto_msgTypes[2]
+func (x *Localization) Reset() {
+	*x = Localization{}
+	mi := &file_promo_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromoRequest) String() string {
+func (x *Localization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePromoRequest) ProtoMessage() {}
+func (*Localization) ProtoMessage() {}
 
-func (x *CreatePromoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[2]
+func (x *Localization) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,103 +4083,110 @@ func (x *CreatePromoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePromoRequest.ProtoReflect.Descriptor instead.
-func (*CreatePromoRequest) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Localization.ProtoReflect.Descriptor instead.
+func (*Localization) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreatePromoRequest) GetMode() Mode {
+func (x *Localization) GetDefaultLocale() string {
 	if x != nil {
-		return x.Mode
-	}
-	return Mode_COMMON
-}
-
-func (x *CreatePromoRequest) GetCompanyId() string {
-	if x != nil && x.CompanyId != nil {
-		return *x.CompanyId
-	}
-	return ""
-}
-
-func (x *CreatePromoRequest) GetPromoCommon() string {
-	if x != nil && x.PromoCommon != nil {
-		return *x.PromoCommon
+		return x.DefaultLocale
 	}
 	return ""
 }
 
-func (x *CreatePromoRequest) GetPromoUnique() []string {
+func (x *Localization) GetTranslations() map[string]string {
 	if x != nil {
-		return x.PromoUnique
+		return x.Translations
 	}
 	return nil
 }
 
-func (x *CreatePromoRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type PromoCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Activations   int64                  `protobuf:"varint,2,opt,name=activations,proto3" json:"activations,omitempty"`
+	MaxCount      int64                  `protobuf:"varint,3,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromoRequest) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_promo_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromoRequest) GetTarget() *Target {
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[51]
 	if x != nil {
-		return x.Target
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePromoRequest) GetMaxCount() int64 {
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PromoCode) GetCode() string {
 	if x != nil {
-		return x.MaxCount
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePromoRequest) GetActiveFrom() *timestamppb.Timestamp {
+func (x *PromoCode) GetActivations() int64 {
 	if x != nil {
-		return x.ActiveFrom
+		return x.Activations
 	}
-	return nil
+	return 0
 }
 
-func (x *CreatePromoRequest) GetActiveUntil() *timestamppb.Timestamp {
+func (x *PromoCode) GetMaxCount() int64 {
 	if x != nil {
-		return x.ActiveUntil
+		return x.MaxCount
 	}
-	return nil
+	return 0
 }
 
-type CreatePromoResponse struct {
+type WebhookEndpoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []WebhookEvent         `protobuf:"varint,3,rep,packed,name=events,proto3,enum=api.WebhookEvent" json:"events,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromoResponse) Reset() {
-	*x = CreatePromoResponse{}
-	mi := &file_promo_proto_msgTypes[3]
+func (x *WebhookEndpoint) Reset() {
+	*x = WebhookEndpoint{}
+	mi := &file_promo_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromoResponse) String() string {
+func (x *WebhookEndpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePromoResponse) ProtoMessage() {}
+func (*WebhookEndpoint) ProtoMessage() {}
 
-func (x *CreatePromoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[3]
+func (x *WebhookEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,44 +4197,63 @@ func (x *CreatePromoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePromoResponse.ProtoReflect.Descriptor instead.
-func (*CreatePromoResponse) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use WebhookEndpoint.ProtoReflect.Descriptor instead.
+func (*WebhookEndpoint) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreatePromoResponse) GetId() string {
+func (x *WebhookEndpoint) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListPromoRequest struct {
+func (x *WebhookEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetEvents() []WebhookEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookEndpoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookEndpointRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	Limit         *int64                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int64                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	SortBy        *PromoSortBy           `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=api.PromoSortBy,oneof" json:"sort_by,omitempty"`
-	Countries     []string               `protobuf:"bytes,5,rep,name=countries,proto3" json:"countries,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []WebhookEvent         `protobuf:"varint,3,rep,packed,name=events,proto3,enum=api.WebhookEvent" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPromoRequest) Reset() {
-	*x = ListPromoRequest{}
-	mi := &file_promo_proto_msgTypes[4]
+func (x *CreateWebhookEndpointRequest) Reset() {
+	*x = CreateWebhookEndpointRequest{}
+	mi := &file_promo_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPromoRequest) String() string {
+func (x *CreateWebhookEndpointRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPromoRequest) ProtoMessage() {}
+func (*CreateWebhookEndpointRequest) ProtoMessage() {}
 
-func (x *ListPromoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[4]
+func (x *CreateWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,69 +4264,56 @@ func (x *ListPromoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPromoRequest.ProtoReflect.Descriptor instead.
-func (*ListPromoRequest) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListPromoRequest) GetCompanyId() string {
+func (x *CreateWebhookEndpointRequest) GetCompanyId() string {
 	if x != nil && x.CompanyId != nil {
 		return *x.CompanyId
 	}
 	return ""
 }
 
-func (x *ListPromoRequest) GetLimit() int64 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
-	}
-	return 0
-}
-
-func (x *ListPromoRequest) GetOffset() int64 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
-	}
-	return 0
-}
-
-func (x *ListPromoRequest) GetSortBy() PromoSortBy {
-	if x != nil && x.SortBy != nil {
-		return *x.SortBy
+func (x *CreateWebhookEndpointRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
-	return PromoSortBy_ACTIVE_FROM
+	return ""
 }
 
-func (x *ListPromoRequest) GetCountries() []string {
+func (x *CreateWebhookEndpointRequest) GetEvents() []WebhookEvent {
 	if x != nil {
-		return x.Countries
+		return x.Events
 	}
 	return nil
 }
 
-type ListPromoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	XTotalCount   int64                  `protobuf:"varint,1,opt,name=x_total_count,json=xTotalCount,proto3" json:"x_total_count,omitempty"`
-	Promo         []*Promo               `protobuf:"bytes,2,rep,name=promo,proto3" json:"promo,omitempty"`
+type CreateWebhookEndpointResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint *WebhookEndpoint       `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// секрет подписи HMAC-SHA256, возвращается только при создании
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPromoResponse) Reset() {
-	*x = ListPromoResponse{}
-	mi := &file_promo_proto_msgTypes[5]
+func (x *CreateWebhookEndpointResponse) Reset() {
+	*x = CreateWebhookEndpointResponse{}
+	mi := &file_promo_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPromoResponse) String() string {
+func (x *CreateWebhookEndpointResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPromoResponse) ProtoMessage() {}
+func (*CreateWebhookEndpointResponse) ProtoMessage() {}
 
-func (x *ListPromoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[5]
+func (x *CreateWebhookEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,48 +4324,47 @@ func (x *ListPromoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPromoResponse.ProtoReflect.Descriptor instead.
-func (*ListPromoResponse) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CreateWebhookEndpointResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListPromoResponse) GetXTotalCount() int64 {
+func (x *CreateWebhookEndpointResponse) GetEndpoint() *WebhookEndpoint {
 	if x != nil {
-		return x.XTotalCount
+		return x.Endpoint
 	}
-	return 0
+	return nil
 }
 
-func (x *ListPromoResponse) GetPromo() []*Promo {
+func (x *CreateWebhookEndpointResponse) GetSecret() string {
 	if x != nil {
-		return x.Promo
+		return x.Secret
 	}
-	return nil
+	return ""
 }
 
-type GetPromoRequest struct {
+type ListWebhookEndpointsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPromoRequest) Reset() {
-	*x = GetPromoRequest{}
-	mi := &file_promo_proto_msgTypes[6]
+func (x *ListWebhookEndpointsRequest) Reset() {
+	*x = ListWebhookEndpointsRequest{}
+	mi := &file_promo_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPromoRequest) String() string {
+func (x *ListWebhookEndpointsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPromoRequest) ProtoMessage() {}
+func (*ListWebhookEndpointsRequest) ProtoMessage() {}
 
-func (x *GetPromoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[6]
+func (x *ListWebhookEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,47 +4375,40 @@ func (x *GetPromoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPromoRequest.ProtoReflect.Descriptor instead.
-func (*GetPromoRequest) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListWebhookEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetPromoRequest) GetCompanyId() string {
+func (x *ListWebhookEndpointsRequest) GetCompanyId() string {
 	if x != nil && x.CompanyId != nil {
 		return *x.CompanyId
 	}
 	return ""
 }
 
-func (x *GetPromoRequest) GetPromoId() string {
-	if x != nil {
-		return x.PromoId
-	}
-	return ""
-}
-
-type GetPromoResponse struct {
+type ListWebhookEndpointsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Promo         *Promo                 `protobuf:"bytes,1,opt,name=promo,proto3" json:"promo,omitempty"`
+	Endpoints     []*WebhookEndpoint     `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPromoResponse) Reset() {
-	*x = GetPromoResponse{}
-	mi := &file_promo_proto_msgTypes[7]
+func (x *ListWebhookEndpointsResponse) Reset() {
+	*x = ListWebhookEndpointsResponse{}
+	mi := &file_promo_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPromoResponse) String() string {
+func (x *ListWebhookEndpointsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPromoResponse) ProtoMessage() {}
+func (*ListWebhookEndpointsResponse) ProtoMessage() {}
 
-func (x *GetPromoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[7]
+func (x *ListWebhookEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,47 +4419,41 @@ func (x *GetPromoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPromoResponse.ProtoReflect.Descriptor instead.
-func (*GetPromoResponse) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListWebhookEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetPromoResponse) GetPromo() *Promo {
+func (x *ListWebhookEndpointsResponse) GetEndpoints() []*WebhookEndpoint {
 	if x != nil {
-		return x.Promo
+		return x.Endpoints
 	}
 	return nil
 }
 
-type UpdatePromoRequest struct {
+type DeleteWebhookEndpointRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Target        *Target                `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
-	MaxCount      int64                  `protobuf:"varint,6,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
-	ActiveFrom    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
-	ActiveUntil   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	EndpointId    string                 `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePromoRequest) Reset() {
-	*x = UpdatePromoRequest{}
-	mi := &file_promo_proto_msgTypes[8]
+func (x *DeleteWebhookEndpointRequest) Reset() {
+	*x = DeleteWebhookEndpointRequest{}
+	mi := &file_promo_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePromoRequest) String() string {
+func (x *DeleteWebhookEndpointRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePromoRequest) ProtoMessage() {}
+func (*DeleteWebhookEndpointRequest) ProtoMessage() {}
 
-func (x *UpdatePromoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[8]
+func (x *DeleteWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,88 +4464,46 @@ func (x *UpdatePromoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePromoRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePromoRequest) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DeleteWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdatePromoRequest) GetCompanyId() string {
+func (x *DeleteWebhookEndpointRequest) GetCompanyId() string {
 	if x != nil && x.CompanyId != nil {
 		return *x.CompanyId
 	}
 	return ""
 }
 
-func (x *UpdatePromoRequest) GetPromoId() string {
-	if x != nil {
-		return x.PromoId
-	}
-	return ""
-}
-
-func (x *UpdatePromoRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *UpdatePromoRequest) GetImageUrl() string {
+func (x *DeleteWebhookEndpointRequest) GetEndpointId() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.EndpointId
 	}
 	return ""
 }
 
-func (x *UpdatePromoRequest) GetTarget() *Target {
-	if x != nil {
-		return x.Target
-	}
-	return nil
-}
-
-func (x *UpdatePromoRequest) GetMaxCount() int64 {
-	if x != nil {
-		return x.MaxCount
-	}
-	return 0
-}
-
-func (x *UpdatePromoRequest) GetActiveFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ActiveFrom
-	}
-	return nil
-}
-
-func (x *UpdatePromoRequest) GetActiveUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ActiveUntil
-	}
-	return nil
-}
-
-type UpdatePromoResponse struct {
+type DeleteWebhookEndpointResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePromoResponse) Reset() {
-	*x = UpdatePromoResponse{}
-	mi := &file_promo_proto_msgTypes[9]
+func (x *DeleteWebhookEndpointResponse) Reset() {
+	*x = DeleteWebhookEndpointResponse{}
+	mi := &file_promo_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePromoResponse) String() string {
+func (x *DeleteWebhookEndpointResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePromoResponse) ProtoMessage() {}
+func (*DeleteWebhookEndpointResponse) ProtoMessage() {}
 
-func (x *UpdatePromoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[9]
+func (x *DeleteWebhookEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,34 +4514,37 @@ func (x *UpdatePromoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePromoResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePromoResponse) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteWebhookEndpointResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{58}
 }
 
-type DeletePromoRequest struct {
+type ListWebhookDeliveriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CompanyId     *string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3,oneof" json:"company_id,omitempty"`
-	PromoId       string                 `protobuf:"bytes,2,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	EndpointId    *string                `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3,oneof" json:"endpoint_id,omitempty"`
+	Status        *WebhookDeliveryStatus `protobuf:"varint,3,opt,name=status,proto3,enum=api.WebhookDeliveryStatus,oneof" json:"status,omitempty"`
+	Limit         *int64                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int64                 `protobuf:"varint,5,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePromoRequest) Reset() {
-	*x = DeletePromoRequest{}
-	mi := &file_promo_proto_msgTypes[10]
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_promo_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePromoRequest) String() string {
+func (x *ListWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePromoRequest) ProtoMessage() {}
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *DeletePromoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[10]
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,46 +4555,69 @@ func (x *DeletePromoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePromoRequest.ProtoReflect.Descriptor instead.
-func (*DeletePromoRequest) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *DeletePromoRequest) GetCompanyId() string {
+func (x *ListWebhookDeliveriesRequest) GetCompanyId() string {
 	if x != nil && x.CompanyId != nil {
 		return *x.CompanyId
 	}
 	return ""
 }
 
-func (x *DeletePromoRequest) GetPromoId() string {
-	if x != nil {
-		return x.PromoId
+func (x *ListWebhookDeliveriesRequest) GetEndpointId() string {
+	if x != nil && x.EndpointId != nil {
+		return *x.EndpointId
 	}
 	return ""
 }
 
-type DeletePromoResponse struct {
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return WebhookDeliveryStatus_DELIVERY_PENDING
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	XTotalCount   int64                  `protobuf:"varint,1,opt,name=x_total_count,json=xTotalCount,proto3" json:"x_total_count,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePromoResponse) Reset() {
-	*x = DeletePromoResponse{}
-	mi := &file_promo_proto_msgTypes[11]
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_promo_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePromoResponse) String() string {
+func (x *ListWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePromoResponse) ProtoMessage() {}
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *DeletePromoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[11]
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,33 +4628,57 @@ func (x *DeletePromoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePromoResponse.ProtoReflect.Descriptor instead.
-func (*DeletePromoResponse) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{60}
 }
 
-type ActivatePromoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PromoId       string                 `protobuf:"bytes,1,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListWebhookDeliveriesResponse) GetXTotalCount() int64 {
+	if x != nil {
+		return x.XTotalCount
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
 }
 
-func (x *ActivatePromoRequest) Reset() {
-	*x = ActivatePromoRequest{}
-	mi := &file_promo_proto_msgTypes[12]
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndpointId     string                 `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Event          WebhookEvent           `protobuf:"varint,3,opt,name=event,proto3,enum=api.WebhookEvent" json:"event,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=api.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts       int64                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode *int64                 `protobuf:"varint,6,opt,name=last_status_code,json=lastStatusCode,proto3,oneof" json:"last_status_code,omitempty"`
+	LastError      *string                `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3,oneof" json:"delivered_at,omitempty"`
+	Payload        string                 `protobuf:"bytes,11,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_promo_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivatePromoRequest) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivatePromoRequest) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *ActivatePromoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[12]
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,103 +4689,110 @@ func (x *ActivatePromoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivatePromoRequest.ProtoReflect.Descriptor instead.
-func (*ActivatePromoRequest) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ActivatePromoRequest) GetPromoId() string {
+func (x *WebhookDelivery) GetId() string {
 	if x != nil {
-		return x.PromoId
+		return x.Id
 	}
 	return ""
 }
 
-type ActivatePromoResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	SuccessActivation bool                   `protobuf:"varint,2,opt,name=success_activation,json=successActivation,proto3" json:"success_activation,omitempty"`
-	Reason            Reason                 `protobuf:"varint,3,opt,name=reason,proto3,enum=api.Reason" json:"reason,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *WebhookDelivery) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
 }
 
-func (x *ActivatePromoResponse) Reset() {
-	*x = ActivatePromoResponse{}
-	mi := &file_promo_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WebhookDelivery) GetEvent() WebhookEvent {
+	if x != nil {
+		return x.Event
+	}
+	return WebhookEvent_WEBHOOK_PROMO_ACTIVATED
 }
 
-func (x *ActivatePromoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_DELIVERY_PENDING
 }
 
-func (*ActivatePromoResponse) ProtoMessage() {}
-
-func (x *ActivatePromoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[13]
+func (x *WebhookDelivery) GetAttempts() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Attempts
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ActivatePromoResponse.ProtoReflect.Descriptor instead.
-func (*ActivatePromoResponse) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{13}
+func (x *WebhookDelivery) GetLastStatusCode() int64 {
+	if x != nil && x.LastStatusCode != nil {
+		return *x.LastStatusCode
+	}
+	return 0
 }
 
-func (x *ActivatePromoResponse) GetCode() string {
-	if x != nil {
-		return x.Code
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
 	}
 	return ""
 }
 
-func (x *ActivatePromoResponse) GetSuccessActivation() bool {
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SuccessActivation
+		return x.NextAttemptAt
 	}
-	return false
+	return nil
 }
 
-func (x *ActivatePromoResponse) GetReason() Reason {
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Reason
+		return x.CreatedAt
 	}
-	return Reason_OK
+	return nil
 }
 
-type Target struct {
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type ForgetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AgeFrom       *int64                 `protobuf:"varint,1,opt,name=age_from,json=ageFrom,proto3,oneof" json:"age_from,omitempty"`
-	AgeUntil      *int64                 `protobuf:"varint,2,opt,name=age_until,json=ageUntil,proto3,oneof" json:"age_until,omitempty"`
-	Country       *string                `protobuf:"bytes,3,opt,name=country,proto3,oneof" json:"country,omitempty"`
-	Categories    []string               `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Target) Reset() {
-	*x = Target{}
-	mi := &file_promo_proto_msgTypes[14]
+func (x *ForgetUserRequest) Reset() {
+	*x = ForgetUserRequest{}
+	mi := &file_promo_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Target) String() string {
+func (x *ForgetUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Target) ProtoMessage() {}
+func (*ForgetUserRequest) ProtoMessage() {}
 
-func (x *Target) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[14]
+func (x *ForgetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,70 +4803,94 @@ func (x *Target) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Target.ProtoReflect.Descriptor instead.
-func (*Target) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ForgetUserRequest.ProtoReflect.Descriptor instead.
+func (*ForgetUserRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *Target) GetAgeFrom() int64 {
-	if x != nil && x.AgeFrom != nil {
-		return *x.AgeFrom
+func (x *ForgetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *Target) GetAgeUntil() int64 {
-	if x != nil && x.AgeUntil != nil {
-		return *x.AgeUntil
+type ForgetUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// активации, отвязанные от пользователя
+	AnonymizedActivations int64 `protobuf:"varint,1,opt,name=anonymized_activations,json=anonymizedActivations,proto3" json:"anonymized_activations,omitempty"`
+	// доставки вебхуков, из которых удалён user_id
+	ScrubbedWebhookDeliveries int64 `protobuf:"varint,2,opt,name=scrubbed_webhook_deliveries,json=scrubbedWebhookDeliveries,proto3" json:"scrubbed_webhook_deliveries,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ForgetUserResponse) Reset() {
+	*x = ForgetUserResponse{}
+	mi := &file_promo_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetUserResponse) ProtoMessage() {}
+
+func (x *ForgetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Target) GetCountry() string {
-	if x != nil && x.Country != nil {
-		return *x.Country
+// Deprecated: Use ForgetUserResponse.ProtoReflect.Descriptor instead.
+func (*ForgetUserResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ForgetUserResponse) GetAnonymizedActivations() int64 {
+	if x != nil {
+		return x.AnonymizedActivations
 	}
-	return ""
+	return 0
 }
 
-func (x *Target) GetCategories() []string {
+func (x *ForgetUserResponse) GetScrubbedWebhookDeliveries() int64 {
 	if x != nil {
-		return x.Categories
+		return x.ScrubbedWebhookDeliveries
 	}
-	return nil
+	return 0
 }
 
-type Promo struct {
+type ExportUserDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PromoId       string                 `protobuf:"bytes,1,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
-	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	CompanyName   string                 `protobuf:"bytes,3,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
-	Mode          Mode                   `protobuf:"varint,4,opt,name=mode,proto3,enum=api.Mode" json:"mode,omitempty"`
-	Codes         []*PromoCode           `protobuf:"bytes,5,rep,name=codes,proto3" json:"codes,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUrl      *string                `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Target        *Target                `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
-	ActiveFrom    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=active_from,json=activeFrom,proto3,oneof" json:"active_from,omitempty"`
-	ActiveUntil   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=active_until,json=activeUntil,proto3,oneof" json:"active_until,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Promo) Reset() {
-	*x = Promo{}
-	mi := &file_promo_proto_msgTypes[15]
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_promo_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Promo) String() string {
+func (x *ExportUserDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Promo) ProtoMessage() {}
+func (*ExportUserDataRequest) ProtoMessage() {}
 
-func (x *Promo) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[15]
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,105 +4901,88 @@ func (x *Promo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Promo.ProtoReflect.Descriptor instead.
-func (*Promo) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *Promo) GetPromoId() string {
-	if x != nil {
-		return x.PromoId
-	}
-	return ""
-}
-
-func (x *Promo) GetCompanyId() string {
-	if x != nil {
-		return x.CompanyId
-	}
-	return ""
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *Promo) GetCompanyName() string {
+func (x *ExportUserDataRequest) GetUserId() string {
 	if x != nil {
-		return x.CompanyName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Promo) GetMode() Mode {
-	if x != nil {
-		return x.Mode
-	}
-	return Mode_COMMON
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activations   []*UserActivation      `protobuf:"bytes,1,rep,name=activations,proto3" json:"activations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Promo) GetCodes() []*PromoCode {
-	if x != nil {
-		return x.Codes
-	}
-	return nil
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_promo_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Promo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Promo) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
-}
+func (*ExportUserDataResponse) ProtoMessage() {}
 
-func (x *Promo) GetTarget() *Target {
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[65]
 	if x != nil {
-		return x.Target
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Promo) GetActiveFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ActiveFrom
-	}
-	return nil
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Promo) GetActiveUntil() *timestamppb.Timestamp {
+func (x *ExportUserDataResponse) GetActivations() []*UserActivation {
 	if x != nil {
-		return x.ActiveUntil
+		return x.Activations
 	}
 	return nil
 }
 
-type PromoCode struct {
+type UserActivation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Activations   int64                  `protobuf:"varint,2,opt,name=activations,proto3" json:"activations,omitempty"`
-	MaxCount      int64                  `protobuf:"varint,3,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	PromoId       string                 `protobuf:"bytes,1,opt,name=promo_id,json=promoId,proto3" json:"promo_id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ActivatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PromoCode) Reset() {
-	*x = PromoCode{}
-	mi := &file_promo_proto_msgTypes[16]
+func (x *UserActivation) Reset() {
+	*x = UserActivation{}
+	mi := &file_promo_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PromoCode) String() string {
+func (x *UserActivation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoCode) ProtoMessage() {}
+func (*UserActivation) ProtoMessage() {}
 
-func (x *PromoCode) ProtoReflect() protoreflect.Message {
-	mi := &file_promo_proto_msgTypes[16]
+func (x *UserActivation) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,30 +4993,44 @@ func (x *PromoCode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
-func (*PromoCode) Descriptor() ([]byte, []int) {
-	return file_promo_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UserActivation.ProtoReflect.Descriptor instead.
+func (*UserActivation) Descriptor() ([]byte, []int) {
+	return file_promo_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *PromoCode) GetCode() string {
+func (x *UserActivation) GetPromoId() string {
+	if x != nil {
+		return x.PromoId
+	}
+	return ""
+}
+
+func (x *UserActivation) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *UserActivation) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *PromoCode) GetActivations() int64 {
+func (x *UserActivation) GetDescription() string {
 	if x != nil {
-		return x.Activations
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *PromoCode) GetMaxCount() int64 {
+func (x *UserActivation) GetActivatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MaxCount
+		return x.ActivatedAt
 	}
-	return 0
+	return nil
 }
 
 var File_promo_proto protoreflect.FileDescriptor
@@ -1206,44 +5039,66 @@ var file_promo_proto_rawDesc = string([]byte{
 	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61,
 	0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x23, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0xd2, 0x03, 0x0a, 0x12, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c,
-	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
-	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x6f, 0x74, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x23, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0xd0, 0x06, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55,
+	0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74,
-	0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69,
-	0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x22,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x19, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x03, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x04, 0x52, 0x06, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x06, 0x52, 0x0e, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3a,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x07, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
 	0x25, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xec, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50,
 	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63,
 	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
 	0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
@@ -1255,166 +5110,833 @@ var file_promo_proto_rawDesc = string([]byte{
 	0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x48, 0x03, 0x52, 0x06, 0x73, 0x6f,
 	0x72, 0x74, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x6f,
-	0x72, 0x74, 0x5f, 0x62, 0x79, 0x22, 0x59, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x78, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
-	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
-	0x22, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
-	0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69,
-	0x64, 0x22, 0x34, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x52, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x22, 0xdf, 0x02, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b,
-	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x22, 0x59, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x78, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x22, 0x5f,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22,
+	0x34, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x22, 0xd4, 0x05, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x62, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
-	0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x5f, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x14, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x22, 0x7f,
-	0x0a, 0x15, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0xb0, 0x01, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x61, 0x67,
-	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x61, 0x67,
-	0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
-	0x08, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
-	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x61, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x67, 0x65,
-	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x22, 0xc7, 0x03, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x19, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88,
-	0x01, 0x01, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x0c, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x48, 0x02, 0x52, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x88,
+	0x01, 0x01, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x48, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3a,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x05, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63,
+	0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x5f, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x14,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22,
+	0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x15, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xcd, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x09, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x09, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x17, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xbf,
+	0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
+	0x22, 0x91, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x14, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x3e,
+	0x0a, 0x12, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x62,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x22, 0x3f, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x63, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69,
+	0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x6d, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x78, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0b, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x5e, 0x0a, 0x09,
-	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x1e, 0x0a, 0x04,
-	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x4f, 0x4e, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x01, 0x2a, 0x30, 0x0a, 0x0b,
-	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x41,
-	0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x4e, 0x54, 0x49, 0x4c, 0x10, 0x01, 0x2a, 0x38,
-	0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e, 0x54, 0x49, 0x46, 0x52, 0x41, 0x55, 0x44, 0x10, 0x01, 0x12,
-	0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x53, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x32, 0x9c, 0x05, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
-	0x6d, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
-	0x6d, 0x6f, 0x12, 0x4e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12,
-	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
-	0x6d, 0x6f, 0x12, 0x56, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x14,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x92, 0x02, 0x0a, 0x06, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x73, 0x6b, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x53, 0x6b, 0x75, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x6b, 0x75, 0x22,
+	0xd4, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x16,
+	0x0a, 0x14, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x70,
+	0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x49, 0x0a, 0x14, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x04,
+	0x63, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x04, 0x63, 0x61, 0x72, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x15,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0b, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3b, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x69, 0x0a, 0x08, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x88,
+	0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x74, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x40, 0x0a, 0x10,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x44,
+	0x0a, 0x0e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0x36, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x1f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x32, 0x0a,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0x87, 0x01, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xf6, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x42, 0x17, 0x0a, 0x15, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x5f, 0x69, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x1e, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xc9, 0x07, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a,
+	0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0b,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x42,
+	0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x02, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0a, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x05, 0x52, 0x06, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x41, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x48, 0x06, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x69, 0x64, 0x22, 0x69, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x50, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22,
+	0x52, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
+	0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x79, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x78, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa0,
+	0x04, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x42, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42,
+	0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x22, 0x2c, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x8b, 0x01, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a,
+	0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a,
+	0x1b, 0x73, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x19, 0x73, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x30, 0x0a,
+	0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x4f, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x2a, 0x1e, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f,
+	0x4d, 0x4d, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45,
+	0x10, 0x01, 0x2a, 0x30, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x4e, 0x54,
+	0x49, 0x4c, 0x10, 0x01, 0x2a, 0x4e, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e, 0x54, 0x49, 0x46, 0x52,
+	0x41, 0x55, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x06, 0x2a,
+	0x90, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52,
+	0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x45, 0x53, 0x55, 0x4d, 0x45, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52, 0x43, 0x48, 0x49,
+	0x56, 0x45, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x52, 0x47, 0x45,
+	0x10, 0x09, 0x2a, 0x4d, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10, 0x02, 0x12, 0x11,
+	0x0a, 0x0d, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x53, 0x48, 0x49, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10,
+	0x03, 0x2a, 0x32, 0x0a, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x55, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c,
+	0x49, 0x46, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x2a, 0xec, 0x02, 0x0a,
+	0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x1b,
+	0x0a, 0x17, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x10,
+	0x04, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x55, 0x52, 0x52,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x12,
+	0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x10, 0x06, 0x12, 0x1c, 0x0a,
+	0x18, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x5f, 0x45, 0x4c, 0x49, 0x47, 0x49,
+	0x42, 0x4c, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x53, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x52, 0x54, 0x10, 0x08, 0x12, 0x19, 0x0a,
+	0x15, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x09, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x0b, 0x2a, 0x63, 0x0a, 0x0c, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x57,
+	0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x45, 0x42, 0x48,
+	0x4f, 0x4f, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02,
+	0x2a, 0x58, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x02, 0x2a, 0x4d, 0x0a, 0x0d, 0x43, 0x6f,
+	0x64, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x10, 0x0a, 0x0c, 0x53,
+	0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x51, 0x52, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x31,
+	0x32, 0x38, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x4f, 0x47,
+	0x59, 0x5f, 0x45, 0x41, 0x4e, 0x31, 0x33, 0x10, 0x02, 0x2a, 0x2f, 0x0a, 0x0f, 0x43, 0x6f, 0x64,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0d, 0x0a, 0x09,
+	0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x49,
+	0x4d, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x56, 0x47, 0x10, 0x01, 0x2a, 0x47, 0x0a, 0x11, 0x51, 0x72,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0b, 0x0a, 0x07, 0x51, 0x52, 0x5f, 0x45, 0x43, 0x5f, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x51, 0x52, 0x5f, 0x45, 0x43, 0x5f, 0x4d, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x51, 0x52, 0x5f,
+	0x45, 0x43, 0x5f, 0x51, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x51, 0x52, 0x5f, 0x45, 0x43, 0x5f,
+	0x48, 0x10, 0x03, 0x2a, 0x39, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x53, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x2a, 0x2f,
+	0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0e,
+	0x0a, 0x0a, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x01, 0x32,
+	0xff, 0x0e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x42, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x39, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x14,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2f,
-	0x7b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x62, 0x0a, 0x0b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x1a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
-	0x6f, 0x6d, 0x6f, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x5f,
-	0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x17, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x6d, 0x6f, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0x71, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a,
-	0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x2f, 0x7b,
-	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x12, 0x53, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67, 0x12,
-	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f,
-	0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
-	0x6d, 0x6f, 0x2f, 0x70, 0x69, 0x6e, 0x67, 0x42, 0x11, 0x5a, 0x0f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x46, 0x65, 0x65, 0x64, 0x12,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x60, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67,
+	0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x11, 0x5a, 0x0f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1429,67 +5951,226 @@ func file_promo_proto_rawDescGZIP() []byte {
 	return file_promo_proto_rawDescData
 }
 
-var file_promo_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_promo_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_promo_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_promo_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_promo_proto_goTypes = []any{
-	(Mode)(0),                     // 0: api.Mode
-	(PromoSortBy)(0),              // 1: api.PromoSortBy
-	(Reason)(0),                   // 2: api.Reason
-	(*PromoPingRequest)(nil),      // 3: api.PromoPingRequest
-	(*PromoPingResponse)(nil),     // 4: api.PromoPingResponse
-	(*CreatePromoRequest)(nil),    // 5: api.CreatePromoRequest
-	(*CreatePromoResponse)(nil),   // 6: api.CreatePromoResponse
-	(*ListPromoRequest)(nil),      // 7: api.ListPromoRequest
-	(*ListPromoResponse)(nil),     // 8: api.ListPromoResponse
-	(*GetPromoRequest)(nil),       // 9: api.GetPromoRequest
-	(*GetPromoResponse)(nil),      // 10: api.GetPromoResponse
-	(*UpdatePromoRequest)(nil),    // 11: api.UpdatePromoRequest
-	(*UpdatePromoResponse)(nil),   // 12: api.UpdatePromoResponse
-	(*DeletePromoRequest)(nil),    // 13: api.DeletePromoRequest
-	(*DeletePromoResponse)(nil),   // 14: api.DeletePromoResponse
-	(*ActivatePromoRequest)(nil),  // 15: api.ActivatePromoRequest
-	(*ActivatePromoResponse)(nil), // 16: api.ActivatePromoResponse
-	(*Target)(nil),                // 17: api.Target
-	(*Promo)(nil),                 // 18: api.Promo
-	(*PromoCode)(nil),             // 19: api.PromoCode
-	(*timestamppb.Timestamp)(nil), // 20: google.protobuf.Timestamp
+	(Mode)(0),                               // 0: api.Mode
+	(PromoSortBy)(0),                        // 1: api.PromoSortBy
+	(Reason)(0),                             // 2: api.Reason
+	(PromoStatus)(0),                        // 3: api.PromoStatus
+	(AuditOperation)(0),                     // 4: api.AuditOperation
+	(RewardType)(0),                         // 5: api.RewardType
+	(LimitScope)(0),                         // 6: api.LimitScope
+	(LimitWindow)(0),                        // 7: api.LimitWindow
+	(QuoteRejectReason)(0),                  // 8: api.QuoteRejectReason
+	(WebhookEvent)(0),                       // 9: api.WebhookEvent
+	(WebhookDeliveryStatus)(0),              // 10: api.WebhookDeliveryStatus
+	(CodeSymbology)(0),                      // 11: api.CodeSymbology
+	(CodeImageFormat)(0),                    // 12: api.CodeImageFormat
+	(QrErrorCorrection)(0),                  // 13: api.QrErrorCorrection
+	(ExportDataset)(0),                      // 14: api.ExportDataset
+	(ExportFormat)(0),                       // 15: api.ExportFormat
+	(*PromoPingRequest)(nil),                // 16: api.PromoPingRequest
+	(*PromoPingResponse)(nil),               // 17: api.PromoPingResponse
+	(*CreatePromoRequest)(nil),              // 18: api.CreatePromoRequest
+	(*CreatePromoResponse)(nil),             // 19: api.CreatePromoResponse
+	(*ListPromoRequest)(nil),                // 20: api.ListPromoRequest
+	(*ListPromoFeedRequest)(nil),            // 21: api.ListPromoFeedRequest
+	(*ListPromoResponse)(nil),               // 22: api.ListPromoResponse
+	(*GetPromoRequest)(nil),                 // 23: api.GetPromoRequest
+	(*GetPromoResponse)(nil),                // 24: api.GetPromoResponse
+	(*UpdatePromoRequest)(nil),              // 25: api.UpdatePromoRequest
+	(*UpdatePromoResponse)(nil),             // 26: api.UpdatePromoResponse
+	(*DeletePromoRequest)(nil),              // 27: api.DeletePromoRequest
+	(*DeletePromoResponse)(nil),             // 28: api.DeletePromoResponse
+	(*RestorePromoRequest)(nil),             // 29: api.RestorePromoRequest
+	(*RestorePromoResponse)(nil),            // 30: api.RestorePromoResponse
+	(*SetPromoImageRequest)(nil),            // 31: api.SetPromoImageRequest
+	(*SetPromoImageResponse)(nil),           // 32: api.SetPromoImageResponse
+	(*ActivatePromoRequest)(nil),            // 33: api.ActivatePromoRequest
+	(*ActivatePromoResponse)(nil),           // 34: api.ActivatePromoResponse
+	(*RenderPromoCodeRequest)(nil),          // 35: api.RenderPromoCodeRequest
+	(*RenderPromoCodeResponse)(nil),         // 36: api.RenderPromoCodeResponse
+	(*ExportPromoDataRequest)(nil),          // 37: api.ExportPromoDataRequest
+	(*ExportPromoDataChunk)(nil),            // 38: api.ExportPromoDataChunk
+	(*PublishPromoRequest)(nil),             // 39: api.PublishPromoRequest
+	(*PublishPromoResponse)(nil),            // 40: api.PublishPromoResponse
+	(*PausePromoRequest)(nil),               // 41: api.PausePromoRequest
+	(*PausePromoResponse)(nil),              // 42: api.PausePromoResponse
+	(*ResumePromoRequest)(nil),              // 43: api.ResumePromoRequest
+	(*ResumePromoResponse)(nil),             // 44: api.ResumePromoResponse
+	(*ArchivePromoRequest)(nil),             // 45: api.ArchivePromoRequest
+	(*ArchivePromoResponse)(nil),            // 46: api.ArchivePromoResponse
+	(*ListPromoAuditLogRequest)(nil),        // 47: api.ListPromoAuditLogRequest
+	(*ListPromoAuditLogResponse)(nil),       // 48: api.ListPromoAuditLogResponse
+	(*AuditLogEntry)(nil),                   // 49: api.AuditLogEntry
+	(*Reward)(nil),                          // 50: api.Reward
+	(*RewardConditions)(nil),                // 51: api.RewardConditions
+	(*CartItem)(nil),                        // 52: api.CartItem
+	(*Cart)(nil),                            // 53: api.Cart
+	(*QuoteDiscountRequest)(nil),            // 54: api.QuoteDiscountRequest
+	(*QuoteDiscountResponse)(nil),           // 55: api.QuoteDiscountResponse
+	(*Stacking)(nil),                        // 56: api.Stacking
+	(*ActivationLimit)(nil),                 // 57: api.ActivationLimit
+	(*ActivationLimits)(nil),                // 58: api.ActivationLimits
+	(*ReferralReward)(nil),                  // 59: api.ReferralReward
+	(*ResolveApplicablePromosRequest)(nil),  // 60: api.ResolveApplicablePromosRequest
+	(*ResolveApplicablePromosResponse)(nil), // 61: api.ResolveApplicablePromosResponse
+	(*AppliedPromo)(nil),                    // 62: api.AppliedPromo
+	(*RejectedPromoCode)(nil),               // 63: api.RejectedPromoCode
+	(*Target)(nil),                          // 64: api.Target
+	(*Promo)(nil),                           // 65: api.Promo
+	(*Localization)(nil),                    // 66: api.Localization
+	(*PromoCode)(nil),                       // 67: api.PromoCode
+	(*WebhookEndpoint)(nil),                 // 68: api.WebhookEndpoint
+	(*CreateWebhookEndpointRequest)(nil),    // 69: api.CreateWebhookEndpointRequest
+	(*CreateWebhookEndpointResponse)(nil),   // 70: api.CreateWebhookEndpointResponse
+	(*ListWebhookEndpointsRequest)(nil),     // 71: api.ListWebhookEndpointsRequest
+	(*ListWebhookEndpointsResponse)(nil),    // 72: api.ListWebhookEndpointsResponse
+	(*DeleteWebhookEndpointRequest)(nil),    // 73: api.DeleteWebhookEndpointRequest
+	(*DeleteWebhookEndpointResponse)(nil),   // 74: api.DeleteWebhookEndpointResponse
+	(*ListWebhookDeliveriesRequest)(nil),    // 75: api.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),   // 76: api.ListWebhookDeliveriesResponse
+	(*WebhookDelivery)(nil),                 // 77: api.WebhookDelivery
+	(*ForgetUserRequest)(nil),               // 78: api.ForgetUserRequest
+	(*ForgetUserResponse)(nil),              // 79: api.ForgetUserResponse
+	(*ExportUserDataRequest)(nil),           // 80: api.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),          // 81: api.ExportUserDataResponse
+	(*UserActivation)(nil),                  // 82: api.UserActivation
+	nil,                                     // 83: api.Localization.TranslationsEntry
+	(*timestamppb.Timestamp)(nil),           // 84: google.protobuf.Timestamp
 }
 var file_promo_proto_depIdxs = []int32{
-	0,  // 0: api.CreatePromoRequest.mode:type_name -> api.Mode
-	17, // 1: api.CreatePromoRequest.target:type_name -> api.Target
-	20, // 2: api.CreatePromoRequest.active_from:type_name -> google.protobuf.Timestamp
-	20, // 3: api.CreatePromoRequest.active_until:type_name -> google.protobuf.Timestamp
-	1,  // 4: api.ListPromoRequest.sort_by:type_name -> api.PromoSortBy
-	18, // 5: api.ListPromoResponse.promo:type_name -> api.Promo
-	18, // 6: api.GetPromoResponse.promo:type_name -> api.Promo
-	17, // 7: api.UpdatePromoRequest.target:type_name -> api.Target
-	20, // 8: api.UpdatePromoRequest.active_from:type_name -> google.protobuf.Timestamp
-	20, // 9: api.UpdatePromoRequest.active_until:type_name -> google.protobuf.Timestamp
-	2,  // 10: api.ActivatePromoResponse.reason:type_name -> api.Reason
-	0,  // 11: api.Promo.mode:type_name -> api.Mode
-	19, // 12: api.Promo.codes:type_name -> api.PromoCode
-	17, // 13: api.Promo.target:type_name -> api.Target
-	20, // 14: api.Promo.active_from:type_name -> google.protobuf.Timestamp
-	20, // 15: api.Promo.active_until:type_name -> google.protobuf.Timestamp
-	5,  // 16: api.PromoService.CreatePromo:input_type -> api.CreatePromoRequest
-	7,  // 17: api.PromoService.ListPromo:input_type -> api.ListPromoRequest
-	9,  // 18: api.PromoService.GetPromo:input_type -> api.GetPromoRequest
-	11, // 19: api.PromoService.UpdatePromo:input_type -> api.UpdatePromoRequest
-	13, // 20: api.PromoService.DeletePromo:input_type -> api.DeletePromoRequest
-	15, // 21: api.PromoService.ActivatePromo:input_type -> api.ActivatePromoRequest
-	3,  // 22: api.PromoService.PromoPing:input_type -> api.PromoPingRequest
-	6,  // 23: api.PromoService.CreatePromo:output_type -> api.CreatePromoResponse
-	8,  // 24: api.PromoService.ListPromo:output_type -> api.ListPromoResponse
-	10, // 25: api.PromoService.GetPromo:output_type -> api.GetPromoResponse
-	12, // 26: api.PromoService.UpdatePromo:output_type -> api.UpdatePromoResponse
-	14, // 27: api.PromoService.DeletePromo:output_type -> api.DeletePromoResponse
-	16, // 28: api.PromoService.ActivatePromo:output_type -> api.ActivatePromoResponse
-	4,  // 29: api.PromoService.PromoPing:output_type -> api.PromoPingResponse
-	23, // [23:30] is the sub-list for method output_type
-	16, // [16:23] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	0,   // 0: api.CreatePromoRequest.mode:type_name -> api.Mode
+	64,  // 1: api.CreatePromoRequest.target:type_name -> api.Target
+	84,  // 2: api.CreatePromoRequest.active_from:type_name -> google.protobuf.Timestamp
+	84,  // 3: api.CreatePromoRequest.active_until:type_name -> google.protobuf.Timestamp
+	50,  // 4: api.CreatePromoRequest.reward:type_name -> api.Reward
+	56,  // 5: api.CreatePromoRequest.stacking:type_name -> api.Stacking
+	57,  // 6: api.CreatePromoRequest.activation_limits:type_name -> api.ActivationLimit
+	59,  // 7: api.CreatePromoRequest.referral_reward:type_name -> api.ReferralReward
+	66,  // 8: api.CreatePromoRequest.localization:type_name -> api.Localization
+	1,   // 9: api.ListPromoRequest.sort_by:type_name -> api.PromoSortBy
+	65,  // 10: api.ListPromoResponse.promo:type_name -> api.Promo
+	65,  // 11: api.GetPromoResponse.promo:type_name -> api.Promo
+	64,  // 12: api.UpdatePromoRequest.target:type_name -> api.Target
+	84,  // 13: api.UpdatePromoRequest.active_from:type_name -> google.protobuf.Timestamp
+	84,  // 14: api.UpdatePromoRequest.active_until:type_name -> google.protobuf.Timestamp
+	50,  // 15: api.UpdatePromoRequest.reward:type_name -> api.Reward
+	56,  // 16: api.UpdatePromoRequest.stacking:type_name -> api.Stacking
+	58,  // 17: api.UpdatePromoRequest.activation_limits:type_name -> api.ActivationLimits
+	59,  // 18: api.UpdatePromoRequest.referral_reward:type_name -> api.ReferralReward
+	66,  // 19: api.UpdatePromoRequest.localization:type_name -> api.Localization
+	2,   // 20: api.ActivatePromoResponse.reason:type_name -> api.Reason
+	11,  // 21: api.RenderPromoCodeRequest.symbology:type_name -> api.CodeSymbology
+	12,  // 22: api.RenderPromoCodeRequest.format:type_name -> api.CodeImageFormat
+	13,  // 23: api.RenderPromoCodeRequest.error_correction:type_name -> api.QrErrorCorrection
+	14,  // 24: api.ExportPromoDataRequest.dataset:type_name -> api.ExportDataset
+	15,  // 25: api.ExportPromoDataRequest.format:type_name -> api.ExportFormat
+	3,   // 26: api.PublishPromoResponse.status:type_name -> api.PromoStatus
+	3,   // 27: api.PausePromoResponse.status:type_name -> api.PromoStatus
+	3,   // 28: api.ResumePromoResponse.status:type_name -> api.PromoStatus
+	3,   // 29: api.ArchivePromoResponse.status:type_name -> api.PromoStatus
+	4,   // 30: api.ListPromoAuditLogRequest.operation:type_name -> api.AuditOperation
+	84,  // 31: api.ListPromoAuditLogRequest.from:type_name -> google.protobuf.Timestamp
+	84,  // 32: api.ListPromoAuditLogRequest.until:type_name -> google.protobuf.Timestamp
+	49,  // 33: api.ListPromoAuditLogResponse.entries:type_name -> api.AuditLogEntry
+	4,   // 34: api.AuditLogEntry.operation:type_name -> api.AuditOperation
+	84,  // 35: api.AuditLogEntry.created_at:type_name -> google.protobuf.Timestamp
+	5,   // 36: api.Reward.type:type_name -> api.RewardType
+	51,  // 37: api.Reward.conditions:type_name -> api.RewardConditions
+	52,  // 38: api.Cart.items:type_name -> api.CartItem
+	53,  // 39: api.QuoteDiscountRequest.cart:type_name -> api.Cart
+	5,   // 40: api.QuoteDiscountResponse.reward_type:type_name -> api.RewardType
+	8,   // 41: api.QuoteDiscountResponse.reject_reason:type_name -> api.QuoteRejectReason
+	6,   // 42: api.ActivationLimit.scope:type_name -> api.LimitScope
+	7,   // 43: api.ActivationLimit.window:type_name -> api.LimitWindow
+	57,  // 44: api.ActivationLimits.limits:type_name -> api.ActivationLimit
+	62,  // 45: api.ResolveApplicablePromosResponse.applied:type_name -> api.AppliedPromo
+	63,  // 46: api.ResolveApplicablePromosResponse.rejected:type_name -> api.RejectedPromoCode
+	56,  // 47: api.AppliedPromo.stacking:type_name -> api.Stacking
+	8,   // 48: api.RejectedPromoCode.reason:type_name -> api.QuoteRejectReason
+	0,   // 49: api.Promo.mode:type_name -> api.Mode
+	67,  // 50: api.Promo.codes:type_name -> api.PromoCode
+	64,  // 51: api.Promo.target:type_name -> api.Target
+	84,  // 52: api.Promo.active_from:type_name -> google.protobuf.Timestamp
+	84,  // 53: api.Promo.active_until:type_name -> google.protobuf.Timestamp
+	3,   // 54: api.Promo.status:type_name -> api.PromoStatus
+	50,  // 55: api.Promo.reward:type_name -> api.Reward
+	56,  // 56: api.Promo.stacking:type_name -> api.Stacking
+	57,  // 57: api.Promo.activation_limits:type_name -> api.ActivationLimit
+	59,  // 58: api.Promo.referral_reward:type_name -> api.ReferralReward
+	66,  // 59: api.Promo.localization:type_name -> api.Localization
+	83,  // 60: api.Localization.translations:type_name -> api.Localization.TranslationsEntry
+	9,   // 61: api.WebhookEndpoint.events:type_name -> api.WebhookEvent
+	84,  // 62: api.WebhookEndpoint.created_at:type_name -> google.protobuf.Timestamp
+	9,   // 63: api.CreateWebhookEndpointRequest.events:type_name -> api.WebhookEvent
+	68,  // 64: api.CreateWebhookEndpointResponse.endpoint:type_name -> api.WebhookEndpoint
+	68,  // 65: api.ListWebhookEndpointsResponse.endpoints:type_name -> api.WebhookEndpoint
+	10,  // 66: api.ListWebhookDeliveriesRequest.status:type_name -> api.WebhookDeliveryStatus
+	77,  // 67: api.ListWebhookDeliveriesResponse.deliveries:type_name -> api.WebhookDelivery
+	9,   // 68: api.WebhookDelivery.event:type_name -> api.WebhookEvent
+	10,  // 69: api.WebhookDelivery.status:type_name -> api.WebhookDeliveryStatus
+	84,  // 70: api.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	84,  // 71: api.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 72: api.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	82,  // 73: api.ExportUserDataResponse.activations:type_name -> api.UserActivation
+	84,  // 74: api.UserActivation.activated_at:type_name -> google.protobuf.Timestamp
+	18,  // 75: api.PromoService.CreatePromo:input_type -> api.CreatePromoRequest
+	20,  // 76: api.PromoService.ListPromo:input_type -> api.ListPromoRequest
+	23,  // 77: api.PromoService.GetPromo:input_type -> api.GetPromoRequest
+	25,  // 78: api.PromoService.UpdatePromo:input_type -> api.UpdatePromoRequest
+	27,  // 79: api.PromoService.DeletePromo:input_type -> api.DeletePromoRequest
+	33,  // 80: api.PromoService.ActivatePromo:input_type -> api.ActivatePromoRequest
+	35,  // 81: api.PromoService.RenderPromoCode:input_type -> api.RenderPromoCodeRequest
+	37,  // 82: api.PromoService.ExportPromoData:input_type -> api.ExportPromoDataRequest
+	21,  // 83: api.PromoService.ListPromoFeed:input_type -> api.ListPromoFeedRequest
+	39,  // 84: api.PromoService.PublishPromo:input_type -> api.PublishPromoRequest
+	41,  // 85: api.PromoService.PausePromo:input_type -> api.PausePromoRequest
+	43,  // 86: api.PromoService.ResumePromo:input_type -> api.ResumePromoRequest
+	45,  // 87: api.PromoService.ArchivePromo:input_type -> api.ArchivePromoRequest
+	29,  // 88: api.PromoService.RestorePromo:input_type -> api.RestorePromoRequest
+	31,  // 89: api.PromoService.SetPromoImage:input_type -> api.SetPromoImageRequest
+	47,  // 90: api.PromoService.ListPromoAuditLog:input_type -> api.ListPromoAuditLogRequest
+	54,  // 91: api.PromoService.QuoteDiscount:input_type -> api.QuoteDiscountRequest
+	60,  // 92: api.PromoService.ResolveApplicablePromos:input_type -> api.ResolveApplicablePromosRequest
+	69,  // 93: api.PromoService.CreateWebhookEndpoint:input_type -> api.CreateWebhookEndpointRequest
+	71,  // 94: api.PromoService.ListWebhookEndpoints:input_type -> api.ListWebhookEndpointsRequest
+	73,  // 95: api.PromoService.DeleteWebhookEndpoint:input_type -> api.DeleteWebhookEndpointRequest
+	75,  // 96: api.PromoService.ListWebhookDeliveries:input_type -> api.ListWebhookDeliveriesRequest
+	78,  // 97: api.PromoService.ForgetUser:input_type -> api.ForgetUserRequest
+	80,  // 98: api.PromoService.ExportUserData:input_type -> api.ExportUserDataRequest
+	16,  // 99: api.PromoService.PromoPing:input_type -> api.PromoPingRequest
+	19,  // 100: api.PromoService.CreatePromo:output_type -> api.CreatePromoResponse
+	22,  // 101: api.PromoService.ListPromo:output_type -> api.ListPromoResponse
+	24,  // 102: api.PromoService.GetPromo:output_type -> api.GetPromoResponse
+	26,  // 103: api.PromoService.UpdatePromo:output_type -> api.UpdatePromoResponse
+	28,  // 104: api.PromoService.DeletePromo:output_type -> api.DeletePromoResponse
+	34,  // 105: api.PromoService.ActivatePromo:output_type -> api.ActivatePromoResponse
+	36,  // 106: api.PromoService.RenderPromoCode:output_type -> api.RenderPromoCodeResponse
+	38,  // 107: api.PromoService.ExportPromoData:output_type -> api.ExportPromoDataChunk
+	22,  // 108: api.PromoService.ListPromoFeed:output_type -> api.ListPromoResponse
+	40,  // 109: api.PromoService.PublishPromo:output_type -> api.PublishPromoResponse
+	42,  // 110: api.PromoService.PausePromo:output_type -> api.PausePromoResponse
+	44,  // 111: api.PromoService.ResumePromo:output_type -> api.ResumePromoResponse
+	46,  // 112: api.PromoService.ArchivePromo:output_type -> api.ArchivePromoResponse
+	30,  // 113: api.PromoService.RestorePromo:output_type -> api.RestorePromoResponse
+	32,  // 114: api.PromoService.SetPromoImage:output_type -> api.SetPromoImageResponse
+	48,  // 115: api.PromoService.ListPromoAuditLog:output_type -> api.ListPromoAuditLogResponse
+	55,  // 116: api.PromoService.QuoteDiscount:output_type -> api.QuoteDiscountResponse
+	61,  // 117: api.PromoService.ResolveApplicablePromos:output_type -> api.ResolveApplicablePromosResponse
+	70,  // 118: api.PromoService.CreateWebhookEndpoint:output_type -> api.CreateWebhookEndpointResponse
+	72,  // 119: api.PromoService.ListWebhookEndpoints:output_type -> api.ListWebhookEndpointsResponse
+	74,  // 120: api.PromoService.DeleteWebhookEndpoint:output_type -> api.DeleteWebhookEndpointResponse
+	76,  // 121: api.PromoService.ListWebhookDeliveries:output_type -> api.ListWebhookDeliveriesResponse
+	79,  // 122: api.PromoService.ForgetUser:output_type -> api.ForgetUserResponse
+	81,  // 123: api.PromoService.ExportUserData:output_type -> api.ExportUserDataResponse
+	17,  // 124: api.PromoService.PromoPing:output_type -> api.PromoPingResponse
+	100, // [100:125] is the sub-list for method output_type
+	75,  // [75:100] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_promo_proto_init() }
@@ -1499,18 +6180,41 @@ func file_promo_proto_init() {
 	}
 	file_promo_proto_msgTypes[2].OneofWrappers = []any{}
 	file_promo_proto_msgTypes[4].OneofWrappers = []any{}
-	file_promo_proto_msgTypes[6].OneofWrappers = []any{}
-	file_promo_proto_msgTypes[8].OneofWrappers = []any{}
-	file_promo_proto_msgTypes[10].OneofWrappers = []any{}
-	file_promo_proto_msgTypes[14].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[5].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[7].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[9].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[11].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[13].OneofWrappers = []any{}
 	file_promo_proto_msgTypes[15].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[17].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[19].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[21].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[22].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[23].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[25].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[27].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[29].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[31].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[34].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[35].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[36].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[39].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[40].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[47].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[48].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[49].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[53].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[55].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[57].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[59].OneofWrappers = []any{}
+	file_promo_proto_msgTypes[61].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_promo_proto_rawDesc), len(file_promo_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      16,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,106 @@
+// Package barcode кодирует строки в QR-код, Code128 и EAN-13 и отрисовывает их в PNG или SVG
+package barcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Symbology string
+
+const (
+	SymbologyQR      Symbology = "qr"
+	SymbologyCode128 Symbology = "code128"
+	SymbologyEAN13   Symbology = "ean13"
+)
+
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+// ErrorCorrection задаёт уровень коррекции ошибок QR-кода: L ~7%, M ~15%, Q ~25%, H ~30% повреждённых модулей
+type ErrorCorrection string
+
+const (
+	ErrorCorrectionL ErrorCorrection = "L"
+	ErrorCorrectionM ErrorCorrection = "M"
+	ErrorCorrectionQ ErrorCorrection = "Q"
+	ErrorCorrectionH ErrorCorrection = "H"
+)
+
+const (
+	DefaultSize = 256
+	MinSize     = 64
+	MaxSize     = 2048
+)
+
+var (
+	ErrInvalidOptions     = errors.New("invalid render options")
+	ErrUnsupportedContent = errors.New("content cannot be encoded with this symbology")
+)
+
+// Options описывает способ отрисовки. Size — ширина изображения в пикселях,
+// ErrorCorrection учитывается только для QR-кода
+type Options struct {
+	Symbology       Symbology
+	Format          Format
+	Size            int
+	ErrorCorrection ErrorCorrection
+}
+
+func (o Options) Validate() error {
+	switch o.Symbology {
+	case SymbologyQR, SymbologyCode128, SymbologyEAN13:
+	default:
+		return fmt.Errorf("%w: unknown symbology %q", ErrInvalidOptions, o.Symbology)
+	}
+
+	switch o.Format {
+	case FormatPNG, FormatSVG:
+	default:
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidOptions, o.Format)
+	}
+
+	if o.Size < MinSize || o.Size > MaxSize {
+		return fmt.Errorf("%w: size must be between %d and %d", ErrInvalidOptions, MinSize, MaxSize)
+	}
+
+	if _, ok := qrLevels[o.ErrorCorrection]; !ok && o.Symbology == SymbologyQR {
+		return fmt.Errorf("%w: unknown error correction %q", ErrInvalidOptions, o.ErrorCorrection)
+	}
+
+	return nil
+}
+
+// Render кодирует content и возвращает изображение вместе с его MIME-типом
+func Render(content string, opts Options) (image []byte, contentType string, err error) {
+	if err = opts.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	var symbol *symbol
+	switch opts.Symbology {
+	case SymbologyQR:
+		symbol, err = encodeQR(content, qrLevels[opts.ErrorCorrection])
+	case SymbologyCode128:
+		symbol, err = encodeCode128(content)
+	case SymbologyEAN13:
+		symbol, err = encodeEAN13(content)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	if opts.Format == FormatSVG {
+		return symbol.svg(opts.Size), "image/svg+xml", nil
+	}
+
+	image, err = symbol.png(opts.Size)
+	if err != nil {
+		return nil, "", err
+	}
+	return image, "image/png", nil
+}
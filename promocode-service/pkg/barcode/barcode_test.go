@@ -0,0 +1,265 @@
+package barcode
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// версия 1-M, строка HELLO WORLD в алфавитно-цифровом режиме
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	require.Equal(t, want, reedSolomonRemainder(data, reedSolomonDivisor(len(want))))
+}
+
+func TestQRFormatAndVersionBits(t *testing.T) {
+	require.Equal(t, 0b111011111000100, qrFormatBits(qrLevels[ErrorCorrectionL], 0))
+	require.Equal(t, 0b101010000010010, qrFormatBits(qrLevels[ErrorCorrectionM], 0))
+	require.Equal(t, 0b000100000111011, qrFormatBits(qrLevels[ErrorCorrectionH], 7))
+	require.Equal(t, 0b000111110010010100, qrVersionBits(7))
+	require.Equal(t, 0b101000110001101001, qrVersionBits(40))
+}
+
+func TestQRDataCodewords(t *testing.T) {
+	require.Equal(t, 19, qrDataCodewords(1, qrLevels[ErrorCorrectionL]))
+	require.Equal(t, 9, qrDataCodewords(1, qrLevels[ErrorCorrectionH]))
+	require.Equal(t, 216, qrDataCodewords(10, qrLevels[ErrorCorrectionM]))
+	require.Equal(t, 2956, qrDataCodewords(40, qrLevels[ErrorCorrectionL]))
+	require.Equal(t, 1276, qrDataCodewords(40, qrLevels[ErrorCorrectionH]))
+}
+
+func TestEncodeQR_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		level   ErrorCorrection
+	}{
+		{name: "short code", content: "SUMMER2025", level: ErrorCorrectionM},
+		{name: "high correction", content: "PROMO-7F3K-QX9D", level: ErrorCorrectionH},
+		{name: "version with version info", content: strings.Repeat("promo code ", 20), level: ErrorCorrectionQ},
+		{name: "many blocks", content: strings.Repeat("0123456789abcdef", 60), level: ErrorCorrectionL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			symbol, err := encodeQR(tt.content, qrLevels[tt.level])
+			require.NoError(t, err)
+
+			require.Equal(t, tt.content, readQR(t, symbol, qrLevels[tt.level]))
+		})
+	}
+}
+
+func TestEncodeQR_TooLong(t *testing.T) {
+	_, err := encodeQR(strings.Repeat("x", 2954), qrLevels[ErrorCorrectionL])
+	require.ErrorIs(t, err, ErrUnsupportedContent)
+}
+
+func TestCode128Patterns(t *testing.T) {
+	for value, pattern := range code128Patterns {
+		width := 0
+		for _, w := range pattern {
+			width += int(w - '0')
+		}
+		if value == code128Stop {
+			require.Equal(t, 13, width)
+			continue
+		}
+		require.Equal(t, 11, width, "value %d", value)
+	}
+}
+
+func TestCode128Values(t *testing.T) {
+	tests := []struct {
+		content string
+		want    []int
+	}{
+		{content: "AB", want: []int{code128StartB, 33, 34}},
+		{content: "1234", want: []int{code128StartC, 12, 34}},
+		{content: "12345", want: []int{code128StartB, 17, code128CodeC, 23, 45}},
+		{content: "AB1234C", want: []int{code128StartB, 33, 34, code128CodeC, 12, 34, code128CodeB, 35}},
+		{content: "A12", want: []int{code128StartB, 33, 17, 18}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.content, func(t *testing.T) {
+			require.Equal(t, tt.want, code128Values(tt.content))
+		})
+	}
+}
+
+func TestEncodeCode128(t *testing.T) {
+	symbol, err := encodeCode128("1234")
+	require.NoError(t, err)
+	// старт, два символа, контрольный символ (105 + 12 + 2*34) % 103 = 82 и стоп
+	require.Equal(t, 11*4+13, symbol.width)
+	require.Equal(t, modules(code128Patterns[82]), symbol.dark[33:44])
+
+	_, err = encodeCode128("ПРОМО")
+	require.ErrorIs(t, err, ErrUnsupportedContent)
+}
+
+func TestEncodeEAN13(t *testing.T) {
+	symbol, err := encodeEAN13("400638133393")
+	require.NoError(t, err)
+	require.Equal(t, 95, symbol.width)
+
+	withCheck, err := encodeEAN13("4006381333931")
+	require.NoError(t, err)
+	require.Equal(t, symbol.dark, withCheck.dark)
+
+	_, err = encodeEAN13("4006381333932")
+	require.ErrorIs(t, err, ErrUnsupportedContent)
+
+	_, err = encodeEAN13("SUMMER2025")
+	require.ErrorIs(t, err, ErrUnsupportedContent)
+}
+
+func TestRender(t *testing.T) {
+	image, contentType, err := Render("SUMMER2025", Options{Symbology: SymbologyQR, Format: FormatPNG, Size: 256, ErrorCorrection: ErrorCorrectionM})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", contentType)
+
+	decoded, err := png.Decode(bytes.NewReader(image))
+	require.NoError(t, err)
+	// версия 1: 21 модуль и по 4 модуля поля с каждой стороны, 256 / 29 = 8 пикселей на модуль
+	require.Equal(t, 29*8, decoded.Bounds().Dx())
+	require.Equal(t, 29*8, decoded.Bounds().Dy())
+
+	image, contentType, err = Render("SUMMER2025", Options{Symbology: SymbologyCode128, Format: FormatSVG, Size: 512})
+	require.NoError(t, err)
+	require.Equal(t, "image/svg+xml", contentType)
+	require.True(t, strings.HasPrefix(string(image), "<svg "))
+
+	_, _, err = Render("SUMMER2025", Options{Symbology: SymbologyQR, Format: FormatPNG, Size: 10, ErrorCorrection: ErrorCorrectionM})
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, _, err = Render("SUMMER2025", Options{Symbology: SymbologyQR, Format: FormatPNG, Size: 256})
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func modules(pattern string) []bool {
+	var result []bool
+	for i, w := range pattern {
+		for j := 0; j < int(w-'0'); j++ {
+			result = append(result, i%2 == 0)
+		}
+	}
+	return result
+}
+
+// readQR считывает символ обратно: проверяет формат, снимает маску, собирает блоки,
+// проверяет их синдромы Рида — Соломона и разбирает байтовый сегмент
+func readQR(t *testing.T, s *symbol, level qrLevel) string {
+	t.Helper()
+
+	size := s.width
+	version := (size - 17) / 4
+
+	format := 0
+	for i := 0; i <= 5; i++ {
+		format |= boolToInt(s.at(8, i)) << i
+	}
+	format |= boolToInt(s.at(8, 7)) << 6
+	format |= boolToInt(s.at(8, 8)) << 7
+	format |= boolToInt(s.at(7, 8)) << 8
+	for i := 9; i < 15; i++ {
+		format |= boolToInt(s.at(14-i, 8)) << i
+	}
+	mask := (format ^ 0x5412) >> 10 & 7
+	require.Equal(t, level.formatBits, (format^0x5412)>>13)
+	require.Equal(t, qrFormatBits(level, mask), format)
+
+	layout := &qrCode{symbol: newSymbol(size, size, qrQuietZone), version: version, function: make([]bool, size*size)}
+	layout.drawFunctionPatterns(level)
+
+	var bits bitBuffer
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vertical
+				if (right+1)&2 == 0 {
+					y = size - 1 - vertical
+				}
+				if !layout.isFunction(x, y) {
+					bits = append(bits, s.at(x, y) != qrMaskApplies(mask, x, y))
+				}
+			}
+		}
+	}
+	raw := bits[:qrRawDataModules(version)/8*8].bytes()
+
+	blockCount := qrEccBlocks[level.index][version]
+	eccLen := qrEccCodewordsPerBlock[level.index][version]
+	shortBlockCount := blockCount - len(raw)%blockCount
+	shortBlockLen := len(raw) / blockCount
+
+	blocks := make([][]byte, blockCount)
+	position := 0
+	for i := 0; i <= shortBlockLen; i++ {
+		for j := range blocks {
+			if i == shortBlockLen-eccLen && j < shortBlockCount {
+				continue
+			}
+			blocks[j] = append(blocks[j], raw[position])
+			position++
+		}
+	}
+
+	var data []byte
+	for _, block := range blocks {
+		for i := 0; i < eccLen; i++ {
+			require.Zero(t, syndrome(block, i), "syndrome %d", i)
+		}
+		data = append(data, block[:len(block)-eccLen]...)
+	}
+
+	reader := bitReader{data: data}
+	require.Equal(t, 0b0100, reader.read(4))
+	length := reader.read(qrCountBits(version))
+	content := make([]byte, length)
+	for i := range content {
+		content[i] = byte(reader.read(8))
+	}
+	return string(content)
+}
+
+// syndrome вычисляет значение многочлена блока в точке α^i
+func syndrome(block []byte, i int) byte {
+	point := byte(1)
+	for j := 0; j < i; j++ {
+		point = gfMultiply(point, 0x02)
+	}
+	var result byte
+	for _, b := range block {
+		result = gfMultiply(result, point) ^ b
+	}
+	return result
+}
+
+type bitReader struct {
+	data     []byte
+	position int
+}
+
+func (r *bitReader) read(length int) int {
+	result := 0
+	for i := 0; i < length; i++ {
+		result = result<<1 | int(r.data[r.position/8]>>(7-r.position%8)&1)
+		r.position++
+	}
+	return result
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,116 @@
+package barcode
+
+import (
+	"fmt"
+)
+
+// Кодирование Code128 наборами B (печатные символы ASCII) и C (пары цифр)
+
+const (
+	code128QuietZone = 10
+
+	code128CodeC  = 99
+	code128CodeB  = 100
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+)
+
+// code128Patterns — ширины чередующихся полос и пробелов для каждого значения, начиная с полосы
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+func encodeCode128(content string) (*symbol, error) {
+	if content == "" {
+		return nil, fmt.Errorf("%w: empty content", ErrUnsupportedContent)
+	}
+	for _, r := range content {
+		if r < ' ' || r > '~' {
+			return nil, fmt.Errorf("%w: code128 supports printable ASCII only", ErrUnsupportedContent)
+		}
+	}
+
+	values := code128Values(content)
+
+	checksum := values[0]
+	for i := 1; i < len(values); i++ {
+		checksum += i * values[i]
+	}
+	values = append(values, checksum%103, code128Stop)
+
+	var modules []bool
+	for _, value := range values {
+		for i, width := range code128Patterns[value] {
+			for j := 0; j < int(width-'0'); j++ {
+				modules = append(modules, i%2 == 0)
+			}
+		}
+	}
+
+	symbol := newSymbol(len(modules), 1, code128QuietZone)
+	copy(symbol.dark, modules)
+	return symbol, nil
+}
+
+// code128Values переводит строку в значения символов. Набор C используется для серий из четырёх и более цифр,
+// что вдвое сокращает их длину
+func code128Values(content string) []int {
+	var values []int
+
+	setC := false
+	for i := 0; i < len(content); {
+		digits := digitRun(content[i:])
+
+		switch {
+		case digits >= 4 && !setC:
+			// нечётная серия начинается с одной цифры в наборе B, чтобы остаток делился на пары
+			if digits%2 == 1 {
+				values = appendCode128B(values, i == 0, content[i])
+				i++
+			}
+			if len(values) == 0 {
+				values = append(values, code128StartC)
+			} else {
+				values = append(values, code128CodeC)
+			}
+			setC = true
+		case setC && digits >= 2:
+			values = append(values, int(content[i]-'0')*10+int(content[i+1]-'0'))
+			i += 2
+		case setC:
+			values = append(values, code128CodeB)
+			setC = false
+		default:
+			values = appendCode128B(values, i == 0, content[i])
+			i++
+		}
+	}
+
+	return values
+}
+
+func appendCode128B(values []int, first bool, char byte) []int {
+	if first {
+		values = append(values, code128StartB)
+	}
+	return append(values, int(char-' '))
+}
+
+func digitRun(s string) int {
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,64 @@
+package barcode
+
+import (
+	"fmt"
+)
+
+const ean13QuietZone = 11
+
+// ean13LeftParity задаёт по первой цифре, какие цифры левой половины кодируются набором G вместо L
+var ean13LeftParity = [10]string{
+	"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG", "LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+}
+
+var (
+	ean13L = [10]string{"0001101", "0011001", "0010011", "0111101", "0100011", "0110001", "0101111", "0111011", "0110111", "0001011"}
+	ean13G = [10]string{"0100111", "0110011", "0011011", "0100001", "0011101", "0111001", "0000101", "0010001", "0001001", "0010111"}
+	ean13R = [10]string{"1110010", "1100110", "1101100", "1000010", "1011100", "1001110", "1010000", "1000100", "1001000", "1110100"}
+)
+
+// encodeEAN13 кодирует 12 цифр с вычисленной контрольной цифрой или 13 цифр с проверкой контрольной
+func encodeEAN13(content string) (*symbol, error) {
+	if (len(content) != 12 && len(content) != 13) || digitRun(content) != len(content) {
+		return nil, fmt.Errorf("%w: ean13 requires 12 or 13 digits", ErrUnsupportedContent)
+	}
+
+	check := ean13CheckDigit(content[:12])
+	if len(content) == 13 && int(content[12]-'0') != check {
+		return nil, fmt.Errorf("%w: invalid ean13 check digit", ErrUnsupportedContent)
+	}
+	digits := content[:12] + string(rune('0'+check))
+
+	pattern := "101"
+	parity := ean13LeftParity[digits[0]-'0']
+	for i := 1; i <= 6; i++ {
+		if parity[i-1] == 'G' {
+			pattern += ean13G[digits[i]-'0']
+		} else {
+			pattern += ean13L[digits[i]-'0']
+		}
+	}
+	pattern += "01010"
+	for i := 7; i <= 12; i++ {
+		pattern += ean13R[digits[i]-'0']
+	}
+	pattern += "101"
+
+	symbol := newSymbol(len(pattern), 1, ean13QuietZone)
+	for i, module := range pattern {
+		symbol.dark[i] = module == '1'
+	}
+	return symbol, nil
+}
+
+func ean13CheckDigit(digits string) int {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(digits[i]-'0') * weight
+	}
+	return (10 - sum%10) % 10
+}
@@ -0,0 +1,512 @@
+package barcode
+
+import (
+	"fmt"
+)
+
+// Кодирование QR-кода по ISO/IEC 18004 в байтовом режиме, версии 1–40
+
+type qrLevel struct {
+	// index — индекс уровня в таблицах блоков коррекции
+	index int
+	// formatBits — код уровня в служебной информации о формате
+	formatBits int
+}
+
+var qrLevels = map[ErrorCorrection]qrLevel{
+	ErrorCorrectionL: {index: 0, formatBits: 1},
+	ErrorCorrectionM: {index: 1, formatBits: 0},
+	ErrorCorrectionQ: {index: 2, formatBits: 3},
+	ErrorCorrectionH: {index: 3, formatBits: 2},
+}
+
+const (
+	qrMinVersion = 1
+	qrMaxVersion = 40
+	qrQuietZone  = 4
+
+	qrPenaltyN1 = 3
+	qrPenaltyN2 = 3
+	qrPenaltyN3 = 40
+	qrPenaltyN4 = 10
+)
+
+// qrEccCodewordsPerBlock и qrEccBlocks — число кодовых слов коррекции в блоке и число блоков по уровню и версии
+var qrEccCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+var qrEccBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+type qrCode struct {
+	*symbol
+	version  int
+	function []bool
+}
+
+func encodeQR(content string, level qrLevel) (*symbol, error) {
+	data := []byte(content)
+
+	version := qrMinVersion
+	for ; version <= qrMaxVersion; version++ {
+		if 4+qrCountBits(version)+8*len(data) <= 8*qrDataCodewords(version, level) {
+			break
+		}
+	}
+	if version > qrMaxVersion {
+		return nil, fmt.Errorf("%w: %d bytes do not fit into a QR code", ErrUnsupportedContent, len(data))
+	}
+
+	codewords := qrAddEcc(qrDataSegment(data, version, level), version, level)
+
+	size := version*4 + 17
+	qr := &qrCode{
+		symbol:   newSymbol(size, size, qrQuietZone),
+		version:  version,
+		function: make([]bool, size*size),
+	}
+	qr.drawFunctionPatterns(level)
+	qr.drawCodewords(codewords)
+
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		qr.applyMask(mask)
+		qr.drawFormatBits(level, mask)
+		if penalty := qr.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		qr.applyMask(mask)
+	}
+	qr.applyMask(bestMask)
+	qr.drawFormatBits(level, bestMask)
+
+	return qr.symbol, nil
+}
+
+func qrCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+// qrRawDataModules — число модулей версии, доступных под данные и коррекцию, после вычета служебных узоров
+func qrRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		alignCount := version/7 + 2
+		result -= (25*alignCount-10)*alignCount - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+func qrDataCodewords(version int, level qrLevel) int {
+	return qrRawDataModules(version)/8 - qrEccCodewordsPerBlock[level.index][version]*qrEccBlocks[level.index][version]
+}
+
+// qrDataSegment собирает поток данных: режим, длина, байты, терминатор и байты-заполнители
+func qrDataSegment(data []byte, version int, level qrLevel) []byte {
+	capacity := qrDataCodewords(version, level) * 8
+
+	var bits bitBuffer
+	bits.append(0b0100, 4)
+	bits.append(len(data), qrCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	bits.append(0, min(4, capacity-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	return bits.bytes()
+}
+
+// qrAddEcc делит данные на блоки, дополняет каждый кодовыми словами Рида — Соломона и чередует блоки
+func qrAddEcc(data []byte, version int, level qrLevel) []byte {
+	blockCount := qrEccBlocks[level.index][version]
+	eccLen := qrEccCodewordsPerBlock[level.index][version]
+	rawCodewords := qrRawDataModules(version) / 8
+	shortBlockCount := blockCount - rawCodewords%blockCount
+	shortBlockLen := rawCodewords / blockCount
+
+	divisor := reedSolomonDivisor(eccLen)
+
+	blocks := make([][]byte, 0, blockCount)
+	for i, offset := 0, 0; i < blockCount; i++ {
+		dataLen := shortBlockLen - eccLen
+		if i >= shortBlockCount {
+			dataLen++
+		}
+		blockData := data[offset : offset+dataLen]
+		offset += dataLen
+
+		block := make([]byte, 0, shortBlockLen+1)
+		block = append(block, blockData...)
+		if i < shortBlockCount {
+			block = append(block, 0)
+		}
+		block = append(block, reedSolomonRemainder(blockData, divisor)...)
+		blocks = append(blocks, block)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := 0; i <= shortBlockLen; i++ {
+		for j, block := range blocks {
+			// короткие блоки дополнены нулём только для выравнивания, в символ он не попадает
+			if i == shortBlockLen-eccLen && j < shortBlockCount {
+				continue
+			}
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+func (q *qrCode) setFunction(x int, y int, dark bool) {
+	q.set(x, y, dark)
+	q.function[y*q.width+x] = true
+}
+
+func (q *qrCode) isFunction(x int, y int) bool {
+	return q.function[y*q.width+x]
+}
+
+func (q *qrCode) drawFunctionPatterns(level qrLevel) {
+	size := q.width
+
+	for i := 0; i < size; i++ {
+		q.setFunction(6, i, i%2 == 0)
+		q.setFunction(i, 6, i%2 == 0)
+	}
+
+	q.drawFinderPattern(3, 3)
+	q.drawFinderPattern(size-4, 3)
+	q.drawFinderPattern(3, size-4)
+
+	positions := qrAlignmentPositions(q.version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			q.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// резервирует место под информацию о формате, настоящие биты записываются после выбора маски
+	q.drawFormatBits(level, 0)
+	q.drawVersion()
+}
+
+func (q *qrCode) drawFinderPattern(centerX int, centerY int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := centerX+dx, centerY+dy
+			if x < 0 || x >= q.width || y < 0 || y >= q.height {
+				continue
+			}
+			distance := max(abs(dx), abs(dy))
+			q.setFunction(x, y, distance != 2 && distance != 4)
+		}
+	}
+}
+
+func (q *qrCode) drawAlignmentPattern(centerX int, centerY int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			q.setFunction(centerX+dx, centerY+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+func qrAlignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+
+	count := version/7 + 2
+	step := (version*8 + count*3 + 5) / (count*4 - 4) * 2
+
+	positions := make([]int, count)
+	positions[0] = 6
+	for i, position := count-1, version*4+17-7; i >= 1; i, position = i-1, position-step {
+		positions[i] = position
+	}
+	return positions
+}
+
+func (q *qrCode) drawFormatBits(level qrLevel, mask int) {
+	bits := qrFormatBits(level, mask)
+	size := q.width
+
+	for i := 0; i <= 5; i++ {
+		q.setFunction(8, i, bit(bits, i))
+	}
+	q.setFunction(8, 7, bit(bits, 6))
+	q.setFunction(8, 8, bit(bits, 7))
+	q.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		q.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	for i := 0; i < 8; i++ {
+		q.setFunction(size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunction(8, size-15+i, bit(bits, i))
+	}
+	q.setFunction(8, size-8, true)
+}
+
+// qrFormatBits — 15 бит информации о формате: уровень коррекции и маска, защищённые кодом БЧХ
+func qrFormatBits(level qrLevel, mask int) int {
+	data := level.formatBits<<3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = remainder<<1 ^ (remainder>>9)*0x537
+	}
+	return (data<<10 | remainder) ^ 0x5412
+}
+
+func (q *qrCode) drawVersion() {
+	if q.version < 7 {
+		return
+	}
+
+	bits := qrVersionBits(q.version)
+	for i := 0; i < 18; i++ {
+		a, b := q.width-11+i%3, i/3
+		q.setFunction(a, b, bit(bits, i))
+		q.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// qrVersionBits — 18 бит информации о версии, защищённые кодом Голея
+func qrVersionBits(version int) int {
+	remainder := version
+	for i := 0; i < 12; i++ {
+		remainder = remainder<<1 ^ (remainder>>11)*0x1F25
+	}
+	return version<<12 | remainder
+}
+
+// drawCodewords раскладывает кодовые слова змейкой по парам столбцов снизу вверх и обратно, обходя служебные узоры
+func (q *qrCode) drawCodewords(codewords []byte) {
+	size := q.width
+
+	i := 0
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = size - 1 - vertical
+				}
+				if !q.isFunction(x, y) && i < len(codewords)*8 {
+					q.set(x, y, bit(int(codewords[i>>3]), 7-i&7))
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (q *qrCode) applyMask(mask int) {
+	for y := 0; y < q.height; y++ {
+		for x := 0; x < q.width; x++ {
+			if !q.isFunction(x, y) && qrMaskApplies(mask, x, y) {
+				q.set(x, y, !q.at(x, y))
+			}
+		}
+	}
+}
+
+func qrMaskApplies(mask int, x int, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// penalty оценивает символ по правилам выбора маски: длинные одноцветные ряды, блоки 2×2,
+// узоры, похожие на поисковые, и перекос доли тёмных модулей
+func (q *qrCode) penalty() int {
+	size := q.width
+	result := 0
+
+	for _, vertical := range []bool{false, true} {
+		for a := 0; a < size; a++ {
+			line := make([]bool, size)
+			for b := 0; b < size; b++ {
+				if vertical {
+					line[b] = q.at(a, b)
+				} else {
+					line[b] = q.at(b, a)
+				}
+			}
+			result += qrLinePenalty(line)
+		}
+	}
+
+	for y := 0; y < size-1; y++ {
+		for x := 0; x < size-1; x++ {
+			color := q.at(x, y)
+			if color == q.at(x+1, y) && color == q.at(x, y+1) && color == q.at(x+1, y+1) {
+				result += qrPenaltyN2
+			}
+		}
+	}
+
+	dark := 0
+	for _, module := range q.dark {
+		if module {
+			dark++
+		}
+	}
+	total := size * size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	result += k * qrPenaltyN4
+
+	return result
+}
+
+var (
+	qrFinderLikeBefore = []bool{false, false, false, false, true, false, true, true, true, false, true}
+	qrFinderLikeAfter  = []bool{true, false, true, true, true, false, true, false, false, false, false}
+)
+
+func qrLinePenalty(line []bool) int {
+	result := 0
+
+	run := 1
+	for i := 1; i <= len(line); i++ {
+		if i < len(line) && line[i] == line[i-1] {
+			run++
+			continue
+		}
+		if run >= 5 {
+			result += qrPenaltyN1 + run - 5
+		}
+		run = 1
+	}
+
+	for i := 0; i+len(qrFinderLikeBefore) <= len(line); i++ {
+		if matches(line[i:], qrFinderLikeBefore) || matches(line[i:], qrFinderLikeAfter) {
+			result += qrPenaltyN3
+		}
+	}
+
+	return result
+}
+
+func matches(line []bool, pattern []bool) bool {
+	for i, module := range pattern {
+		if line[i] != module {
+			return false
+		}
+	}
+	return true
+}
+
+// reedSolomonDivisor строит порождающий многочлен степени degree над GF(2^8) с корнями α^0…α^(degree-1)
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+func reedSolomonRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply умножает в GF(2^8) по модулю x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x byte, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = z<<1 ^ (z>>7)*0x11D
+		z ^= int(y>>i&1) * int(x)
+	}
+	return byte(z)
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value int, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, value>>i&1 != 0)
+	}
+}
+
+func (b bitBuffer) bytes() []byte {
+	result := make([]byte, len(b)/8)
+	for i, set := range b {
+		if set {
+			result[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return result
+}
+
+func bit(value int, i int) bool {
+	return value>>i&1 != 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,115 @@
+package barcode
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// symbol — закодированный символ в модулях. Линейные коды имеют высоту в один ряд и при отрисовке растягиваются по вертикали
+type symbol struct {
+	width  int
+	height int
+	dark   []bool
+
+	// quietZone — обязательное светлое поле вокруг символа в модулях
+	quietZone int
+}
+
+func newSymbol(width int, height int, quietZone int) *symbol {
+	return &symbol{width: width, height: height, dark: make([]bool, width*height), quietZone: quietZone}
+}
+
+func (s *symbol) at(x int, y int) bool {
+	return s.dark[y*s.width+x]
+}
+
+func (s *symbol) set(x int, y int, dark bool) {
+	s.dark[y*s.width+x] = dark
+}
+
+func (s *symbol) linear() bool {
+	return s.height == 1
+}
+
+// layout подбирает размер модуля так, чтобы ширина изображения не превышала size, но модуль был не меньше пикселя
+func (s *symbol) layout(size int) (module int, width int, height int) {
+	totalWidth := s.width + 2*s.quietZone
+	module = max(1, size/totalWidth)
+	width = module * totalWidth
+
+	if s.linear() {
+		return module, width, max(module*s.quietZone, width/3)
+	}
+	return module, width, module * (s.height + 2*s.quietZone)
+}
+
+func (s *symbol) png(size int) ([]byte, error) {
+	module, width, height := s.layout(size)
+
+	img := image.NewPaletted(image.Rect(0, 0, width, height), color.Palette{color.White, color.Black})
+	for y := 0; y < height; y++ {
+		moduleY := 0
+		if !s.linear() {
+			moduleY = y/module - s.quietZone
+			if moduleY < 0 || moduleY >= s.height {
+				continue
+			}
+		}
+		for x := 0; x < width; x++ {
+			moduleX := x/module - s.quietZone
+			if moduleX >= 0 && moduleX < s.width && s.at(moduleX, moduleY) {
+				img.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("png.Encode: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// svg рисует тёмные модули одним path, соседние модули ряда объединяются в прямоугольник
+func (s *symbol) svg(size int) []byte {
+	_, width, height := s.layout(size)
+
+	viewWidth := s.width + 2*s.quietZone
+	viewHeight := s.height + 2*s.quietZone
+	barHeight := 1
+	if s.linear() {
+		viewHeight = viewWidth * height / width
+		barHeight = viewHeight
+	}
+
+	var path strings.Builder
+	for y := 0; y < s.height; y++ {
+		for x := 0; x < s.width; {
+			if !s.at(x, y) {
+				x++
+				continue
+			}
+			run := 1
+			for x+run < s.width && s.at(x+run, y) {
+				run++
+			}
+			top := y + s.quietZone
+			if s.linear() {
+				top = 0
+			}
+			fmt.Fprintf(&path, "M%d,%dh%dv%dh-%dz", x+s.quietZone, top, run, barHeight, run)
+			x += run
+		}
+	}
+
+	return []byte(fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+			`<rect width="100%%" height="100%%" fill="#fff"/><path fill="#000" d="%s"/></svg>`,
+		width, height, viewWidth, viewHeight, path.String(),
+	))
+}
@@ -0,0 +1,43 @@
+package tabular
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+type csvWriter struct {
+	w   *csv.Writer
+	buf []string
+}
+
+// NewCSV создаёт Writer, записывающий CSV по RFC 4180
+func NewCSV(w io.Writer) Writer {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) WriteRow(cells ...any) error {
+	c.buf = c.buf[:0]
+	for _, cell := range cells {
+		s, numeric := formatCell(cell)
+		if !numeric {
+			s = escapeFormula(s)
+		}
+		c.buf = append(c.buf, s)
+	}
+
+	return c.w.Write(c.buf)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula экранирует строки, которые табличные редакторы интерпретируют как формулы
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
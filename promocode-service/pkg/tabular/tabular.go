@@ -0,0 +1,87 @@
+// Package tabular реализует потоковую запись табличных данных в CSV и XLSX.
+// Строки пишутся в io.Writer по мере поступления и не накапливаются в памяти
+package tabular
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// TimeLayout формат, в котором записываются значения time.Time
+const TimeLayout = "2006-01-02 15:04:05"
+
+var ErrUnsupportedFormat = errors.New("unsupported format")
+
+// Writer записывает таблицу построчно. Close дописывает служебные структуры формата,
+// но не закрывает нижележащий io.Writer
+type Writer interface {
+	WriteRow(cells ...any) error
+	Close() error
+}
+
+// NewWriter создаёт Writer для формата f
+func NewWriter(w io.Writer, f Format) (Writer, error) {
+	switch f {
+	case FormatCSV:
+		return NewCSV(w), nil
+	case FormatXLSX:
+		return NewXLSX(w)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, f)
+	}
+}
+
+// ContentType возвращает MIME-тип формата
+func (f Format) ContentType() string {
+	switch f {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Extension возвращает расширение файла без точки
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// formatCell приводит значение ячейки к строке. numeric=true означает, что значение числовое
+func formatCell(v any) (s string, numeric bool) {
+	switch c := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return c, false
+	case *string:
+		if c == nil {
+			return "", false
+		}
+		return *c, false
+	case int:
+		return strconv.Itoa(c), true
+	case int64:
+		return strconv.FormatInt(c, 10), true
+	case float64:
+		return strconv.FormatFloat(c, 'f', -1, 64), true
+	case time.Time:
+		if c.IsZero() {
+			return "", false
+		}
+		return c.UTC().Format(TimeLayout), false
+	case fmt.Stringer:
+		return c.String(), false
+	default:
+		return fmt.Sprint(c), false
+	}
+}
@@ -0,0 +1,79 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSV(t *testing.T) {
+	var buf bytes.Buffer
+
+	w := NewCSV(&buf)
+	require.NoError(t, w.WriteRow("code", "activations", "activated_at"))
+	require.NoError(t, w.WriteRow("SALE,10", int64(3), time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)))
+	require.NoError(t, w.WriteRow("=HYPERLINK(1)", -1, nil))
+	require.NoError(t, w.Close())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"code", "activations", "activated_at"},
+		{"SALE,10", "3", "2025-01-02 03:04:05"},
+		{"'=HYPERLINK(1)", "-1", ""},
+	}, records)
+}
+
+func TestXLSX(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, FormatXLSX)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow("code", "activations"))
+	require.NoError(t, w.WriteRow("<A&B>", int64(42)))
+	require.NoError(t, w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		files[f.Name], err = io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		require.Contains(t, files, name)
+		require.NoError(t, xml.Unmarshal(files[name], new(struct{})), name)
+	}
+
+	var sheet struct {
+		Rows []struct {
+			Cells []struct {
+				Type   string `xml:"t,attr"`
+				Value  string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	require.NoError(t, xml.Unmarshal(files["xl/worksheets/sheet1.xml"], &sheet))
+	require.Len(t, sheet.Rows, 2)
+	require.Equal(t, "inlineStr", sheet.Rows[1].Cells[0].Type)
+	require.Equal(t, "<A&B>", sheet.Rows[1].Cells[0].Inline)
+	require.Equal(t, "", sheet.Rows[1].Cells[1].Type)
+	require.Equal(t, "42", sheet.Rows[1].Cells[1].Value)
+}
+
+func TestNewWriter_UnsupportedFormat(t *testing.T) {
+	_, err := NewWriter(io.Discard, "ods")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
@@ -0,0 +1,47 @@
+#!/usr/bin/env sh
+# Генерирует локальный CA и сертификаты mTLS для docker-compose.
+# CA создаётся один раз в .dev-ca/, повторный запуск перевыпускает только сертификаты сервисов,
+# поэтому его можно использовать для проверки ротации без перезапуска контейнеров.
+#
+# CommonName сертификата — имя пира в TLS_ALLOWED_PEERS, SAN — имя контейнера из docker-compose.
+set -eu
+
+ROOT=$(cd "$(dirname "$0")/.." && pwd)
+CA_DIR="$ROOT/.dev-ca"
+DAYS=${DAYS:-30}
+
+mkdir -p "$CA_DIR"
+
+if [ ! -f "$CA_DIR/ca.pem" ]; then
+	openssl req -x509 -newkey ec -pkeyopt ec_paramgen_curve:P-256 -nodes \
+		-keyout "$CA_DIR/ca-key.pem" -out "$CA_DIR/ca.pem" \
+		-days 365 -subj "/CN=promocode-dev-ca" 2>/dev/null
+	echo "created CA $CA_DIR/ca.pem"
+fi
+
+# issue <service dir> <common name> <container name>
+issue() {
+	out="$ROOT/$1/certs/mtls"
+	mkdir -p "$out"
+
+	ext=$(mktemp)
+	printf 'subjectAltName=DNS:%s,DNS:localhost\nextendedKeyUsage=serverAuth,clientAuth\nkeyUsage=digitalSignature\n' "$3" > "$ext"
+
+	openssl req -newkey ec -pkeyopt ec_paramgen_curve:P-256 -nodes \
+		-keyout "$out/tls-key.pem.tmp" -out "$out/tls.csr" -subj "/CN=$2" 2>/dev/null
+	openssl x509 -req -in "$out/tls.csr" -CA "$CA_DIR/ca.pem" -CAkey "$CA_DIR/ca-key.pem" \
+		-CAcreateserial -days "$DAYS" -extfile "$ext" -out "$out/tls.pem.tmp" 2>/dev/null
+
+	# сервисы перечитывают файлы по времени изменения, поэтому ключ и сертификат подменяются
+	# переименованием, чтобы не прочитать половину новой пары
+	cp "$CA_DIR/ca.pem" "$out/ca.pem"
+	mv "$out/tls-key.pem.tmp" "$out/tls-key.pem"
+	mv "$out/tls.pem.tmp" "$out/tls.pem"
+	rm -f "$out/tls.csr" "$ext"
+
+	echo "issued $2 -> $out"
+}
+
+issue account-service account-service account_service_container
+issue auth-service auth-service auth_service_container
+issue promocode-service promo-service promo_code_service_container